@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"errors"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
 	"go-exchange/token"
+	"go-exchange/val"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -27,7 +29,7 @@ func (server *Server) createAccount(ctx *gin.Context) {
 	arg := db.CreateAccountParams{
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
-		Balance:  0,
+		Balance:  decimal.Zero,
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -119,8 +121,8 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 
 // PUT http://localhost:8080/accounts
 type updateAccountRequest struct {
-	ID      int64  `json:"id" binding:"required,min=1"`
-	Balance *int64 `json:"balance" binding:"required,gte=0"`
+	ID      int64            `json:"id" binding:"required,min=1"`
+	Balance *decimal.Decimal `json:"balance" binding:"required,gte=0"`
 }
 
 func (server *Server) updateAccount(ctx *gin.Context) {
@@ -130,11 +132,17 @@ func (server *Server) updateAccount(ctx *gin.Context) {
 		return
 	}
 
-	_, err := server.verifyAccountOwner(ctx, req.ID)
+	a, err := server.verifyAccountOwner(ctx, req.ID)
 	if err != nil {
 		return
 	}
 
+	currency, _ := server.registry.Currency(a.Currency)
+	if err := val.ValidateDecimals(*req.Balance, currency); err != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse([]fieldViolation{newFieldViolation("balance", err)}))
+		return
+	}
+
 	arg := db.UpdateAccountParams{
 		ID:      req.ID,
 		Balance: *req.Balance,
@@ -3,21 +3,35 @@ package api
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
 	"go-exchange/token"
 	"go-exchange/util"
+	"go-exchange/val"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 // POST http://localhost:8080/asks
 type askRequest struct {
-	Pair          string `json:"pair" binding:"required,pair"`
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Price         int64  `json:"price" binding:"required,gt=0"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
+	Pair                string          `json:"pair" binding:"required,pair"`
+	FromAccountID       int64           `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID         int64           `json:"to_account_id" binding:"required,min=1"`
+	Price               decimal.Decimal `json:"price" binding:"omitempty,gt=0"`
+	Amount              decimal.Decimal `json:"amount" binding:"required,gt=0"`
+	Type                string          `json:"type" binding:"omitempty,order_type"`
+	MaxSlippage         int64           `json:"max_slippage" binding:"omitempty,min=0,max=10000"`
+	TimeInForce         string          `json:"time_in_force" binding:"omitempty,time_in_force"`
+	ExpiresAt           time.Time       `json:"expires_at"`
+	StopPrice           decimal.Decimal `json:"stop_price" binding:"omitempty,gt=0"`
+	PostOnly            bool            `json:"post_only"`
+	DisplayAmount       decimal.Decimal `json:"display_amount" binding:"omitempty,gt=0"`
+	Hidden              bool            `json:"hidden"`
+	SelfTradePrevention string          `json:"self_trade_prevention" binding:"omitempty,self_trade_prevention"`
 }
 
 func (server *Server) createAsk(ctx *gin.Context) {
@@ -27,6 +41,30 @@ func (server *Server) createAsk(ctx *gin.Context) {
 		return
 	}
 
+	if req.Type == "" {
+		req.Type = util.LIMIT
+	}
+
+	if err := val.ValidateOrderPrices(req.Type, req.Price, req.StopPrice, req.MaxSlippage); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	timeInForce, expiresAt, err := val.ValidateOrderTimeInForce(req.Type, req.TimeInForce, req.ExpiresAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := val.ValidateOrderFlags(req.Type, timeInForce, req.Amount, req.PostOnly, req.DisplayAmount, req.Hidden); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.validOrderSize(ctx, req.Pair, newOrderSize(req.Price, req.StopPrice, req.Amount, req.DisplayAmount)) {
+		return
+	}
+
 	c1, c2 := util.CurrenciesFromPair(req.Pair)
 
 	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, c1)
@@ -52,22 +90,69 @@ func (server *Server) createAsk(ctx *gin.Context) {
 		return
 	}
 
+	price, amount := req.Price, req.Amount
+	if req.Type == util.MARKET {
+		available := fromAccount.Balance.Sub(fromAccount.Held)
+		quote, err := server.engine.QuoteMarketAsk(req.Pair, req.Amount, available, req.MaxSlippage)
+		if err != nil {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		price, amount = quote.Price, quote.Amount
+
+		// a quote for less than the amount must still meet the trading rules of the pair
+		if !server.validOrderSize(ctx, req.Pair, newOrderSize(price, decimal.Zero, amount, decimal.Zero)) {
+			return
+		}
+	}
+	if req.Type == util.STOP_MARKET {
+		pair, _ := server.registry.Pair(req.Pair)
+		price = engine.StopMarketPrice(util.ASK, req.StopPrice, req.MaxSlippage, pair.TickSize)
+	}
+
 	arg := db.CreateAskParams{
-		Pair:          req.Pair,
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Price:         req.Price,
-		Amount:        req.Amount,
-		Status:        util.ACTIVE,
+		Pair:                req.Pair,
+		FromAccountID:       req.FromAccountID,
+		ToAccountID:         req.ToAccountID,
+		Price:               price,
+		Amount:              amount,
+		Status:              util.OrderStatus(req.Type),
+		Type:                req.Type,
+		TimeInForce:         timeInForce,
+		ExpiresAt:           expiresAt,
+		StopPrice:           req.StopPrice,
+		PostOnly:            req.PostOnly,
+		DisplayAmount:       req.DisplayAmount,
+		Hidden:              req.Hidden,
+		SelfTradePrevention: req.SelfTradePrevention,
 	}
 
-	result, err := server.store.CreateAsk(ctx, arg)
+	result, err := server.store.CreateAskTx(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	ask := result.Ask
+
+	match, err := server.engine.PlaceAsk(ctx, ask)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		ask, err = server.store.GetAsk(ctx, ask.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
 
-	ctx.JSON(http.StatusOK, result)
+	ctx.JSON(http.StatusOK, ask)
 }
 
 // GET http://localhost:8080/asks/1
@@ -142,12 +227,47 @@ func (server *Server) listAsks(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, asks)
 }
 
+// GET http://localhost:8080/asks/1/events?page_id=1&page_size=5
+func (server *Server) listAskEvents(ctx *gin.Context) {
+	var uri getAskRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listOrderEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ask, err := server.store.GetAsk(ctx, uri.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.verifyAccountOwner(ctx, ask.FromAccountID)
+	if err != nil {
+		return
+	}
+
+	server.listOrderEvents(ctx, util.ASK, ask.ID, req)
+}
+
 // PUT http://localhost:8080/asks
 type updateAskRequest struct {
-	ID     int64  `json:"id" binding:"required,min=1"`
-	Status string `json:"status" binding:"required,eq=canceled"`
+	ID     int64           `json:"id" binding:"required,min=1"`
+	Status string          `json:"status" binding:"omitempty,eq=canceled"`
+	Price  decimal.Decimal `json:"price" binding:"omitempty,gt=0"`
+	Amount decimal.Decimal `json:"amount" binding:"omitempty,gt=0"`
 }
 
+// updateAsk cancels an open ask, or amends its price and amount if no status is given
 func (server *Server) updateAsk(ctx *gin.Context) {
 	var req updateAskRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -155,6 +275,11 @@ func (server *Server) updateAsk(ctx *gin.Context) {
 		return
 	}
 
+	if err := val.ValidateOrderUpdate(req.Status, req.Price, req.Amount); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	a, err := server.store.GetAsk(ctx, req.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -170,12 +295,18 @@ func (server *Server) updateAsk(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.UpdateAskParams{
-		ID:     req.ID,
-		Status: req.Status,
+	if !util.IsOpenStatus(a.Status) {
+		err := fmt.Errorf("ask %d is %s", a.ID, a.Status)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
 	}
 
-	ask, err := server.store.UpdateAsk(ctx, arg)
+	if req.Status == "" {
+		server.amendAsk(ctx, a, req.Price, req.Amount)
+		return
+	}
+
+	result, err := server.engine.CancelOpenAsk(ctx, a)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -185,5 +316,64 @@ func (server *Server) updateAsk(ctx *gin.Context) {
 		return
 	}
 
+	ctx.JSON(http.StatusOK, result.Ask)
+}
+
+// amendAsk changes the price and amount of an open ask, a zero price or amount keeps the current one
+func (server *Server) amendAsk(ctx *gin.Context, a db.Ask, price decimal.Decimal, amount decimal.Decimal) {
+	if a.GroupID.Valid {
+		err := fmt.Errorf("ask %d belongs to order group %d", a.ID, a.GroupID.Int64)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	if !server.registry.IsActivePair(a.Pair) {
+		err := fmt.Errorf("pair %s is not open for trading", a.Pair)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	if err := val.ValidateOrderAmendment(a.Type, price, amount, a.FilledAmount, a.DisplayAmount); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	size := orderSize{price: price, amount: amount}
+	if price.IsZero() {
+		price = a.Price
+	}
+	if amount.IsZero() {
+		amount = a.Amount
+	}
+	size.notionalAmount, size.notionalPrice = amount, price
+
+	if !server.validOrderSize(ctx, a.Pair, size) {
+		return
+	}
+
+	result, match, err := server.engine.AmendAsk(ctx, a, price, amount)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("ask %d can no longer be amended", a.ID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	ask := result.Ask
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		ask, err = server.store.GetAsk(ctx, ask.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
 	ctx.JSON(http.StatusOK, ask)
 }
@@ -2,10 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	mockdb "go-exchange/db/mock"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
 	"go-exchange/token"
 	"go-exchange/util"
 	"io"
@@ -20,12 +23,16 @@ import (
 )
 
 func randomAsk(fromAccountID int64, toAccountID int64) db.Ask {
+	amount := util.RandomInt(1, 99)
 	return db.Ask{
-		Pair:          util.RandomPair(),
-		FromAccountID: fromAccountID,
-		ToAccountID:   toAccountID,
-		Price:         util.RandomMoney(),
-		Amount:        util.RandomInt(1, 99),
+		Pair:            util.RandomPair(),
+		FromAccountID:   fromAccountID,
+		ToAccountID:     toAccountID,
+		Price:           util.RandomMoney(),
+		Amount:          decimal.NewFromInt(amount),
+		Type:            util.LIMIT,
+		TimeInForce:     util.GTC,
+		RemainingAmount: decimal.NewFromInt(amount),
 	}
 }
 
@@ -69,7 +76,7 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
@@ -82,9 +89,11 @@ func TestCreateAskAPI(t *testing.T) {
 					Price:         ask.Price,
 					Amount:        ask.Amount,
 					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
 				}
 
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Eq(arg)).Times(1).Return(ask, nil)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: ask}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -101,7 +110,7 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
@@ -114,14 +123,49 @@ func TestCreateAskAPI(t *testing.T) {
 					Price:         ask.Price,
 					Amount:        ask.Amount,
 					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
 				}
 
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.Ask{}, sql.ErrConnDone)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: ask.FromAccountID,
+					ToAccountID:   ask.ToAccountID,
+					Price:         ask.Price,
+					Amount:        ask.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+				}
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "FromAccountNotFound",
 			body: gin.H{
@@ -132,12 +176,12 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -153,12 +197,12 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -174,12 +218,12 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -195,12 +239,12 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -216,11 +260,11 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -232,15 +276,15 @@ func TestCreateAskAPI(t *testing.T) {
 				"pair":            ask.Pair,
 				"from_account_id": account1.ID,
 				"to_account_id":   account2.ID,
-				"price":           -ask.Price,
+				"price":           ask.Price.Neg(),
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -253,14 +297,14 @@ func TestCreateAskAPI(t *testing.T) {
 				"from_account_id": account1.ID,
 				"to_account_id":   account2.ID,
 				"price":           ask.Price,
-				"amount":          -ask.Amount,
+				"amount":          ask.Amount.Neg(),
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -276,11 +320,11 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, sql.ErrConnDone)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -296,12 +340,12 @@ func TestCreateAskAPI(t *testing.T) {
 				"amount":          ask.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -322,7 +366,7 @@ func TestCreateAskAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(0)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -357,3 +401,1060 @@ func TestCreateAskAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateMarketAskAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.BTC
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.USDT
+
+	resting1 := &engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(1)}
+	resting2 := &engine.Order{ID: 2, Pair: util.BTC_USDT, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(80), Amount: decimal.NewFromInt(5)}
+
+	ask := randomAsk(account1.ID, account2.ID)
+	ask.Pair = util.BTC_USDT
+	ask.Type = util.MARKET
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		resting       []*engine.Order
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// it sweeps both levels, so the worst price is the second one
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         decimal.NewFromInt(80),
+					Amount:        decimal.NewFromInt(3),
+					Status:        util.ACTIVE,
+					Type:          util.MARKET,
+					TimeInForce:   util.IOC,
+				}
+
+				created := ask
+				created.Price = arg.Price
+				created.Amount = arg.Amount
+				created.RemainingAmount = arg.Amount
+
+				completed := created
+				completed.Status = util.COMPLETED
+				completed.FilledAmount = arg.Amount
+				completed.RemainingAmount = decimal.NewFromInt(0)
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(completed, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, util.COMPLETED, gotAsk.Status)
+				require.Equal(t, decimal.NewFromInt(3), gotAsk.FilledAmount)
+			},
+		},
+		{
+			name: "MaxSlippage",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+				"max_slippage":    1000,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// the second level is more than 10% away from the best price
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         decimal.NewFromInt(100),
+					Amount:        decimal.NewFromInt(1),
+					Status:        util.ACTIVE,
+					Type:          util.MARKET,
+					TimeInForce:   util.IOC,
+				}
+
+				created := ask
+				created.Price = arg.Price
+				created.Amount = arg.Amount
+				created.RemainingAmount = arg.Amount
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(created, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NoLiquidity",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				poor := account1
+				poor.Balance = decimal.NewFromInt(0)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(poor, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidType",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            "stop",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "LimitWithoutPrice",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.LIMIT,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range tc.resting {
+				resting := *order
+				book.Add(&resting)
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/asks"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateAskTimeInForceAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.BTC
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.USDT
+
+	ask := randomAsk(account1.ID, account2.ID)
+	ask.Pair = util.BTC_USDT
+	ask.Price = decimal.NewFromInt(100)
+	ask.Amount = decimal.NewFromInt(3)
+	ask.RemainingAmount = decimal.NewFromInt(3)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "ImmediateOrCancel",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"time_in_force":   util.IOC,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         ask.Price,
+					Amount:        ask.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.IOC,
+				}
+
+				created := ask
+				created.TimeInForce = util.IOC
+
+				canceled := created
+				canceled.Status = util.CANCELED
+
+				// nothing crosses the empty book, so the whole ask is canceled
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().CancelAskTx(gomock.Any(), gomock.Eq(ask.ID)).Times(1)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(canceled, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, util.CANCELED, gotAsk.Status)
+				require.Equal(t, util.IOC, gotAsk.TimeInForce)
+			},
+		},
+		{
+			name: "GoodTillDate",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"time_in_force":   util.GTD,
+				"expires_at":      time.Now().Add(time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateAskParams) (db.CreateAskTxResult, error) {
+						require.Equal(t, util.GTD, arg.TimeInForce)
+						require.True(t, arg.ExpiresAt.Valid)
+						require.True(t, arg.ExpiresAt.Time.After(time.Now()))
+						return db.CreateAskTxResult{Ask: ask}, nil
+					})
+				store.EXPECT().CancelAskTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "GoodTillDateExpired",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"time_in_force":   util.GTD,
+				"expires_at":      time.Now().Add(-time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ExpiresAtWithoutGoodTillDate",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"expires_at":      time.Now().Add(time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MarketGoodTillCanceled",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          ask.Amount,
+				"type":            util.MARKET,
+				"time_in_force":   util.GTC,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTimeInForce",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"time_in_force":   "DAY",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/asks"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateStopAskAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.BTC
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.USDT
+
+	ask := randomAsk(account1.ID, account2.ID)
+	ask.Pair = util.BTC_USDT
+	ask.Price = decimal.NewFromInt(100)
+	ask.Amount = decimal.NewFromInt(3)
+	ask.RemainingAmount = decimal.NewFromInt(3)
+	ask.Status = util.PENDING
+	ask.StopPrice = decimal.NewFromInt(90)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "StopLimit",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"type":            util.STOP_LIMIT,
+				"stop_price":      ask.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         ask.Price,
+					Amount:        ask.Amount,
+					Status:        util.PENDING,
+					Type:          util.STOP_LIMIT,
+					TimeInForce:   util.GTC,
+					StopPrice:     ask.StopPrice,
+				}
+
+				created := ask
+				created.Type = util.STOP_LIMIT
+
+				// nothing traded yet, so the ask waits for its trigger
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().TriggerAsk(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, util.PENDING, gotAsk.Status)
+				require.Equal(t, ask.StopPrice, gotAsk.StopPrice)
+			},
+		},
+		{
+			name: "StopMarket",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          ask.Amount,
+				"type":            util.STOP_MARKET,
+				"stop_price":      ask.StopPrice,
+				"max_slippage":    1000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// the ask holds funds at the worst price it can reach once triggered
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         engine.StopMarketPrice(util.ASK, ask.StopPrice, 1000, decimal.NewFromInt(1)),
+					Amount:        ask.Amount,
+					Status:        util.PENDING,
+					Type:          util.STOP_MARKET,
+					TimeInForce:   util.IOC,
+					StopPrice:     ask.StopPrice,
+				}
+
+				created := ask
+				created.Price = arg.Price
+				created.Type = util.STOP_MARKET
+				created.TimeInForce = util.IOC
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "StopMarketWithoutSlippage",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          ask.Amount,
+				"type":            util.STOP_MARKET,
+				"stop_price":      ask.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopWithoutStopPrice",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"type":            util.STOP_LIMIT,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopLimitWithoutPrice",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          ask.Amount,
+				"type":            util.STOP_LIMIT,
+				"stop_price":      ask.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopPriceWithoutStop",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"stop_price":      ask.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/asks"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateAskFlagsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.BTC
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.USDT
+
+	crossing := &engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(110), Amount: decimal.NewFromInt(5)}
+
+	ask := randomAsk(account1.ID, account2.ID)
+	ask.Pair = util.BTC_USDT
+	ask.Price = decimal.NewFromInt(100)
+	ask.Amount = decimal.NewFromInt(3)
+	ask.RemainingAmount = decimal.NewFromInt(3)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		resting       []*engine.Order
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "PostOnlyRejected",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"post_only":       true,
+			},
+			resting: []*engine.Order{crossing},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         ask.Price,
+					Amount:        ask.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+					PostOnly:      true,
+				}
+
+				created := ask
+				created.PostOnly = true
+
+				canceled := created
+				canceled.Status = util.CANCELED
+
+				// the ask would take the resting ask, so it is canceled without trading
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CancelAskTx(gomock.Any(), gomock.Eq(ask.ID)).Times(1)
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(canceled, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, util.CANCELED, gotAsk.Status)
+				require.True(t, gotAsk.PostOnly)
+			},
+		},
+		{
+			name: "Iceberg",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"display_amount":  1,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateAskParams{
+					Pair:          ask.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         ask.Price,
+					Amount:        ask.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+					DisplayAmount: decimal.NewFromInt(1),
+				}
+
+				created := ask
+				created.DisplayAmount = decimal.NewFromInt(1)
+
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateAskTxResult{Ask: created}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DisplayAmountTooLarge",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"display_amount":  ask.Amount,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "PostOnlyImmediateOrCancel",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"time_in_force":   util.IOC,
+				"post_only":       true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "HiddenMarket",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          ask.Amount,
+				"type":            util.MARKET,
+				"hidden":          true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "HiddenIceberg",
+			body: gin.H{
+				"pair":            ask.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           ask.Price,
+				"amount":          ask.Amount,
+				"display_amount":  1,
+				"hidden":          true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range tc.resting {
+				resting := *order
+				book.Add(&resting)
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/asks"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestAmendAskAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.BTC
+	account2.Currency = util.USDT
+
+	ask := randomAsk(account1.ID, account2.ID)
+	ask.ID = 1
+	ask.Pair = util.BTC_USDT
+	ask.Price = decimal.NewFromInt(100)
+	ask.Amount = decimal.NewFromInt(5)
+	ask.RemainingAmount = decimal.NewFromInt(5)
+	ask.Status = util.ACTIVE
+
+	bid := &engine.Order{ID: 2, Pair: util.BTC_USDT, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(95), Amount: decimal.NewFromInt(2)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		ask           func() db.Ask
+		buildStubs    func(store *mockdb.MockStore, ask db.Ask)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "ReduceAmount",
+			body: gin.H{"id": ask.ID, "amount": 3},
+			ask:  func() db.Ask { return ask },
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				amended := ask
+				amended.Amount = decimal.NewFromInt(3)
+				amended.RemainingAmount = decimal.NewFromInt(3)
+				store.EXPECT().AmendAskTx(gomock.Any(), gomock.Eq(db.AmendAskParams{ID: ask.ID, Price: ask.Price, Amount: decimal.NewFromInt(3)})).Times(1).
+					Return(db.AmendAskTxResult{Ask: amended}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, decimal.NewFromInt(3), gotAsk.Amount)
+
+				require.Equal(t, ask.ID, book.Best(util.ASK).ID)
+				require.Equal(t, decimal.NewFromInt(3), book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "NewPriceTrades",
+			body: gin.H{"id": ask.ID, "price": bid.Price},
+			ask:  func() db.Ask { return ask },
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				amended := ask
+				amended.Price = bid.Price
+
+				filled := amended
+				filled.Status = util.PARTIALLY_FILLED
+				filled.FilledAmount = bid.Amount
+				filled.RemainingAmount = ask.Amount.Sub(bid.Amount)
+
+				// the ask is fetched again once it traded
+				gomock.InOrder(
+					store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil),
+					store.EXPECT().AmendAskTx(gomock.Any(), gomock.Eq(db.AmendAskParams{ID: ask.ID, Price: bid.Price, Amount: ask.Amount})).Times(1).
+						Return(db.AmendAskTxResult{Ask: amended}, nil),
+					store.EXPECT().FillTx(gomock.Any(), gomock.Eq(db.FillTxParams{BidID: bid.ID, AskID: ask.ID, Price: bid.Price, Amount: bid.Amount, TakerSide: util.ASK})).Times(1),
+					store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(filled, nil),
+				)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotAsk db.Ask
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotAsk)
+				require.NoError(t, err)
+				require.Equal(t, util.PARTIALLY_FILLED, gotAsk.Status)
+
+				require.Empty(t, book.Orders(util.BID))
+				require.Equal(t, ask.Amount.Sub(bid.Amount), book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{"id": ask.ID, "amount": 50},
+			ask:  func() db.Ask { return ask },
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendAskTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AmendAskTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.Equal(t, ask.Amount, book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "Completed",
+			body: gin.H{"id": ask.ID, "amount": 3},
+			ask: func() db.Ask {
+				completed := ask
+				completed.Status = util.COMPLETED
+				completed.FilledAmount = ask.Amount
+				completed.RemainingAmount = decimal.NewFromInt(0)
+				return completed
+			},
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "AmountNotAboveFilled",
+			body: gin.H{"id": ask.ID, "amount": 2},
+			ask: func() db.Ask {
+				partial := ask
+				partial.Status = util.PARTIALLY_FILLED
+				partial.FilledAmount = decimal.NewFromInt(2)
+				partial.RemainingAmount = decimal.NewFromInt(3)
+				return partial
+			},
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OrderGroupLeg",
+			body: gin.H{"id": ask.ID, "amount": 3},
+			ask: func() db.Ask {
+				leg := ask
+				leg.GroupID = sql.NullInt64{Int64: 1, Valid: true}
+				leg.GroupLeg = util.ENTRY_LEG
+				return leg
+			},
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendAskTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "CancelAndAmend",
+			body: gin.H{"id": ask.ID, "status": util.CANCELED, "amount": 3},
+			ask:  func() db.Ask { return ask },
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NothingToUpdate",
+			body: gin.H{"id": ask.ID},
+			ask:  func() db.Ask { return ask },
+			buildStubs: func(store *mockdb.MockStore, ask db.Ask) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ask := tc.ask()
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, ask)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			resting := *bid
+			book.Add(&resting)
+			if util.IsOpenStatus(ask.Status) {
+				book.Add(&engine.Order{ID: ask.ID, Pair: ask.Pair, Side: util.ASK, Type: ask.Type, Price: ask.Price, Amount: ask.RemainingAmount})
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/asks"
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
@@ -3,9 +3,14 @@ package api
 import (
 	"database/sql"
 	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/engine"
 	"go-exchange/token"
 	"go-exchange/util"
+	"go-exchange/val"
 	"net/http"
+	"time"
 
 	db "go-exchange/db/sqlc"
 
@@ -14,11 +19,20 @@ import (
 
 // POST http://localhost:8080/bids
 type bidRequest struct {
-	Pair          string `json:"pair" binding:"required,pair"`
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Price         int64  `json:"price" binding:"required,gt=0"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
+	Pair                string          `json:"pair" binding:"required,pair"`
+	FromAccountID       int64           `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID         int64           `json:"to_account_id" binding:"required,min=1"`
+	Price               decimal.Decimal `json:"price" binding:"omitempty,gt=0"`
+	Amount              decimal.Decimal `json:"amount" binding:"required,gt=0"`
+	Type                string          `json:"type" binding:"omitempty,order_type"`
+	MaxSlippage         int64           `json:"max_slippage" binding:"omitempty,min=0,max=10000"`
+	TimeInForce         string          `json:"time_in_force" binding:"omitempty,time_in_force"`
+	ExpiresAt           time.Time       `json:"expires_at"`
+	StopPrice           decimal.Decimal `json:"stop_price" binding:"omitempty,gt=0"`
+	PostOnly            bool            `json:"post_only"`
+	DisplayAmount       decimal.Decimal `json:"display_amount" binding:"omitempty,gt=0"`
+	Hidden              bool            `json:"hidden"`
+	SelfTradePrevention string          `json:"self_trade_prevention" binding:"omitempty,self_trade_prevention"`
 }
 
 func (server *Server) createBid(ctx *gin.Context) {
@@ -28,6 +42,30 @@ func (server *Server) createBid(ctx *gin.Context) {
 		return
 	}
 
+	if req.Type == "" {
+		req.Type = util.LIMIT
+	}
+
+	if err := val.ValidateOrderPrices(req.Type, req.Price, req.StopPrice, req.MaxSlippage); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	timeInForce, expiresAt, err := val.ValidateOrderTimeInForce(req.Type, req.TimeInForce, req.ExpiresAt)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if err := val.ValidateOrderFlags(req.Type, timeInForce, req.Amount, req.PostOnly, req.DisplayAmount, req.Hidden); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.validOrderSize(ctx, req.Pair, newOrderSize(req.Price, req.StopPrice, req.Amount, req.DisplayAmount)) {
+		return
+	}
+
 	c1, c2 := util.CurrenciesFromPair(req.Pair)
 
 	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, c2)
@@ -53,22 +91,69 @@ func (server *Server) createBid(ctx *gin.Context) {
 		return
 	}
 
+	price, amount := req.Price, req.Amount
+	if req.Type == util.MARKET {
+		available := fromAccount.Balance.Sub(fromAccount.Held)
+		quote, err := server.engine.QuoteMarketBid(req.Pair, req.Amount, available, req.MaxSlippage)
+		if err != nil {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		price, amount = quote.Price, quote.Amount
+
+		// a quote for less than the amount must still meet the trading rules of the pair
+		if !server.validOrderSize(ctx, req.Pair, newOrderSize(price, decimal.Zero, amount, decimal.Zero)) {
+			return
+		}
+	}
+	if req.Type == util.STOP_MARKET {
+		pair, _ := server.registry.Pair(req.Pair)
+		price = engine.StopMarketPrice(util.BID, req.StopPrice, req.MaxSlippage, pair.TickSize)
+	}
+
 	arg := db.CreateBidParams{
-		Pair:          req.Pair,
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Price:         req.Price,
-		Amount:        req.Amount,
-		Status:        util.ACTIVE,
+		Pair:                req.Pair,
+		FromAccountID:       req.FromAccountID,
+		ToAccountID:         req.ToAccountID,
+		Price:               price,
+		Amount:              amount,
+		Status:              util.OrderStatus(req.Type),
+		Type:                req.Type,
+		TimeInForce:         timeInForce,
+		ExpiresAt:           expiresAt,
+		StopPrice:           req.StopPrice,
+		PostOnly:            req.PostOnly,
+		DisplayAmount:       req.DisplayAmount,
+		Hidden:              req.Hidden,
+		SelfTradePrevention: req.SelfTradePrevention,
 	}
 
-	result, err := server.store.CreateBid(ctx, arg)
+	result, err := server.store.CreateBidTx(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
+	bid := result.Bid
+
+	match, err := server.engine.PlaceBid(ctx, bid)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		bid, err = server.store.GetBid(ctx, bid.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
 
-	ctx.JSON(http.StatusOK, result)
+	ctx.JSON(http.StatusOK, bid)
 }
 
 // GET http://localhost:8080/bids/1
@@ -143,12 +228,47 @@ func (server *Server) listBids(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, bids)
 }
 
+// GET http://localhost:8080/bids/1/events?page_id=1&page_size=5
+func (server *Server) listBidEvents(ctx *gin.Context) {
+	var uri getBidRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listOrderEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	bid, err := server.store.GetBid(ctx, uri.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.verifyAccountOwner(ctx, bid.FromAccountID)
+	if err != nil {
+		return
+	}
+
+	server.listOrderEvents(ctx, util.BID, bid.ID, req)
+}
+
 // PUT http://localhost:8080/bids
 type updateBidRequest struct {
-	ID     int64  `json:"id" binding:"required,min=1"`
-	Status string `json:"status" binding:"required,eq=canceled"`
+	ID     int64           `json:"id" binding:"required,min=1"`
+	Status string          `json:"status" binding:"omitempty,eq=canceled"`
+	Price  decimal.Decimal `json:"price" binding:"omitempty,gt=0"`
+	Amount decimal.Decimal `json:"amount" binding:"omitempty,gt=0"`
 }
 
+// updateBid cancels an open bid, or amends its price and amount if no status is given
 func (server *Server) updateBid(ctx *gin.Context) {
 	var req updateBidRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -156,6 +276,11 @@ func (server *Server) updateBid(ctx *gin.Context) {
 		return
 	}
 
+	if err := val.ValidateOrderUpdate(req.Status, req.Price, req.Amount); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	b, err := server.store.GetBid(ctx, req.ID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -171,12 +296,18 @@ func (server *Server) updateBid(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.UpdateBidParams{
-		ID:     req.ID,
-		Status: req.Status,
+	if !util.IsOpenStatus(b.Status) {
+		err := fmt.Errorf("bid %d is %s", b.ID, b.Status)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
 	}
 
-	bid, err := server.store.UpdateBid(ctx, arg)
+	if req.Status == "" {
+		server.amendBid(ctx, b, req.Price, req.Amount)
+		return
+	}
+
+	result, err := server.engine.CancelOpenBid(ctx, b)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
@@ -186,5 +317,64 @@ func (server *Server) updateBid(ctx *gin.Context) {
 		return
 	}
 
+	ctx.JSON(http.StatusOK, result.Bid)
+}
+
+// amendBid changes the price and amount of an open bid, a zero price or amount keeps the current one
+func (server *Server) amendBid(ctx *gin.Context, b db.Bid, price decimal.Decimal, amount decimal.Decimal) {
+	if b.GroupID.Valid {
+		err := fmt.Errorf("bid %d belongs to order group %d", b.ID, b.GroupID.Int64)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	if !server.registry.IsActivePair(b.Pair) {
+		err := fmt.Errorf("pair %s is not open for trading", b.Pair)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	if err := val.ValidateOrderAmendment(b.Type, price, amount, b.FilledAmount, b.DisplayAmount); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	size := orderSize{price: price, amount: amount}
+	if price.IsZero() {
+		price = b.Price
+	}
+	if amount.IsZero() {
+		amount = b.Amount
+	}
+	size.notionalAmount, size.notionalPrice = amount, price
+
+	if !server.validOrderSize(ctx, b.Pair, size) {
+		return
+	}
+
+	result, match, err := server.engine.AmendBid(ctx, b, price, amount)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("bid %d can no longer be amended", b.ID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	bid := result.Bid
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		bid, err = server.store.GetBid(ctx, bid.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
 	ctx.JSON(http.StatusOK, bid)
 }
@@ -2,10 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	mockdb "go-exchange/db/mock"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
 	"go-exchange/token"
 	"go-exchange/util"
 	"io"
@@ -20,12 +23,16 @@ import (
 )
 
 func randomBid(fromAccountID int64, toAccountID int64) db.Bid {
+	amount := util.RandomInt(1, 99)
 	return db.Bid{
-		Pair:          util.RandomPair(),
-		FromAccountID: fromAccountID,
-		ToAccountID:   toAccountID,
-		Price:         util.RandomMoney(),
-		Amount:        util.RandomInt(1, 99),
+		Pair:            util.RandomPair(),
+		FromAccountID:   fromAccountID,
+		ToAccountID:     toAccountID,
+		Price:           util.RandomMoney(),
+		Amount:          decimal.NewFromInt(amount),
+		Type:            util.LIMIT,
+		TimeInForce:     util.GTC,
+		RemainingAmount: decimal.NewFromInt(amount),
 	}
 }
 
@@ -69,7 +76,7 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
@@ -82,9 +89,11 @@ func TestCreateBidAPI(t *testing.T) {
 					Price:         bid.Price,
 					Amount:        bid.Amount,
 					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
 				}
 
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Eq(arg)).Times(1).Return(bid, nil)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: bid}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -101,7 +110,7 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
@@ -114,14 +123,49 @@ func TestCreateBidAPI(t *testing.T) {
 					Price:         bid.Price,
 					Amount:        bid.Amount,
 					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
 				}
 
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.Bid{}, sql.ErrConnDone)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: bid.FromAccountID,
+					ToAccountID:   bid.ToAccountID,
+					Price:         bid.Price,
+					Amount:        bid.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+				}
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "FromAccountNotFound",
 			body: gin.H{
@@ -132,12 +176,12 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -153,12 +197,12 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusNotFound, recorder.Code)
@@ -174,12 +218,12 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -195,12 +239,12 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account3.ID)).Times(1).Return(account3, nil)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -216,11 +260,11 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -232,15 +276,15 @@ func TestCreateBidAPI(t *testing.T) {
 				"pair":            bid.Pair,
 				"from_account_id": account1.ID,
 				"to_account_id":   account2.ID,
-				"price":           -bid.Price,
+				"price":           bid.Price.Neg(),
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -253,14 +297,14 @@ func TestCreateBidAPI(t *testing.T) {
 				"from_account_id": account1.ID,
 				"to_account_id":   account2.ID,
 				"price":           bid.Price,
-				"amount":          -bid.Amount,
+				"amount":          bid.Amount.Neg(),
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -276,11 +320,11 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, sql.ErrConnDone)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -296,12 +340,12 @@ func TestCreateBidAPI(t *testing.T) {
 				"amount":          bid.Amount,
 			},
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
-				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", util.TRADER, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -322,7 +366,7 @@ func TestCreateBidAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(0)
 				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(0)
-				store.EXPECT().CreateBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
@@ -358,3 +402,1315 @@ func TestCreateBidAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateMarketBidAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+
+	resting1 := &engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(1)}
+	resting2 := &engine.Order{ID: 2, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(120), Amount: decimal.NewFromInt(5)}
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.Pair = util.BTC_USDT
+	bid.Type = util.MARKET
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		resting       []*engine.Order
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// it sweeps both levels, so the worst price is the second one
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         decimal.NewFromInt(120),
+					Amount:        decimal.NewFromInt(3),
+					Status:        util.ACTIVE,
+					Type:          util.MARKET,
+					TimeInForce:   util.IOC,
+				}
+
+				created := bid
+				created.Price = arg.Price
+				created.Amount = arg.Amount
+				created.RemainingAmount = arg.Amount
+
+				completed := created
+				completed.Status = util.COMPLETED
+				completed.FilledAmount = arg.Amount
+				completed.RemainingAmount = decimal.NewFromInt(0)
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(completed, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.COMPLETED, gotBid.Status)
+				require.Equal(t, decimal.NewFromInt(3), gotBid.FilledAmount)
+			},
+		},
+		{
+			name: "MaxSlippage",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+				"max_slippage":    1000,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// the second level is more than 10% away from the best price
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         decimal.NewFromInt(100),
+					Amount:        decimal.NewFromInt(1),
+					Status:        util.ACTIVE,
+					Type:          util.MARKET,
+					TimeInForce:   util.IOC,
+				}
+
+				created := bid
+				created.Price = arg.Price
+				created.Amount = arg.Amount
+				created.RemainingAmount = arg.Amount
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(created, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NoLiquidity",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.MARKET,
+			},
+			resting: []*engine.Order{resting1, resting2},
+			buildStubs: func(store *mockdb.MockStore) {
+				poor := account1
+				poor.Balance = decimal.NewFromInt(50)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(poor, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidType",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            "stop",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "LimitWithoutPrice",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          3,
+				"type":            util.LIMIT,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range tc.resting {
+				resting := *order
+				book.Add(&resting)
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateBidTimeInForceAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.Pair = util.BTC_USDT
+	bid.Price = decimal.NewFromInt(100)
+	bid.Amount = decimal.NewFromInt(3)
+	bid.RemainingAmount = decimal.NewFromInt(3)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "ImmediateOrCancel",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"time_in_force":   util.IOC,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         bid.Price,
+					Amount:        bid.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.IOC,
+				}
+
+				created := bid
+				created.TimeInForce = util.IOC
+
+				canceled := created
+				canceled.Status = util.CANCELED
+
+				// nothing crosses the empty book, so the whole bid is canceled
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(canceled, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.CANCELED, gotBid.Status)
+				require.Equal(t, util.IOC, gotBid.TimeInForce)
+			},
+		},
+		{
+			name: "GoodTillDate",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"time_in_force":   util.GTD,
+				"expires_at":      time.Now().Add(time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateBidParams) (db.CreateBidTxResult, error) {
+						require.Equal(t, util.GTD, arg.TimeInForce)
+						require.True(t, arg.ExpiresAt.Valid)
+						require.True(t, arg.ExpiresAt.Time.After(time.Now()))
+						return db.CreateBidTxResult{Bid: bid}, nil
+					})
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "GoodTillDateExpired",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"time_in_force":   util.GTD,
+				"expires_at":      time.Now().Add(-time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "ExpiresAtWithoutGoodTillDate",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"expires_at":      time.Now().Add(time.Hour),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MarketGoodTillCanceled",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          bid.Amount,
+				"type":            util.MARKET,
+				"time_in_force":   util.GTC,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTimeInForce",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"time_in_force":   "DAY",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateStopBidAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.Pair = util.BTC_USDT
+	bid.Price = decimal.NewFromInt(100)
+	bid.Amount = decimal.NewFromInt(3)
+	bid.RemainingAmount = decimal.NewFromInt(3)
+	bid.Status = util.PENDING
+	bid.StopPrice = decimal.NewFromInt(90)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "StopLimit",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"type":            util.STOP_LIMIT,
+				"stop_price":      bid.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         bid.Price,
+					Amount:        bid.Amount,
+					Status:        util.PENDING,
+					Type:          util.STOP_LIMIT,
+					TimeInForce:   util.GTC,
+					StopPrice:     bid.StopPrice,
+				}
+
+				created := bid
+				created.Type = util.STOP_LIMIT
+
+				// nothing traded yet, so the bid waits for its trigger
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().TriggerBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.PENDING, gotBid.Status)
+				require.Equal(t, bid.StopPrice, gotBid.StopPrice)
+			},
+		},
+		{
+			name: "StopMarket",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          bid.Amount,
+				"type":            util.STOP_MARKET,
+				"stop_price":      bid.StopPrice,
+				"max_slippage":    1000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// the bid holds funds at the worst price it can reach once triggered
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         engine.StopMarketPrice(util.BID, bid.StopPrice, 1000, decimal.NewFromInt(1)),
+					Amount:        bid.Amount,
+					Status:        util.PENDING,
+					Type:          util.STOP_MARKET,
+					TimeInForce:   util.IOC,
+					StopPrice:     bid.StopPrice,
+				}
+
+				created := bid
+				created.Price = arg.Price
+				created.Type = util.STOP_MARKET
+				created.TimeInForce = util.IOC
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "StopMarketWithoutSlippage",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          bid.Amount,
+				"type":            util.STOP_MARKET,
+				"stop_price":      bid.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopWithoutStopPrice",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"type":            util.STOP_LIMIT,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopLimitWithoutPrice",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          bid.Amount,
+				"type":            util.STOP_LIMIT,
+				"stop_price":      bid.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopPriceWithoutStop",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"stop_price":      bid.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestCreateBidFlagsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+
+	crossing := &engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(90), Amount: decimal.NewFromInt(5)}
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.Pair = util.BTC_USDT
+	bid.Price = decimal.NewFromInt(100)
+	bid.Amount = decimal.NewFromInt(3)
+	bid.RemainingAmount = decimal.NewFromInt(3)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		resting       []*engine.Order
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "PostOnlyRejected",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"post_only":       true,
+			},
+			resting: []*engine.Order{crossing},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         bid.Price,
+					Amount:        bid.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+					PostOnly:      true,
+				}
+
+				created := bid
+				created.PostOnly = true
+
+				canceled := created
+				canceled.Status = util.CANCELED
+
+				// the bid would take the resting ask, so it is canceled without trading
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(canceled, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.CANCELED, gotBid.Status)
+				require.True(t, gotBid.PostOnly)
+			},
+		},
+		{
+			name: "Iceberg",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"display_amount":  1,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:          bid.Pair,
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Price:         bid.Price,
+					Amount:        bid.Amount,
+					Status:        util.ACTIVE,
+					Type:          util.LIMIT,
+					TimeInForce:   util.GTC,
+					DisplayAmount: decimal.NewFromInt(1),
+				}
+
+				created := bid
+				created.DisplayAmount = decimal.NewFromInt(1)
+
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: created}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "DisplayAmountTooLarge",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"display_amount":  bid.Amount,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "PostOnlyImmediateOrCancel",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"time_in_force":   util.IOC,
+				"post_only":       true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "HiddenMarket",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"amount":          bid.Amount,
+				"type":            util.MARKET,
+				"hidden":          true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "HiddenIceberg",
+			body: gin.H{
+				"pair":            bid.Pair,
+				"from_account_id": account1.ID,
+				"to_account_id":   account2.ID,
+				"price":           bid.Price,
+				"amount":          bid.Amount,
+				"display_amount":  1,
+				"hidden":          true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range tc.resting {
+				resting := *order
+				book.Add(&resting)
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestAmendBidAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account2.Currency = util.BTC
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.ID = 1
+	bid.Pair = util.BTC_USDT
+	bid.Price = decimal.NewFromInt(100)
+	bid.Amount = decimal.NewFromInt(5)
+	bid.RemainingAmount = decimal.NewFromInt(5)
+	bid.Status = util.ACTIVE
+
+	ask := &engine.Order{ID: 2, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(105), Amount: decimal.NewFromInt(2)}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		bid           func() db.Bid
+		buildStubs    func(store *mockdb.MockStore, bid db.Bid)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "ReduceAmount",
+			body: gin.H{"id": bid.ID, "amount": 3},
+			bid:  func() db.Bid { return bid },
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				amended := bid
+				amended.Amount = decimal.NewFromInt(3)
+				amended.RemainingAmount = decimal.NewFromInt(3)
+				store.EXPECT().AmendBidTx(gomock.Any(), gomock.Eq(db.AmendBidParams{ID: bid.ID, Price: bid.Price, Amount: decimal.NewFromInt(3)})).Times(1).
+					Return(db.AmendBidTxResult{Bid: amended}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, decimal.NewFromInt(3), gotBid.Amount)
+
+				require.Equal(t, bid.ID, book.Best(util.BID).ID)
+				require.Equal(t, decimal.NewFromInt(3), book.Best(util.BID).Amount)
+			},
+		},
+		{
+			name: "NewPriceTrades",
+			body: gin.H{"id": bid.ID, "price": ask.Price},
+			bid:  func() db.Bid { return bid },
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				amended := bid
+				amended.Price = ask.Price
+
+				filled := amended
+				filled.Status = util.PARTIALLY_FILLED
+				filled.FilledAmount = ask.Amount
+				filled.RemainingAmount = bid.Amount.Sub(ask.Amount)
+
+				// the bid is fetched again once it traded
+				gomock.InOrder(
+					store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil),
+					store.EXPECT().AmendBidTx(gomock.Any(), gomock.Eq(db.AmendBidParams{ID: bid.ID, Price: ask.Price, Amount: bid.Amount})).Times(1).
+						Return(db.AmendBidTxResult{Bid: amended}, nil),
+					store.EXPECT().FillTx(gomock.Any(), gomock.Eq(db.FillTxParams{BidID: bid.ID, AskID: ask.ID, Price: ask.Price, Amount: ask.Amount, TakerSide: util.BID})).Times(1),
+					store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(filled, nil),
+				)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.PARTIALLY_FILLED, gotBid.Status)
+
+				require.Empty(t, book.Orders(util.ASK))
+				require.Equal(t, bid.Amount.Sub(ask.Amount), book.Best(util.BID).Amount)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{"id": bid.ID, "amount": 50},
+			bid:  func() db.Bid { return bid },
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendBidTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AmendBidTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				require.Equal(t, bid.Amount, book.Best(util.BID).Amount)
+			},
+		},
+		{
+			name: "Completed",
+			body: gin.H{"id": bid.ID, "amount": 3},
+			bid: func() db.Bid {
+				completed := bid
+				completed.Status = util.COMPLETED
+				completed.FilledAmount = bid.Amount
+				completed.RemainingAmount = decimal.NewFromInt(0)
+				return completed
+			},
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "AmountNotAboveFilled",
+			body: gin.H{"id": bid.ID, "amount": 2},
+			bid: func() db.Bid {
+				partial := bid
+				partial.Status = util.PARTIALLY_FILLED
+				partial.FilledAmount = decimal.NewFromInt(2)
+				partial.RemainingAmount = decimal.NewFromInt(3)
+				return partial
+			},
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OrderGroupLeg",
+			body: gin.H{"id": bid.ID, "amount": 3},
+			bid: func() db.Bid {
+				leg := bid
+				leg.GroupID = sql.NullInt64{Int64: 1, Valid: true}
+				leg.GroupLeg = util.ENTRY_LEG
+				return leg
+			},
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().AmendBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "CancelAndAmend",
+			body: gin.H{"id": bid.ID, "status": util.CANCELED, "amount": 3},
+			bid:  func() db.Bid { return bid },
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NothingToUpdate",
+			body: gin.H{"id": bid.ID},
+			bid:  func() db.Bid { return bid },
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bid := tc.bid()
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, bid)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			resting := *ask
+			book.Add(&resting)
+			if util.IsOpenStatus(bid.Status) {
+				book.Add(&engine.Order{ID: bid.ID, Pair: bid.Pair, Side: util.BID, Type: bid.Type, Price: bid.Price, Amount: bid.RemainingAmount})
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
+
+func TestCreateBidSelfTradeAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+
+	ownAsk := &engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(90), Amount: decimal.NewFromInt(5), Owner: user.Username}
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.Pair = util.BTC_USDT
+	bid.Price = decimal.NewFromInt(100)
+	bid.Amount = decimal.NewFromInt(3)
+	bid.RemainingAmount = decimal.NewFromInt(3)
+	bid.Owner = user.Username
+	bid.SelfTradePrevention = util.CANCEL_BOTH
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "CancelBoth",
+			body: gin.H{
+				"pair":                  bid.Pair,
+				"from_account_id":       account1.ID,
+				"to_account_id":         account2.ID,
+				"price":                 bid.Price,
+				"amount":                bid.Amount,
+				"self_trade_prevention": util.CANCEL_BOTH,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.CreateBidParams{
+					Pair:                bid.Pair,
+					FromAccountID:       account1.ID,
+					ToAccountID:         account2.ID,
+					Price:               bid.Price,
+					Amount:              bid.Amount,
+					Status:              util.ACTIVE,
+					Type:                util.LIMIT,
+					TimeInForce:         util.GTC,
+					SelfTradePrevention: util.CANCEL_BOTH,
+				}
+
+				canceled := bid
+				canceled.Status = util.CANCELED
+
+				// the bid would take the own ask, so both are canceled without trading
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.CreateBidTxResult{Bid: bid}, nil)
+				store.EXPECT().SelfTradeTx(gomock.Any(), gomock.Eq(db.SelfTradeTxParams{
+					BidID:     bid.ID,
+					AskID:     ownAsk.ID,
+					TakerSide: util.BID,
+					Mode:      util.CANCEL_BOTH,
+				})).Times(1).Return(db.SelfTradeTxResult{
+					Bid:      canceled,
+					Ask:      db.Ask{ID: ownAsk.ID, Status: util.CANCELED},
+					Canceled: db.OrderGroupLegs{Bids: []db.Bid{canceled}, Asks: []db.Ask{{ID: ownAsk.ID, Status: util.CANCELED}}},
+				}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(canceled, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotBid db.Bid
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotBid)
+				require.NoError(t, err)
+				require.Equal(t, util.CANCELED, gotBid.Status)
+
+				require.Empty(t, book.Orders(util.BID))
+				require.Empty(t, book.Orders(util.ASK))
+			},
+		},
+		{
+			name: "InvalidSelfTradePrevention",
+			body: gin.H{
+				"pair":                  bid.Pair,
+				"from_account_id":       account1.ID,
+				"to_account_id":         account2.ID,
+				"price":                 bid.Price,
+				"amount":                bid.Amount,
+				"self_trade_prevention": "invalid",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Len(t, book.Orders(util.ASK), 1)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			resting := *ownAsk
+			book.Add(&resting)
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/bids"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
+
+func TestCreateBidSizeAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account2.Currency = util.BTC
+	account1.Balance = decimal.NewFromInt(100000)
+
+	pair := db.Pair{Symbol: util.BTC_USDT, Base: util.BTC, Quote: util.USDT, TickSize: decimal.NewFromInt(5), LotSize: decimal.NewFromInt(10), MinNotional: decimal.NewFromInt(1000), Status: util.ACTIVE}
+
+	testCases := []struct {
+		name       string
+		body       gin.H
+		asks       []db.Ask
+		fields     []string
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"price": 100, "amount": 10},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.CreateBidTxResult{Bid: db.Bid{ID: 1, Pair: pair.Symbol, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(10), RemainingAmount: decimal.NewFromInt(10), Status: util.ACTIVE}}, nil)
+			},
+		},
+		{
+			name:   "PriceOffTick",
+			body:   gin.H{"price": 101, "amount": 10},
+			fields: []string{"price"},
+		},
+		{
+			name:   "AmountOffLot",
+			body:   gin.H{"price": 100, "amount": 15},
+			fields: []string{"amount"},
+		},
+		{
+			name:   "BelowMinNotional",
+			body:   gin.H{"price": 50, "amount": 10},
+			fields: []string{"amount"},
+		},
+		{
+			name:   "StopLimit",
+			body:   gin.H{"type": util.STOP_LIMIT, "price": 102, "stop_price": 103, "amount": 10, "display_amount": 3},
+			fields: []string{"price", "stop_price", "display_amount"},
+		},
+		{
+			name:   "MarketAmountOffLot",
+			body:   gin.H{"type": util.MARKET, "amount": 1},
+			fields: []string{"amount"},
+		},
+		{
+			name: "MarketQuoteBelowMinNotional",
+			body: gin.H{"type": util.MARKET, "amount": 20},
+			// the book only has 10 to sell, worth less than the minimum notional
+			asks:   []db.Ask{{ID: 1, Pair: pair.Symbol, Type: util.LIMIT, TimeInForce: util.GTC, Price: decimal.NewFromInt(50), RemainingAmount: decimal.NewFromInt(10), Status: util.ACTIVE}},
+			fields: []string{"amount"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			if tc.buildStubs != nil {
+				tc.buildStubs(store)
+			}
+
+			server := newTestServer(t, store)
+			server.registry.SetPair(pair)
+			for _, ask := range tc.asks {
+				_, err := server.engine.PlaceAsk(context.Background(), ask)
+				require.NoError(t, err)
+			}
+			recorder := httptest.NewRecorder()
+
+			body := gin.H{"pair": pair.Symbol, "from_account_id": account1.ID, "to_account_id": account2.ID}
+			for key, value := range tc.body {
+				body[key] = value
+			}
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/bids", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.fields == nil {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				return
+			}
+			require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+			var rsp struct {
+				FieldViolations []fieldViolation `json:"field_violations"`
+			}
+			err = json.Unmarshal(recorder.Body.Bytes(), &rsp)
+			require.NoError(t, err)
+
+			fields := make([]string, 0, len(rsp.FieldViolations))
+			for _, violation := range rsp.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+			require.Equal(t, tc.fields, fields)
+		})
+	}
+}
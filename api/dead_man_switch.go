@@ -0,0 +1,132 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"go-exchange/token"
+	"net/http"
+	"time"
+
+	db "go-exchange/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST http://localhost:8080/dead_man_switch
+type armDeadManSwitchRequest struct {
+	TimeoutSeconds int64 `json:"timeout_seconds" binding:"required,min=1,max=86400"`
+}
+
+// armDeadManSwitch arms the dead man's switch of the authenticated user, or re-arms it with a new timeout.
+// Every open order of the user is canceled if no heartbeat arrives before the timeout
+func (server *Server) armDeadManSwitch(ctx *gin.Context) {
+	var req armDeadManSwitchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.ArmDeadManSwitchTxParams{
+		Username:       authPayload.Username,
+		TimeoutSeconds: req.TimeoutSeconds,
+		Now:            time.Now(),
+	}
+
+	result, err := server.store.ArmDeadManSwitchTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result.DeadManSwitch)
+}
+
+// POST http://localhost:8080/dead_man_switch/heartbeat
+func (server *Server) refreshDeadManSwitch(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.RefreshDeadManSwitchTxParams{
+		Username: authPayload.Username,
+		Now:      time.Now(),
+	}
+
+	result, err := server.store.RefreshDeadManSwitchTx(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("dead man's switch is not armed")))
+			return
+		}
+		if errors.Is(err, db.ErrDeadManSwitchExpired) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result.DeadManSwitch)
+}
+
+// GET http://localhost:8080/dead_man_switch
+func (server *Server) getDeadManSwitch(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	deadManSwitch, err := server.store.GetDeadManSwitch(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("dead man's switch is not armed")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deadManSwitch)
+}
+
+// DELETE http://localhost:8080/dead_man_switch
+func (server *Server) disarmDeadManSwitch(ctx *gin.Context) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	result, err := server.store.DisarmDeadManSwitchTx(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("dead man's switch is not armed")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result.DeadManSwitch)
+}
+
+// GET http://localhost:8080/dead_man_switch/events?page_id=1&page_size=5
+type listDeadManSwitchEventsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=1,max=10"`
+}
+
+// listDeadManSwitchEvents lists the audit trail of the dead man's switch of the authenticated user, newest first
+func (server *Server) listDeadManSwitchEvents(ctx *gin.Context) {
+	var req listDeadManSwitchEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.ListDeadManSwitchEventsParams{
+		Username: authPayload.Username,
+		Limit:    req.PageSize,
+		Offset:   (req.PageID - 1) * req.PageSize,
+	}
+
+	events, err := server.store.ListDeadManSwitchEvents(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomDeadManSwitch(username string) db.DeadManSwitch {
+	return db.DeadManSwitch{
+		Username:       username,
+		TimeoutSeconds: 30,
+		ExpiresAt:      time.Now().Add(30 * time.Second).UTC().Truncate(time.Second),
+	}
+}
+
+func requireBodyMatchDeadManSwitch(t *testing.T, body *bytes.Buffer, deadManSwitch db.DeadManSwitch) {
+	var got db.DeadManSwitch
+	err := json.Unmarshal(body.Bytes(), &got)
+	require.NoError(t, err)
+	require.Equal(t, deadManSwitch.Username, got.Username)
+	require.Equal(t, deadManSwitch.TimeoutSeconds, got.TimeoutSeconds)
+	require.WithinDuration(t, deadManSwitch.ExpiresAt, got.ExpiresAt, time.Second)
+}
+
+func TestArmDeadManSwitchAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	deadManSwitch := randomDeadManSwitch(user.Username)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"timeout_seconds": deadManSwitch.TimeoutSeconds},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ArmDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ any, arg db.ArmDeadManSwitchTxParams) (db.DeadManSwitchTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, deadManSwitch.TimeoutSeconds, arg.TimeoutSeconds)
+						require.WithinDuration(t, time.Now(), arg.Now, time.Second)
+						return db.DeadManSwitchTxResult{DeadManSwitch: deadManSwitch}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDeadManSwitch(t, recorder.Body, deadManSwitch)
+			},
+		},
+		{
+			name: "InvalidTimeout",
+			body: gin.H{"timeout_seconds": 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ArmDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TimeoutTooLong",
+			body: gin.H{"timeout_seconds": 86401},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ArmDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"timeout_seconds": deadManSwitch.TimeoutSeconds},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ArmDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.DeadManSwitchTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/dead_man_switch", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestRefreshDeadManSwitchAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	deadManSwitch := randomDeadManSwitch(user.Username)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RefreshDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ any, arg db.RefreshDeadManSwitchTxParams) (db.DeadManSwitchTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.WithinDuration(t, time.Now(), arg.Now, time.Second)
+						return db.DeadManSwitchTxResult{DeadManSwitch: deadManSwitch}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDeadManSwitch(t, recorder.Body, deadManSwitch)
+			},
+		},
+		{
+			name: "NotArmed",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RefreshDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.DeadManSwitchTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Expired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RefreshDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.DeadManSwitchTxResult{}, db.ErrDeadManSwitchExpired)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().RefreshDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.DeadManSwitchTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/dead_man_switch/heartbeat", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDisarmDeadManSwitchAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	deadManSwitch := randomDeadManSwitch(user.Username)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DisarmDeadManSwitchTx(gomock.Any(), gomock.Eq(user.Username)).Times(1).
+					Return(db.DeadManSwitchTxResult{DeadManSwitch: deadManSwitch}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchDeadManSwitch(t, recorder.Body, deadManSwitch)
+			},
+		},
+		{
+			name: "NotArmed",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DisarmDeadManSwitchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.DeadManSwitchTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodDelete, "/dead_man_switch", nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
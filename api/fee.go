@@ -0,0 +1,103 @@
+package api
+
+import (
+	"database/sql"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/val"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+// GET http://localhost:8080/fee_tiers?pair=BTC/USDT
+type listFeeTiersRequest struct {
+	Pair string `form:"pair" binding:"required,listed_pair"`
+}
+
+func (server *Server) listFeeTiers(ctx *gin.Context) {
+	var req listFeeTiersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	feeTiers, err := server.store.ListFeeTiers(ctx, req.Pair)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, feeTiers)
+}
+
+// POST http://localhost:8080/admin/fee_tiers
+type createFeeTierRequest struct {
+	Pair      string          `json:"pair" binding:"required,listed_pair"`
+	MinVolume decimal.Decimal `json:"min_volume" binding:"omitempty,min=0"`
+	MakerRate *int64          `json:"maker_rate" binding:"required,min=0,max=10000"`
+	TakerRate *int64          `json:"taker_rate" binding:"required,min=0,max=10000"`
+}
+
+// createFeeTier adds a tier to the fee schedule of a pair, for the owners whose trailing 30-day volume reaches its min volume
+func (server *Server) createFeeTier(ctx *gin.Context) {
+	var req createFeeTierRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pair, _ := server.registry.Pair(req.Pair)
+	quote, _ := server.registry.Currency(pair.Quote)
+	if err := val.ValidateDecimals(req.MinVolume, quote); err != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse([]fieldViolation{newFieldViolation("min_volume", err)}))
+		return
+	}
+
+	feeTier, err := server.store.CreateFeeTier(ctx, db.CreateFeeTierParams{
+		Pair:      req.Pair,
+		MinVolume: req.MinVolume,
+		MakerRate: *req.MakerRate,
+		TakerRate: *req.TakerRate,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				ctx.JSON(http.StatusForbidden, errorResponse(err))
+				return
+			}
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, feeTier)
+}
+
+// DELETE http://localhost:8080/admin/fee_tiers/1
+type deleteFeeTierRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// deleteFeeTier removes a tier from the fee schedule of its pair, a pair left without tiers trades for free
+func (server *Server) deleteFeeTier(ctx *gin.Context) {
+	var req deleteFeeTierRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	feeTier, err := server.store.DeleteFeeTier(ctx, req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, feeTier)
+}
@@ -0,0 +1,324 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func randomFeeTier(pair string, minVolume int64) db.FeeTier {
+	return db.FeeTier{
+		ID:        util.RandomInt(1, 1000),
+		Pair:      pair,
+		MinVolume: decimal.NewFromInt(minVolume),
+		MakerRate: util.RandomInt(0, 10),
+		TakerRate: util.RandomInt(10, 20),
+	}
+}
+
+func requireBodyMatchFeeTiers(t *testing.T, body *bytes.Buffer, feeTiers []db.FeeTier) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotFeeTiers []db.FeeTier
+	err = json.Unmarshal(data, &gotFeeTiers)
+	require.NoError(t, err)
+	require.Equal(t, feeTiers, gotFeeTiers)
+}
+
+func TestListFeeTiersAPI(t *testing.T) {
+	pair := util.BTC_USDT
+	feeTiers := []db.FeeTier{
+		randomFeeTier(pair, 0),
+		randomFeeTier(pair, 1000000),
+	}
+
+	testCases := []struct {
+		name          string
+		pair          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			pair: pair,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListFeeTiers(gomock.Any(), gomock.Eq(pair)).Times(1).Return(feeTiers, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchFeeTiers(t, recorder.Body, feeTiers)
+			},
+		},
+		{
+			name: "InvalidPair",
+			pair: "BTC/XYZ",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListFeeTiers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			pair: pair,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListFeeTiers(gomock.Any(), gomock.Any()).Times(1).Return([]db.FeeTier{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/fee_tiers?pair="+url.QueryEscape(tc.pair), nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func requireBodyMatchFeeTier(t *testing.T, body *bytes.Buffer, feeTier db.FeeTier) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotFeeTier db.FeeTier
+	err = json.Unmarshal(data, &gotFeeTier)
+	require.NoError(t, err)
+	require.Equal(t, feeTier, gotFeeTier)
+}
+
+func TestCreateFeeTierAPI(t *testing.T) {
+	feeTier := randomFeeTier(util.BTC_USDT, 1000000)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"pair": feeTier.Pair, "min_volume": feeTier.MinVolume, "maker_rate": feeTier.MakerRate, "taker_rate": feeTier.TakerRate},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateFeeTierParams{
+					Pair:      feeTier.Pair,
+					MinVolume: feeTier.MinVolume,
+					MakerRate: feeTier.MakerRate,
+					TakerRate: feeTier.TakerRate,
+				}
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Eq(arg)).Times(1).Return(feeTier, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchFeeTier(t, recorder.Body, feeTier)
+			},
+		},
+		{
+			name: "NotAdmin",
+			body: gin.H{"pair": feeTier.Pair, "maker_rate": feeTier.MakerRate, "taker_rate": feeTier.TakerRate},
+			role: util.TRADER,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPair",
+			body: gin.H{"pair": "BTC/XYZ", "maker_rate": feeTier.MakerRate, "taker_rate": feeTier.TakerRate},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingRate",
+			body: gin.H{"pair": feeTier.Pair, "maker_rate": feeTier.MakerRate},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "RateAboveWholeAmount",
+			body: gin.H{"pair": feeTier.Pair, "maker_rate": feeTier.MakerRate, "taker_rate": 10001},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MinVolumeExceedsDecimals",
+			body: gin.H{"pair": feeTier.Pair, "min_volume": "0.0000001", "maker_rate": feeTier.MakerRate, "taker_rate": feeTier.TakerRate},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateMinVolume",
+			body: gin.H{"pair": feeTier.Pair, "min_volume": feeTier.MinVolume, "maker_rate": feeTier.MakerRate, "taker_rate": feeTier.TakerRate},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateFeeTier(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.FeeTier{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/fee_tiers", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "admin", tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestDeleteFeeTierAPI(t *testing.T) {
+	feeTier := randomFeeTier(util.BTC_USDT, 0)
+
+	testCases := []struct {
+		name          string
+		feeTierID     int64
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			feeTierID: feeTier.ID,
+			role:      util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeTier(gomock.Any(), gomock.Eq(feeTier.ID)).Times(1).Return(feeTier, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchFeeTier(t, recorder.Body, feeTier)
+			},
+		},
+		{
+			name:      "NotAdmin",
+			feeTierID: feeTier.ID,
+			role:      util.TRADER,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			feeTierID: feeTier.ID,
+			role:      util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeTier(gomock.Any(), gomock.Eq(feeTier.ID)).Times(1).Return(db.FeeTier{}, sql.ErrNoRows)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "InvalidID",
+			feeTierID: 0,
+			role:      util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteFeeTier(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("/admin/fee_tiers/%d", tc.feeTierID), nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "admin", tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
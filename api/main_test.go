@@ -2,6 +2,10 @@ package api
 
 import (
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/feed"
+	"go-exchange/registry"
 	"go-exchange/util"
 	"os"
 	"testing"
@@ -11,13 +15,37 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// newTestRegistry lists the currencies and pairs seeded by the registry migration
+func newTestRegistry() *registry.Registry {
+	registry := registry.NewRegistry()
+	decimals := map[string]int64{
+		util.BRL: 2, util.CAD: 2, util.EUR: 2, util.JPY: 0, util.USD: 2,
+		util.BTC: 8, util.ETH: 18, util.MATIC: 18, util.SOL: 9, util.USDT: 6,
+	}
+	for code, places := range decimals {
+		registry.SetCurrency(db.Currency{Code: code, Decimals: places, Status: util.ACTIVE})
+	}
+
+	for _, symbol := range []string{
+		util.USDT_BRL, util.USDT_CAD, util.USDT_EUR, util.USDT_JPY, util.USDT_USD,
+		util.BTC_USDT, util.ETH_USDT, util.MATIC_USDT, util.SOL_USDT,
+		util.ETH_BTC, util.MATIC_BTC, util.SOL_BTC,
+		util.MATIC_ETH, util.SOL_ETH,
+	} {
+		base, quote := util.CurrenciesFromPair(symbol)
+		registry.SetPair(db.Pair{Symbol: symbol, Base: base, Quote: quote, TickSize: decimal.NewFromInt(1), LotSize: decimal.NewFromInt(1), Status: util.ACTIVE})
+	}
+	return registry
+}
+
 func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store)
+	registry := newTestRegistry()
+	server, err := NewServer(config, store, engine.NewEngine(store, registry, feed.NewHub()), registry)
 	require.NoError(t, err)
 
 	return server
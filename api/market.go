@@ -0,0 +1,228 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/engine"
+	"go-exchange/util"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultBookDepth is the number of price levels of each side returned when no depth is given
+const defaultBookDepth = 20
+
+// maxCandles is the most candles returned at once
+const maxCandles = 1000
+
+// defaultTradeTape is the number of recent trades returned when no limit is given
+const defaultTradeTape = 50
+
+// marketRequest is the pair in the path of the public market routes, like /markets/BTC/USDT
+type marketRequest struct {
+	Base  string `uri:"base" binding:"required"`
+	Quote string `uri:"quote" binding:"required"`
+}
+
+// validMarket binds the pair of a market route and responds with not found if the pair isn't listed
+func (server *Server) validMarket(ctx *gin.Context) (string, bool) {
+	var uri marketRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return "", false
+	}
+
+	pair := fmt.Sprintf("%s/%s", uri.Base, uri.Quote)
+	if !server.registry.IsListedPair(pair) {
+		err := fmt.Errorf("pair %s is not listed", pair)
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return "", false
+	}
+	return pair, true
+}
+
+// GET http://localhost:8080/markets/BTC/USDT/book?depth=10
+type getOrderBookRequest struct {
+	Depth int `form:"depth" binding:"omitempty,min=1,max=100"`
+}
+
+// getOrderBook responds with the price levels of both sides of the order book of a pair, best prices first.
+// Only what is displayed is aggregated, so hidden orders and the reserves of iceberg orders are left out
+func (server *Server) getOrderBook(ctx *gin.Context) {
+	pair, valid := server.validMarket(ctx)
+	if !valid {
+		return
+	}
+
+	var req getOrderBookRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.Depth == 0 {
+		req.Depth = defaultBookDepth
+	}
+
+	depth, err := server.engine.Depth(pair, req.Depth)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, depth)
+}
+
+// GET http://localhost:8080/tickers
+func (server *Server) listTickers(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, server.engine.Tickers())
+}
+
+// GET http://localhost:8080/markets/BTC/USDT/ticker
+func (server *Server) getTicker(ctx *gin.Context) {
+	pair, valid := server.validMarket(ctx)
+	if !valid {
+		return
+	}
+
+	ticker, err := server.engine.Ticker(pair)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ticker)
+}
+
+// GET http://localhost:8080/markets/BTC/USDT/trades?limit=100
+type listMarketTradesRequest struct {
+	Limit int32 `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+// listMarketTrades responds with the most recent trades of the order book of a pair, newest first
+func (server *Server) listMarketTrades(ctx *gin.Context) {
+	pair, valid := server.validMarket(ctx)
+	if !valid {
+		return
+	}
+
+	var req listMarketTradesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultTradeTape
+	}
+
+	trades, err := server.store.ListPairTrades(ctx, db.ListPairTradesParams{
+		Pair:  pair,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]engine.MarketTrade, 0, len(trades))
+	for _, trade := range trades {
+		rsp = append(rsp, engine.NewMarketTrade(trade))
+	}
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// GET http://localhost:8080/markets/BTC/USDT/candles?interval=1h&from=1700000000&to=1700086400
+type listCandlesRequest struct {
+	Interval string    `form:"interval" binding:"required,candle_interval"`
+	From     time.Time `form:"from" time_format:"unix"`
+	To       time.Time `form:"to" time_format:"unix"`
+}
+
+// listCandles responds with the candles of a pair opened between from and to, oldest first.
+// Without to it ends now, and without from it starts maxCandles intervals before to.
+// Intervals without trades have no candle
+func (server *Server) listCandles(ctx *gin.Context) {
+	pair, valid := server.validMarket(ctx)
+	if !valid {
+		return
+	}
+
+	var req listCandlesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	duration := util.CandleIntervalDuration(req.Interval)
+	if req.To.IsZero() {
+		req.To = time.Now()
+	}
+	if req.From.IsZero() {
+		req.From = req.To.Add(-maxCandles * duration)
+	}
+
+	if !req.From.Before(req.To) {
+		violation := newFieldViolation("from", errors.New("must be before to"))
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse([]fieldViolation{violation}))
+		return
+	}
+
+	arg := db.ListCandlesParams{
+		Pair:     pair,
+		Interval: req.Interval,
+		FromTime: req.From.Truncate(duration),
+		ToTime:   req.To,
+		Limit:    maxCandles,
+	}
+
+	candles, err := server.store.ListCandles(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, candles)
+}
+
+// POST http://localhost:8080/admin/candles/backfill
+type backfillCandlesRequest struct {
+	Pair     string    `json:"pair" binding:"required,listed_pair"`
+	FromTime time.Time `json:"from_time" binding:"required"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+// backfillCandles rebuilds the candles of every interval of a pair from the trades executed between two times,
+// up to now when no end is given
+func (server *Server) backfillCandles(ctx *gin.Context) {
+	var req backfillCandlesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.ToTime.IsZero() {
+		req.ToTime = time.Now()
+	}
+
+	if !req.FromTime.Before(req.ToTime) {
+		violation := newFieldViolation("from_time", errors.New("must be before to_time"))
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse([]fieldViolation{violation}))
+		return
+	}
+
+	result, err := server.store.BackfillCandlesTx(ctx, db.BackfillCandlesTxParams{
+		Pair:     req.Pair,
+		FromTime: req.FromTime,
+		ToTime:   req.ToTime,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,543 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderBookAPI(t *testing.T) {
+	orders := []*engine.Order{
+		{ID: 1, Pair: util.BTC_USDT, Side: util.BID, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(2)},
+		{ID: 2, Pair: util.BTC_USDT, Side: util.BID, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(3)},
+		{ID: 3, Pair: util.BTC_USDT, Side: util.BID, Price: decimal.NewFromInt(90), Amount: decimal.NewFromInt(1)},
+		{ID: 4, Pair: util.BTC_USDT, Side: util.ASK, Price: decimal.NewFromInt(110), Amount: decimal.NewFromInt(10), DisplayAmount: decimal.NewFromInt(4)},
+		{ID: 5, Pair: util.BTC_USDT, Side: util.ASK, Price: decimal.NewFromInt(120), Amount: decimal.NewFromInt(5), Hidden: true},
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			url:  "/markets/BTC/USDT/book",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var depth engine.Depth
+				err := json.Unmarshal(recorder.Body.Bytes(), &depth)
+				require.NoError(t, err)
+				require.Equal(t, engine.Depth{
+					Pair: util.BTC_USDT,
+					Bids: []engine.PriceLevel{
+						{Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(5), OrderCount: 2},
+						{Price: decimal.NewFromInt(90), Amount: decimal.NewFromInt(1), OrderCount: 1},
+					},
+					Asks: []engine.PriceLevel{
+						{Price: decimal.NewFromInt(110), Amount: decimal.NewFromInt(4), OrderCount: 1},
+					},
+				}, depth)
+			},
+		},
+		{
+			name: "Depth",
+			url:  "/markets/BTC/USDT/book?depth=1",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var depth engine.Depth
+				err := json.Unmarshal(recorder.Body.Bytes(), &depth)
+				require.NoError(t, err)
+				require.Len(t, depth.Bids, 1)
+				require.Len(t, depth.Asks, 1)
+			},
+		},
+		{
+			name: "EmptyBook",
+			url:  "/markets/ETH/BTC/book",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `{"pair":"ETH/BTC","sequence":0,"bids":[],"asks":[]}`, recorder.Body.String())
+			},
+		},
+		{
+			name: "InvalidDepth",
+			url:  "/markets/BTC/USDT/book?depth=1000",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedPair",
+			url:  "/markets/XYZ/USDT/book",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store)
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range orders {
+				order := *order
+				book.Add(&order)
+			}
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListCandlesAPI(t *testing.T) {
+	to := time.Unix(1700003600, 0)
+	candles := []db.Candle{
+		{
+			Pair:        util.BTC_USDT,
+			Interval:    util.ONE_HOUR,
+			OpenTime:    to.Add(-time.Hour),
+			Open:        decimal.NewFromInt(10),
+			High:        decimal.NewFromInt(14),
+			Low:         decimal.NewFromInt(8),
+			Close:       decimal.NewFromInt(12),
+			Volume:      decimal.NewFromInt(8),
+			QuoteVolume: decimal.NewFromInt(88),
+			TradeCount:  4,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			url:  "/markets/BTC/USDT/candles?interval=1h&from=1699995000&to=1700003600",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListCandlesParams{
+					Pair:     util.BTC_USDT,
+					Interval: util.ONE_HOUR,
+					FromTime: time.Unix(1699992000, 0),
+					ToTime:   to,
+					Limit:    maxCandles,
+				}
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Eq(arg)).Times(1).Return(candles, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotCandles []db.Candle
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotCandles)
+				require.NoError(t, err)
+				require.Len(t, gotCandles, 1)
+				require.Equal(t, candles[0].Close, gotCandles[0].Close)
+				require.True(t, candles[0].OpenTime.Equal(gotCandles[0].OpenTime))
+			},
+		},
+		{
+			name: "NoFrom",
+			url:  "/markets/BTC/USDT/candles?interval=1d&to=1700003600",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListCandlesParams{
+					Pair:     util.BTC_USDT,
+					Interval: util.ONE_DAY,
+					FromTime: to.Add(-maxCandles * 24 * time.Hour).Truncate(24 * time.Hour),
+					ToTime:   to,
+					Limit:    maxCandles,
+				}
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Candle{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidInterval",
+			url:  "/markets/BTC/USDT/candles?interval=2h",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "FromAfterTo",
+			url:  "/markets/BTC/USDT/candles?interval=1m&from=1700003600&to=1700000000",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedPair",
+			url:  "/markets/XYZ/USDT/candles?interval=1m",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			url:  "/markets/BTC/USDT/candles?interval=1m",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListCandles(gomock.Any(), gomock.Any()).Times(1).Return([]db.Candle{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestBackfillCandlesAPI(t *testing.T) {
+	fromTime := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	toTime := fromTime.Add(24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"pair": util.BTC_USDT, "from_time": fromTime, "to_time": toTime},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.BackfillCandlesTxParams{
+					Pair:     util.BTC_USDT,
+					FromTime: fromTime,
+					ToTime:   toTime,
+				}
+				result := db.BackfillCandlesTxResult{Candles: map[string]int64{util.ONE_DAY: 1}}
+				store.EXPECT().BackfillCandlesTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotAdmin",
+			body: gin.H{"pair": util.BTC_USDT, "from_time": fromTime, "to_time": toTime},
+			role: util.TRADER,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BackfillCandlesTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedPair",
+			body: gin.H{"pair": "XYZ/USDT", "from_time": fromTime, "to_time": toTime},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BackfillCandlesTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "FromAfterTo",
+			body: gin.H{"pair": util.BTC_USDT, "from_time": toTime, "to_time": fromTime},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BackfillCandlesTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"pair": util.BTC_USDT, "from_time": fromTime},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().BackfillCandlesTx(gomock.Any(), gomock.Any()).Times(1).Return(db.BackfillCandlesTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/candles/backfill", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "admin", tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestGetTickerAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		url           string
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			url:  "/markets/BTC/USDT/ticker",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var ticker engine.Ticker
+				err := json.Unmarshal(recorder.Body.Bytes(), &ticker)
+				require.NoError(t, err)
+				require.Equal(t, util.BTC_USDT, ticker.Pair)
+				require.Equal(t, decimal.NewFromInt(100), ticker.BestBid)
+				require.True(t, ticker.BestAsk.IsZero())
+			},
+		},
+		{
+			name: "UnlistedPair",
+			url:  "/markets/XYZ/USDT/ticker",
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store)
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			book.Add(&engine.Order{ID: 1, Pair: util.BTC_USDT, Side: util.BID, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(2)})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+func TestListTickersAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, store)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/tickers", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var tickers []engine.Ticker
+	err = json.Unmarshal(recorder.Body.Bytes(), &tickers)
+	require.NoError(t, err)
+	require.Len(t, tickers, len(server.registry.Pairs()))
+	for _, ticker := range tickers {
+		require.True(t, server.registry.IsListedPair(ticker.Pair))
+	}
+}
+
+func TestListMarketTradesAPI(t *testing.T) {
+	trade := db.Trade{
+		ID:                  util.RandomInt(1, 1000),
+		FirstFromAccountID:  util.RandomInt(1, 1000),
+		FirstToAccountID:    util.RandomInt(1, 1000),
+		FirstAmount:         decimal.NewFromInt(250),
+		SecondFromAccountID: util.RandomInt(1, 1000),
+		SecondToAccountID:   util.RandomInt(1, 1000),
+		SecondAmount:        decimal.NewFromInt(5),
+		Pair:                sql.NullString{String: util.BTC_USDT, Valid: true},
+		Price:               decimal.NullDecimal{Decimal: decimal.NewFromInt(50), Valid: true},
+		TakerSide:           sql.NullString{String: util.ASK, Valid: true},
+		MakerOrderID:        sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true},
+		TakerOrderID:        sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			url:  "/markets/BTC/USDT/trades?limit=10",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListPairTradesParams{
+					Pair:  util.BTC_USDT,
+					Limit: 10,
+				}
+				store.EXPECT().ListPairTrades(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Trade{trade}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var tape []engine.MarketTrade
+				err := json.Unmarshal(recorder.Body.Bytes(), &tape)
+				require.NoError(t, err)
+				require.Len(t, tape, 1)
+				require.Equal(t, trade.ID, tape[0].ID)
+				require.Equal(t, util.BTC_USDT, tape[0].Pair)
+				require.Equal(t, decimal.NewFromInt(50), tape[0].Price)
+				require.Equal(t, decimal.NewFromInt(5), tape[0].Amount)
+				require.Equal(t, decimal.NewFromInt(250), tape[0].QuoteAmount)
+				require.Equal(t, util.ASK, tape[0].TakerSide)
+
+				// the accounts and orders of the trade stay private
+				require.NotContains(t, recorder.Body.String(), "account_id")
+				require.NotContains(t, recorder.Body.String(), "order_id")
+			},
+		},
+		{
+			name: "DefaultLimit",
+			url:  "/markets/BTC/USDT/trades",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListPairTradesParams{
+					Pair:  util.BTC_USDT,
+					Limit: defaultTradeTape,
+				}
+				store.EXPECT().ListPairTrades(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Trade{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, `[]`, recorder.Body.String())
+			},
+		},
+		{
+			name: "InvalidLimit",
+			url:  "/markets/BTC/USDT/trades?limit=1000",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedPair",
+			url:  "/markets/XYZ/USDT/trades",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			url:  "/markets/BTC/USDT/trades",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).Times(1).Return([]db.Trade{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"go-exchange/token"
+	"go-exchange/util"
 	"net/http"
 	"strings"
 
@@ -52,3 +53,18 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// adminMiddleware creates a gin middleware that only lets admins through.
+// It must run after authMiddleware
+func adminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+		if payload.Role != util.ADMIN {
+			err := errors.New("only admins can access this resource")
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.Next()
+	}
+}
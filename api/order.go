@@ -0,0 +1,166 @@
+package api
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/token"
+	"go-exchange/val"
+	"net/http"
+
+	db "go-exchange/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST http://localhost:8080/orders/cancel
+type cancelOrdersRequest struct {
+	Pair      string `json:"pair" binding:"omitempty,listed_pair"`
+	Side      string `json:"side" binding:"omitempty,side"`
+	AccountID int64  `json:"account_id" binding:"omitempty,min=1"`
+}
+
+type cancelOrdersResponse struct {
+	BidIDs []int64 `json:"bid_ids"`
+	AskIDs []int64 `json:"ask_ids"`
+}
+
+// cancelOrders cancels every open order of the authenticated user, optionally only those of a pair, a side or an account
+func (server *Server) cancelOrders(ctx *gin.Context) {
+	var req cancelOrdersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.AccountID != 0 {
+		_, err := server.verifyAccountOwner(ctx, req.AccountID)
+		if err != nil {
+			return
+		}
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	arg := db.CancelOrdersTxParams{
+		Owner:     authPayload.Username,
+		Pair:      req.Pair,
+		Side:      req.Side,
+		AccountID: req.AccountID,
+	}
+
+	result, err := server.engine.CancelOrders(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := cancelOrdersResponse{
+		BidIDs: make([]int64, 0, len(result.Bids)),
+		AskIDs: make([]int64, 0, len(result.Asks)),
+	}
+	for _, bid := range result.Bids {
+		rsp.BidIDs = append(rsp.BidIDs, bid.ID)
+	}
+	for _, ask := range result.Asks {
+		rsp.AskIDs = append(rsp.AskIDs, ask.ID)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type listOrderEventsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=1,max=10"`
+}
+
+// listOrderEvents responds with a page of the event history of an order, oldest first
+func (server *Server) listOrderEvents(ctx *gin.Context, side string, id int64, req listOrderEventsRequest) {
+	arg := db.ListOrderEventsParams{
+		Side:    side,
+		OrderID: id,
+		Limit:   req.PageSize,
+		Offset:  (req.PageID - 1) * req.PageSize,
+	}
+
+	events, err := server.store.ListOrderEvents(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, events)
+}
+
+// orderSize holds the prices and amounts of an order to check against the trading rules of its pair.
+// Zero prices and amounts aren't checked, notionalAmount at notionalPrice is what the order is worth
+type orderSize struct {
+	price          decimal.Decimal
+	stopPrice      decimal.Decimal
+	amount         decimal.Decimal
+	displayAmount  decimal.Decimal
+	notionalAmount decimal.Decimal
+	notionalPrice  decimal.Decimal
+}
+
+// orderSizeViolations checks prices are multiples of the tick size of the pair, amounts multiples of its lot size
+// and the order is worth at least its minimum notional
+func orderSizeViolations(pair db.Pair, size orderSize) (violations []fieldViolation) {
+	if !size.price.IsZero() {
+		if err := val.ValidateTickSize(size.price, pair); err != nil {
+			violations = append(violations, newFieldViolation("price", err))
+		}
+	}
+
+	if !size.stopPrice.IsZero() {
+		if err := val.ValidateTickSize(size.stopPrice, pair); err != nil {
+			violations = append(violations, newFieldViolation("stop_price", err))
+		}
+	}
+
+	if !size.amount.IsZero() {
+		if err := val.ValidateLotSize(size.amount, pair); err != nil {
+			violations = append(violations, newFieldViolation("amount", err))
+		}
+	}
+
+	if !size.displayAmount.IsZero() {
+		if err := val.ValidateLotSize(size.displayAmount, pair); err != nil {
+			violations = append(violations, newFieldViolation("display_amount", err))
+		}
+	}
+
+	if !size.notionalAmount.IsZero() && !size.notionalPrice.IsZero() {
+		if err := val.ValidateMinNotional(size.notionalAmount, size.notionalPrice, pair); err != nil {
+			violations = append(violations, newFieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
+
+// newOrderSize returns the size of a new order, which is worth its amount at its limit price or else at its stop price.
+// Market orders have neither until they are quoted, so only their amount is checked
+func newOrderSize(price decimal.Decimal, stopPrice decimal.Decimal, amount decimal.Decimal, displayAmount decimal.Decimal) orderSize {
+	notionalPrice := price
+	if notionalPrice.IsZero() {
+		notionalPrice = stopPrice
+	}
+
+	return orderSize{
+		price:          price,
+		stopPrice:      stopPrice,
+		amount:         amount,
+		displayAmount:  displayAmount,
+		notionalAmount: amount,
+		notionalPrice:  notionalPrice,
+	}
+}
+
+// validOrderSize responds with the field violations of an order that doesn't meet the trading rules of its pair
+func (server *Server) validOrderSize(ctx *gin.Context, symbol string, size orderSize) bool {
+	pair, _ := server.registry.Pair(symbol)
+
+	if violations := orderSizeViolations(pair, size); violations != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse(violations))
+		return false
+	}
+	return true
+}
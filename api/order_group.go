@@ -0,0 +1,288 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/token"
+	"go-exchange/util"
+	"go-exchange/val"
+	"net/http"
+
+	db "go-exchange/db/sqlc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST http://localhost:8080/order_groups
+type orderGroupRequest struct {
+	Type                string          `json:"type" binding:"required,order_group_type"`
+	Pair                string          `json:"pair" binding:"required,pair"`
+	Side                string          `json:"side" binding:"required,side"`
+	FromAccountID       int64           `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID         int64           `json:"to_account_id" binding:"required,min=1"`
+	Amount              decimal.Decimal `json:"amount" binding:"required,gt=0"`
+	Price               decimal.Decimal `json:"price" binding:"omitempty,gt=0"`
+	TakeProfitPrice     decimal.Decimal `json:"take_profit_price" binding:"required,gt=0"`
+	StopPrice           decimal.Decimal `json:"stop_price" binding:"required,gt=0"`
+	StopLimitPrice      decimal.Decimal `json:"stop_limit_price" binding:"omitempty,gt=0"`
+	MaxSlippage         int64           `json:"max_slippage" binding:"omitempty,min=0,max=10000"`
+	SelfTradePrevention string          `json:"self_trade_prevention" binding:"omitempty,self_trade_prevention"`
+}
+
+type orderGroupResponse struct {
+	db.OrderGroup
+	db.OrderGroupLegs
+}
+
+// createOrderGroup creates all the legs of an order group at once.
+// The legs of an OCO are on the side of the request, a bracket enters on the side of the request
+// and its take profit and stop loss exit on the opposite side with the accounts swapped
+func (server *Server) createOrderGroup(ctx *gin.Context) {
+	var req orderGroupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err := val.ValidateOrderGroupPrices(req.Type, req.Side, req.Price, req.TakeProfitPrice, req.StopPrice, req.StopLimitPrice, req.MaxSlippage)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pair, _ := server.registry.Pair(req.Pair)
+	if violations := orderGroupSizeViolations(pair, req); violations != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse(violations))
+		return
+	}
+
+	c1, c2 := util.CurrenciesFromPair(req.Pair)
+	fromCurrency, toCurrency := c2, c1
+	if req.Side == util.ASK {
+		fromCurrency, toCurrency = c1, c2
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, fromCurrency)
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if fromAccount.Owner != authPayload.Username {
+		err := errors.New("from account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	toAccount, valid := server.validAccount(ctx, req.ToAccountID, toCurrency)
+	if !valid {
+		return
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		err := errors.New("to account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	group := engine.OrderGroup{
+		Type:                req.Type,
+		Pair:                req.Pair,
+		Side:                req.Side,
+		FromAccountID:       req.FromAccountID,
+		ToAccountID:         req.ToAccountID,
+		Amount:              req.Amount,
+		Price:               req.Price,
+		TakeProfitPrice:     req.TakeProfitPrice,
+		StopPrice:           req.StopPrice,
+		StopLimitPrice:      req.StopLimitPrice,
+		MaxSlippage:         req.MaxSlippage,
+		SelfTradePrevention: req.SelfTradePrevention,
+	}
+
+	result, err := server.store.CreateOrderGroupTx(ctx, group.Params(pair))
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if err := server.engine.PlaceOrderGroup(ctx, req.Side, result.Legs); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	legs, err := server.listOrderGroupLegs(ctx, result.OrderGroup.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, orderGroupResponse{result.OrderGroup, legs})
+}
+
+// orderGroupSizeViolations checks the legs of an order group against the trading rules of its pair.
+// Every leg trades amount, so the leg with the lowest price must still be worth the minimum notional
+func orderGroupSizeViolations(pair db.Pair, req orderGroupRequest) []fieldViolation {
+	stopLossPrice := req.StopLimitPrice
+	if stopLossPrice.IsZero() {
+		stopLossPrice = req.StopPrice
+	}
+
+	lowestPrice := decimal.Min(req.TakeProfitPrice, stopLossPrice)
+	if !req.Price.IsZero() {
+		lowestPrice = decimal.Min(lowestPrice, req.Price)
+	}
+
+	violations := orderSizeViolations(pair, orderSize{
+		price:          req.Price,
+		stopPrice:      req.StopPrice,
+		amount:         req.Amount,
+		notionalAmount: req.Amount,
+		notionalPrice:  lowestPrice,
+	})
+
+	if err := val.ValidateTickSize(req.TakeProfitPrice, pair); err != nil {
+		violations = append(violations, newFieldViolation("take_profit_price", err))
+	}
+
+	if !req.StopLimitPrice.IsZero() {
+		if err := val.ValidateTickSize(req.StopLimitPrice, pair); err != nil {
+			violations = append(violations, newFieldViolation("stop_limit_price", err))
+		}
+	}
+
+	return violations
+}
+
+func (server *Server) listOrderGroupLegs(ctx *gin.Context, id int64) (db.OrderGroupLegs, error) {
+	var legs db.OrderGroupLegs
+	var err error
+
+	groupID := sql.NullInt64{Int64: id, Valid: true}
+	legs.Bids, err = server.store.ListBidsByGroup(ctx, groupID)
+	if err != nil {
+		return legs, err
+	}
+
+	legs.Asks, err = server.store.ListAsksByGroup(ctx, groupID)
+	return legs, err
+}
+
+// verifyOrderGroupOwner loads an order group with its legs and checks the authenticated user owns them
+func (server *Server) verifyOrderGroupOwner(ctx *gin.Context, id int64) (orderGroupResponse, bool) {
+	group, err := server.store.GetOrderGroup(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return orderGroupResponse{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return orderGroupResponse{}, false
+	}
+
+	legs, err := server.listOrderGroupLegs(ctx, group.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return orderGroupResponse{}, false
+	}
+
+	// every leg is paid from an account of the same user
+	var accountID int64
+	if len(legs.Bids) > 0 {
+		accountID = legs.Bids[0].FromAccountID
+	} else if len(legs.Asks) > 0 {
+		accountID = legs.Asks[0].FromAccountID
+	}
+
+	_, err = server.verifyAccountOwner(ctx, accountID)
+	if err != nil {
+		return orderGroupResponse{}, false
+	}
+
+	return orderGroupResponse{group, legs}, true
+}
+
+// GET http://localhost:8080/order_groups/1
+type getOrderGroupRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) getOrderGroup(ctx *gin.Context) {
+	var req getOrderGroupRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	group, valid := server.verifyOrderGroupOwner(ctx, req.ID)
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, group)
+}
+
+// PATCH http://localhost:8080/order_groups
+type updateOrderGroupRequest struct {
+	ID     int64  `json:"id" binding:"required,min=1"`
+	Status string `json:"status" binding:"required,eq=canceled"`
+}
+
+// updateOrderGroup cancels every open leg of an order group
+func (server *Server) updateOrderGroup(ctx *gin.Context) {
+	var req updateOrderGroupRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	group, valid := server.verifyOrderGroupOwner(ctx, req.ID)
+	if !valid {
+		return
+	}
+
+	open := db.OrderGroupLegs{}
+	for _, bid := range group.Bids {
+		if util.IsOpenStatus(bid.Status) {
+			open.Bids = append(open.Bids, bid)
+		}
+	}
+	for _, ask := range group.Asks {
+		if util.IsOpenStatus(ask.Status) {
+			open.Asks = append(open.Asks, ask)
+		}
+	}
+
+	if len(open.Bids)+len(open.Asks) == 0 {
+		err := fmt.Errorf("order group %d has no open orders", group.ID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	server.engine.CancelLegs(open)
+
+	result, err := server.store.CancelOrderGroupTx(ctx, req.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	legs, err := server.listOrderGroupLegs(ctx, result.OrderGroup.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, orderGroupResponse{result.OrderGroup, legs})
+}
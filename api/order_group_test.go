@@ -0,0 +1,544 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrderGroupAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	account1.Currency = util.USDT
+	account1.Balance = decimal.NewFromInt(1000)
+	account2.Currency = util.BTC
+	account2.Balance = decimal.NewFromInt(10)
+
+	group := db.OrderGroup{ID: util.RandomInt(1, 1000)}
+	groupID := sql.NullInt64{Int64: group.ID, Valid: true}
+
+	// an OCO that sells above 120 or below 90
+	takeProfit := randomAsk(account2.ID, account1.ID)
+	takeProfit.ID = 1
+	takeProfit.Pair = util.BTC_USDT
+	takeProfit.Price = decimal.NewFromInt(120)
+	takeProfit.Amount = decimal.NewFromInt(2)
+	takeProfit.RemainingAmount = decimal.NewFromInt(2)
+	takeProfit.Status = util.ACTIVE
+	takeProfit.GroupID = groupID
+	takeProfit.GroupLeg = util.TAKE_PROFIT_LEG
+
+	stopLoss := takeProfit
+	stopLoss.ID = 2
+	stopLoss.Price = decimal.NewFromInt(85)
+	stopLoss.Type = util.STOP_LIMIT
+	stopLoss.Status = util.PENDING
+	stopLoss.StopPrice = decimal.NewFromInt(90)
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	// a bracket that buys at 100 and then sells above 120 or below 90
+	entry := randomBid(account1.ID, account2.ID)
+	entry.ID = 3
+	entry.Pair = util.BTC_USDT
+	entry.Price = decimal.NewFromInt(100)
+	entry.Amount = decimal.NewFromInt(2)
+	entry.RemainingAmount = decimal.NewFromInt(2)
+	entry.Status = util.ACTIVE
+	entry.GroupID = groupID
+	entry.GroupLeg = util.ENTRY_LEG
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		resting       []*engine.Order
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "OCO",
+			body: gin.H{
+				"type":              util.OCO,
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"stop_limit_price":  stopLoss.Price,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.CreateOrderGroupTxParams{
+					Type: util.OCO,
+					Asks: []db.CreateAskParams{
+						{
+							Pair:          util.BTC_USDT,
+							FromAccountID: account2.ID,
+							ToAccountID:   account1.ID,
+							Price:         takeProfit.Price,
+							Amount:        takeProfit.Amount,
+							Status:        util.ACTIVE,
+							Type:          util.LIMIT,
+							TimeInForce:   util.GTC,
+							GroupLeg:      util.TAKE_PROFIT_LEG,
+						},
+						{
+							Pair:          util.BTC_USDT,
+							FromAccountID: account2.ID,
+							ToAccountID:   account1.ID,
+							Price:         stopLoss.Price,
+							Amount:        stopLoss.Amount,
+							Status:        util.PENDING,
+							Type:          util.STOP_LIMIT,
+							TimeInForce:   util.GTC,
+							StopPrice:     stopLoss.StopPrice,
+							GroupLeg:      util.STOP_LOSS_LEG,
+						},
+					},
+				}
+				legs := db.OrderGroupLegs{Asks: []db.Ask{takeProfit, stopLoss}}
+
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CreateOrderGroupTxResult{OrderGroup: group, Legs: legs}, nil)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Bid{}, nil)
+				store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return(legs.Asks, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got orderGroupResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, group.ID, got.ID)
+				require.Len(t, got.Asks, 2)
+
+				// the take profit rests on the book and the stop loss waits for its trigger
+				require.Len(t, book.Orders(util.ASK), 1)
+				require.Equal(t, takeProfit.ID, book.Best(util.ASK).ID)
+				require.Len(t, book.Stops(), 1)
+				require.Equal(t, stopLoss.ID, book.Stops()[0].ID)
+			},
+		},
+		{
+			name: "OCOTakeProfitFilled",
+			body: gin.H{
+				"type":              util.OCO,
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"stop_limit_price":  stopLoss.Price,
+			},
+			resting: []*engine.Order{{ID: 10, Pair: util.BTC_USDT, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(130), Amount: decimal.NewFromInt(5)}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				legs := db.OrderGroupLegs{Asks: []db.Ask{takeProfit, stopLoss}}
+
+				// the take profit trades right away, which cancels the stop loss before it is placed
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.CreateOrderGroupTxResult{OrderGroup: group, Legs: legs}, nil)
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(1)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Bid{}, nil)
+				store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return(legs.Asks, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Empty(t, book.Orders(util.ASK))
+				require.Empty(t, book.Stops())
+			},
+		},
+		{
+			name: "Bracket",
+			body: gin.H{
+				"type":              util.BRACKET,
+				"pair":              util.BTC_USDT,
+				"side":              util.BID,
+				"from_account_id":   account1.ID,
+				"to_account_id":     account2.ID,
+				"amount":            entry.Amount,
+				"price":             entry.Price,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"max_slippage":      1000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				// the take profit and stop loss sell what the entry buys once it fills
+				arg := db.CreateOrderGroupTxParams{
+					Type: util.BRACKET,
+					Bids: []db.CreateBidParams{
+						{
+							Pair:          util.BTC_USDT,
+							FromAccountID: account1.ID,
+							ToAccountID:   account2.ID,
+							Price:         entry.Price,
+							Amount:        entry.Amount,
+							Status:        util.ACTIVE,
+							Type:          util.LIMIT,
+							TimeInForce:   util.GTC,
+							GroupLeg:      util.ENTRY_LEG,
+						},
+					},
+					Asks: []db.CreateAskParams{
+						{
+							Pair:          util.BTC_USDT,
+							FromAccountID: account2.ID,
+							ToAccountID:   account1.ID,
+							Price:         takeProfit.Price,
+							Amount:        entry.Amount,
+							Status:        util.INACTIVE,
+							Type:          util.LIMIT,
+							TimeInForce:   util.GTC,
+							GroupLeg:      util.TAKE_PROFIT_LEG,
+						},
+						{
+							Pair:          util.BTC_USDT,
+							FromAccountID: account2.ID,
+							ToAccountID:   account1.ID,
+							Price:         engine.StopMarketPrice(util.ASK, stopLoss.StopPrice, 1000, decimal.NewFromInt(1)),
+							Amount:        entry.Amount,
+							Status:        util.INACTIVE,
+							Type:          util.STOP_MARKET,
+							TimeInForce:   util.IOC,
+							StopPrice:     stopLoss.StopPrice,
+							GroupLeg:      util.STOP_LOSS_LEG,
+						},
+					},
+				}
+
+				inactiveTakeProfit, inactiveStopLoss := takeProfit, stopLoss
+				inactiveTakeProfit.Status = util.INACTIVE
+				inactiveStopLoss.Status = util.INACTIVE
+				legs := db.OrderGroupLegs{Bids: []db.Bid{entry}, Asks: []db.Ask{inactiveTakeProfit, inactiveStopLoss}}
+
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CreateOrderGroupTxResult{OrderGroup: group, Legs: legs}, nil)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return(legs.Bids, nil)
+				store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return(legs.Asks, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				// only the entry is placed
+				require.Len(t, book.Orders(util.BID), 1)
+				require.Equal(t, entry.ID, book.Best(util.BID).ID)
+				require.Empty(t, book.Orders(util.ASK))
+				require.Empty(t, book.Stops())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"type":              util.OCO,
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"stop_limit_price":  stopLoss.Price,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.CreateOrderGroupTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "TakeProfitBelowStop",
+			body: gin.H{
+				"type":              util.OCO,
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": 80,
+				"stop_price":        stopLoss.StopPrice,
+				"stop_limit_price":  stopLoss.Price,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BracketEntryOutsideRange",
+			body: gin.H{
+				"type":              util.BRACKET,
+				"pair":              util.BTC_USDT,
+				"side":              util.BID,
+				"from_account_id":   account1.ID,
+				"to_account_id":     account2.ID,
+				"amount":            entry.Amount,
+				"price":             150,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"max_slippage":      1000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "StopLossWithoutPrice",
+			body: gin.H{
+				"type":              util.OCO,
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidType",
+			body: gin.H{
+				"type":              "invalid",
+				"pair":              util.BTC_USDT,
+				"side":              util.ASK,
+				"from_account_id":   account2.ID,
+				"to_account_id":     account1.ID,
+				"amount":            takeProfit.Amount,
+				"take_profit_price": takeProfit.Price,
+				"stop_price":        stopLoss.StopPrice,
+				"stop_limit_price":  stopLoss.Price,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			for _, order := range tc.resting {
+				resting := *order
+				book.Add(&resting)
+			}
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/order_groups"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
+
+func TestUpdateOrderGroupAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+
+	account := randomAccount(user.Username)
+	account.Currency = util.BTC
+	otherAccount := randomAccount(otherUser.Username)
+
+	group := db.OrderGroup{ID: util.RandomInt(1, 1000), Type: util.OCO}
+	groupID := sql.NullInt64{Int64: group.ID, Valid: true}
+
+	takeProfit := randomAsk(account.ID, util.RandomInt(1, 1000))
+	takeProfit.ID = 1
+	takeProfit.Pair = util.BTC_USDT
+	takeProfit.Price = decimal.NewFromInt(120)
+	takeProfit.Status = util.ACTIVE
+	takeProfit.GroupID = groupID
+	takeProfit.GroupLeg = util.TAKE_PROFIT_LEG
+
+	stopLoss := takeProfit
+	stopLoss.ID = 2
+	stopLoss.Price = decimal.NewFromInt(85)
+	stopLoss.Type = util.STOP_LIMIT
+	stopLoss.Status = util.PENDING
+	stopLoss.StopPrice = decimal.NewFromInt(90)
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	canceledTakeProfit, canceledStopLoss := takeProfit, stopLoss
+	canceledTakeProfit.Status = util.CANCELED
+	canceledStopLoss.Status = util.CANCELED
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"id": group.ID, "status": util.CANCELED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOrderGroup(gomock.Any(), gomock.Eq(group.ID)).Times(1).Return(group, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(2).Return([]db.Bid{}, nil)
+				gomock.InOrder(
+					store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Ask{takeProfit, stopLoss}, nil),
+					store.EXPECT().CancelOrderGroupTx(gomock.Any(), gomock.Eq(group.ID)).Times(1).
+						Return(db.CancelOrderGroupTxResult{OrderGroup: group, Canceled: db.OrderGroupLegs{Asks: []db.Ask{canceledTakeProfit, canceledStopLoss}}}, nil),
+					store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Ask{canceledTakeProfit, canceledStopLoss}, nil),
+				)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got orderGroupResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Len(t, got.Asks, 2)
+				for _, ask := range got.Asks {
+					require.Equal(t, util.CANCELED, ask.Status)
+				}
+
+				// every leg is taken off the book
+				require.Empty(t, book.Orders(util.ASK))
+				require.Empty(t, book.Stops())
+			},
+		},
+		{
+			name: "NoOpenLegs",
+			body: gin.H{"id": group.ID, "status": util.CANCELED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOrderGroup(gomock.Any(), gomock.Eq(group.ID)).Times(1).Return(group, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Bid{}, nil)
+				store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Ask{canceledTakeProfit, canceledStopLoss}, nil)
+				store.EXPECT().CancelOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			body: gin.H{"id": group.ID, "status": util.CANCELED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOrderGroup(gomock.Any(), gomock.Eq(group.ID)).Times(1).Return(group, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(otherAccount, nil)
+				store.EXPECT().ListBidsByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Bid{}, nil)
+				store.EXPECT().ListAsksByGroup(gomock.Any(), gomock.Eq(groupID)).Times(1).Return([]db.Ask{takeProfit, stopLoss}, nil)
+				store.EXPECT().CancelOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Len(t, book.Orders(util.ASK), 1)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"id": group.ID, "status": util.CANCELED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOrderGroup(gomock.Any(), gomock.Eq(group.ID)).Times(1).Return(db.OrderGroup{}, sql.ErrNoRows)
+				store.EXPECT().CancelOrderGroupTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"id": group.ID, "status": util.ACTIVE},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetOrderGroup(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			book.Add(&engine.Order{ID: takeProfit.ID, Pair: util.BTC_USDT, Side: util.ASK, Type: util.LIMIT, Price: takeProfit.Price, Amount: takeProfit.Amount})
+			book.AddStop(&engine.Order{ID: stopLoss.ID, Pair: util.BTC_USDT, Side: util.ASK, Type: util.STOP_LIMIT, Price: stopLoss.Price, StopPrice: stopLoss.StopPrice, Amount: stopLoss.Amount})
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/order_groups"
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOrdersAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	otherAccount := randomAccount(otherUser.Username)
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.ID = 1
+	bid.Pair = util.BTC_USDT
+	bid.Status = util.CANCELED
+
+	ask := randomAsk(account2.ID, account1.ID)
+	ask.ID = 2
+	ask.Pair = util.BTC_USDT
+	ask.Status = util.CANCELED
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, book *engine.OrderBook)
+	}{
+		{
+			name: "All",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CancelOrdersTxParams{Owner: user.Username}
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CancelOrdersTxResult{Bids: []db.Bid{bid}, Asks: []db.Ask{ask}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got cancelOrdersResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, []int64{bid.ID}, got.BidIDs)
+				require.Equal(t, []int64{ask.ID}, got.AskIDs)
+
+				require.Empty(t, book.Orders(util.BID))
+				require.Empty(t, book.Orders(util.ASK))
+			},
+		},
+		{
+			name: "Filters",
+			body: gin.H{
+				"pair":       util.BTC_USDT,
+				"side":       util.BID,
+				"account_id": account1.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.CancelOrdersTxParams{
+					Owner:     user.Username,
+					Pair:      util.BTC_USDT,
+					Side:      util.BID,
+					AccountID: account1.ID,
+				}
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.CancelOrdersTxResult{Bids: []db.Bid{bid}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got cancelOrdersResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, []int64{bid.ID}, got.BidIDs)
+				require.Empty(t, got.AskIDs)
+
+				require.Empty(t, book.Orders(util.BID))
+				require.Len(t, book.Orders(util.ASK), 1)
+			},
+		},
+		{
+			name: "UnauthorizedAccount",
+			body: gin.H{"account_id": otherAccount.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).Times(1).Return(otherAccount, nil)
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidSide",
+			body: gin.H{"side": "invalid"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CancelOrdersTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, book *engine.OrderBook) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.Len(t, book.Orders(util.BID), 1)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			book, err := server.engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			book.Add(&engine.Order{ID: bid.ID, Pair: bid.Pair, Side: util.BID, Type: util.LIMIT, Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(1)})
+			book.Add(&engine.Order{ID: ask.ID, Pair: ask.Pair, Side: util.ASK, Type: util.LIMIT, Price: decimal.NewFromInt(110), Amount: decimal.NewFromInt(1)})
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := "/orders/cancel"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, book)
+		})
+	}
+}
+
+func TestListOrderEventsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+
+	account1 := randomAccount(user.Username)
+	account2 := randomAccount(user.Username)
+	otherAccount := randomAccount(otherUser.Username)
+
+	bid := randomBid(account1.ID, account2.ID)
+	bid.ID = 1
+	ask := randomAsk(account2.ID, account1.ID)
+	ask.ID = 2
+
+	event := db.OrderEvent{
+		ID:                  1,
+		Side:                util.BID,
+		OrderID:             bid.ID,
+		Type:                util.SELF_TRADE_PREVENTED,
+		SelfTradePrevention: util.CANCEL_OLDEST,
+		CounterOrderID:      sql.NullInt64{Int64: ask.ID, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		url           string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Bid",
+			url:  fmt.Sprintf("/bids/%d/events?page_id=1&page_size=5", bid.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+
+				arg := db.ListOrderEventsParams{Side: util.BID, OrderID: bid.ID, Limit: 5, Offset: 0}
+				store.EXPECT().ListOrderEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.OrderEvent{event}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []db.OrderEvent
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, []db.OrderEvent{event}, got)
+			},
+		},
+		{
+			name: "Ask",
+			url:  fmt.Sprintf("/asks/%d/events?page_id=2&page_size=5", ask.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+
+				arg := db.ListOrderEventsParams{Side: util.ASK, OrderID: ask.ID, Limit: 5, Offset: 5}
+				store.EXPECT().ListOrderEvents(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.OrderEvent{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			url:  fmt.Sprintf("/bids/%d/events?page_id=1&page_size=5", bid.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				otherBid := bid
+				otherBid.FromAccountID = otherAccount.ID
+				store.EXPECT().GetBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(otherBid, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(otherAccount.ID)).Times(1).Return(otherAccount, nil)
+				store.EXPECT().ListOrderEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			url:  fmt.Sprintf("/asks/%d/events?page_id=1&page_size=5", ask.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(db.Ask{}, sql.ErrNoRows)
+				store.EXPECT().ListOrderEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			url:  fmt.Sprintf("/bids/%d/events?page_id=1&page_size=20", bid.ID),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBid(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListOrderEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, user.Username, util.TRADER, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
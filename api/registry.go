@@ -0,0 +1,252 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"go-exchange/val"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+// GET http://localhost:8080/currencies
+func (server *Server) listCurrencies(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, server.registry.Currencies())
+}
+
+// GET http://localhost:8080/pairs
+func (server *Server) listPairs(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, server.registry.Pairs())
+}
+
+// POST http://localhost:8080/admin/currencies
+type createCurrencyRequest struct {
+	Code     string `json:"code" binding:"required,alphanum,uppercase,min=2,max=10"`
+	Decimals *int64 `json:"decimals" binding:"required,min=0,max=18"`
+}
+
+// createCurrency lists a new currency along with the exchange account its fees are collected into
+func (server *Server) createCurrency(ctx *gin.Context) {
+	var req createCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.CreateCurrencyTx(ctx, db.CreateCurrencyParams{
+		Code:     req.Code,
+		Decimals: *req.Decimals,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				ctx.JSON(http.StatusForbidden, errorResponse(err))
+				return
+			}
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.registry.SetCurrency(result.Currency)
+
+	ctx.JSON(http.StatusOK, result.Currency)
+}
+
+// PATCH http://localhost:8080/admin/currencies
+type updateCurrencyRequest struct {
+	Code     string `json:"code" binding:"required"`
+	Decimals *int64 `json:"decimals" binding:"omitempty,min=0,max=18"`
+	Status   string `json:"status" binding:"omitempty,market_status"`
+}
+
+// updateCurrency changes the decimals or the trading status of a currency.
+// Pairs can't take new orders while one of their currencies isn't active
+func (server *Server) updateCurrency(ctx *gin.Context) {
+	var req updateCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.UpdateCurrencyParams{
+		Code:   req.Code,
+		Status: sql.NullString{String: req.Status, Valid: req.Status != ""},
+	}
+	if req.Decimals != nil {
+		arg.Decimals = sql.NullInt64{Int64: *req.Decimals, Valid: true}
+	}
+
+	currency, err := server.store.UpdateCurrency(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.registry.SetCurrency(currency)
+
+	ctx.JSON(http.StatusOK, currency)
+}
+
+// POST http://localhost:8080/admin/pairs
+type createPairRequest struct {
+	Base        string          `json:"base" binding:"required,currency"`
+	Quote       string          `json:"quote" binding:"required,currency,nefield=Base"`
+	TickSize    decimal.Decimal `json:"tick_size" binding:"required,gt=0"`
+	LotSize     decimal.Decimal `json:"lot_size" binding:"required,gt=0"`
+	MinNotional decimal.Decimal `json:"min_notional" binding:"omitempty,min=0"`
+}
+
+// createPair lists a new market between two active currencies, open for trading right away
+func (server *Server) createPair(ctx *gin.Context) {
+	var req createPairRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if !server.validPairPrecision(ctx, req.Base, req.Quote, req.TickSize, req.LotSize, req.MinNotional) {
+		return
+	}
+
+	pair, err := server.store.CreatePair(ctx, db.CreatePairParams{
+		Symbol:      fmt.Sprintf("%s/%s", req.Base, req.Quote),
+		Base:        req.Base,
+		Quote:       req.Quote,
+		TickSize:    req.TickSize,
+		LotSize:     req.LotSize,
+		MinNotional: req.MinNotional,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "foreign_key_violation", "unique_violation":
+				ctx.JSON(http.StatusForbidden, errorResponse(err))
+				return
+			}
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.registry.SetPair(pair)
+
+	ctx.JSON(http.StatusOK, pair)
+}
+
+// PATCH http://localhost:8080/admin/pairs
+type updatePairRequest struct {
+	Pair        string           `json:"pair" binding:"required,listed_pair"`
+	TickSize    decimal.Decimal  `json:"tick_size" binding:"omitempty,gt=0"`
+	LotSize     decimal.Decimal  `json:"lot_size" binding:"omitempty,gt=0"`
+	MinNotional *decimal.Decimal `json:"min_notional" binding:"omitempty,min=0"`
+	Status      string           `json:"status" binding:"omitempty,market_status"`
+}
+
+type updatePairResponse struct {
+	Pair           db.Pair `json:"pair"`
+	CanceledBidIDs []int64 `json:"canceled_bid_ids"`
+	CanceledAskIDs []int64 `json:"canceled_ask_ids"`
+}
+
+// updatePair changes the trading rules or the trading status of a pair.
+// Paused pairs keep their open orders but take no new ones, delisting a pair also cancels its open orders
+func (server *Server) updatePair(ctx *gin.Context) {
+	var req updatePairRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// the rules left out keep their current value, the new ones must still fit the currencies of the pair
+	listed, _ := server.registry.Pair(req.Pair)
+	tickSize, lotSize, minNotional := listed.TickSize, listed.LotSize, listed.MinNotional
+	if !req.TickSize.IsZero() {
+		tickSize = req.TickSize
+	}
+	if !req.LotSize.IsZero() {
+		lotSize = req.LotSize
+	}
+	if req.MinNotional != nil {
+		minNotional = *req.MinNotional
+	}
+
+	if !server.validPairPrecision(ctx, listed.Base, listed.Quote, tickSize, lotSize, minNotional) {
+		return
+	}
+
+	arg := db.UpdatePairParams{
+		Symbol:   req.Pair,
+		TickSize: decimal.NullDecimal{Decimal: req.TickSize, Valid: !req.TickSize.IsZero()},
+		LotSize:  decimal.NullDecimal{Decimal: req.LotSize, Valid: !req.LotSize.IsZero()},
+		Status:   sql.NullString{String: req.Status, Valid: req.Status != ""},
+	}
+	if req.MinNotional != nil {
+		arg.MinNotional = decimal.NullDecimal{Decimal: *req.MinNotional, Valid: true}
+	}
+
+	pair, err := server.store.UpdatePair(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.registry.SetPair(pair)
+
+	rsp := updatePairResponse{
+		Pair:           pair,
+		CanceledBidIDs: []int64{},
+		CanceledAskIDs: []int64{},
+	}
+
+	if pair.Status == util.DELISTED {
+		result, err := server.engine.CancelOrders(ctx, db.CancelOrdersTxParams{Pair: pair.Symbol})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		for _, bid := range result.Bids {
+			rsp.CanceledBidIDs = append(rsp.CanceledBidIDs, bid.ID)
+		}
+		for _, ask := range result.Asks {
+			rsp.CanceledAskIDs = append(rsp.CanceledAskIDs, ask.ID)
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// validPairPrecision responds with the field violations of trading rules that the currencies of a pair can't settle.
+// Amounts on the lot size must fit the decimals of the base currency, and any trade at a price on the tick size
+// must be worth an amount that fits the decimals of the quote currency
+func (server *Server) validPairPrecision(ctx *gin.Context, base string, quote string, tickSize decimal.Decimal, lotSize decimal.Decimal, minNotional decimal.Decimal) bool {
+	baseCurrency, _ := server.registry.Currency(base)
+	quoteCurrency, _ := server.registry.Currency(quote)
+
+	var violations []fieldViolation
+	if err := val.ValidateTickValue(tickSize, lotSize, quoteCurrency); err != nil {
+		violations = append(violations, newFieldViolation("tick_size", err))
+	}
+	if err := val.ValidateDecimals(lotSize, baseCurrency); err != nil {
+		violations = append(violations, newFieldViolation("lot_size", err))
+	}
+	if err := val.ValidateDecimals(minNotional, quoteCurrency); err != nil {
+		violations = append(violations, newFieldViolation("min_notional", err))
+	}
+
+	if violations != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse(violations))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func requireBodyMatchPair(t *testing.T, body *bytes.Buffer, pair db.Pair) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotPair db.Pair
+	err = json.Unmarshal(data, &gotPair)
+	require.NoError(t, err)
+	require.Equal(t, pair, gotPair)
+}
+
+func TestCreatePairAPI(t *testing.T) {
+	pair := db.Pair{
+		Symbol:      util.SOL_BTC,
+		Base:        util.SOL,
+		Quote:       util.BTC,
+		TickSize:    decimal.NewFromInt(5),
+		LotSize:     decimal.NewFromInt(10),
+		MinNotional: decimal.NewFromInt(1000),
+		Status:      util.ACTIVE,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, server *Server)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"base": pair.Base, "quote": pair.Quote, "tick_size": pair.TickSize, "lot_size": pair.LotSize, "min_notional": pair.MinNotional},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreatePairParams{
+					Symbol:      pair.Symbol,
+					Base:        pair.Base,
+					Quote:       pair.Quote,
+					TickSize:    pair.TickSize,
+					LotSize:     pair.LotSize,
+					MinNotional: pair.MinNotional,
+				}
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Eq(arg)).Times(1).Return(pair, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchPair(t, recorder.Body, pair)
+
+				cached, ok := server.registry.Pair(pair.Symbol)
+				require.True(t, ok)
+				require.Equal(t, pair, cached)
+			},
+		},
+		{
+			name: "NotAdmin",
+			body: gin.H{"base": pair.Base, "quote": pair.Quote, "tick_size": pair.TickSize, "lot_size": pair.LotSize},
+			role: util.TRADER,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedCurrency",
+			body: gin.H{"base": "XYZ", "quote": pair.Quote, "tick_size": pair.TickSize, "lot_size": pair.LotSize},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SameCurrency",
+			body: gin.H{"base": pair.Quote, "quote": pair.Quote, "tick_size": pair.TickSize, "lot_size": pair.LotSize},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "PrecisionExceedsCurrencies",
+			body: gin.H{"base": pair.Base, "quote": pair.Quote, "tick_size": "0.000001", "lot_size": "0.0000000001", "min_notional": "0.000000001"},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var rsp struct {
+					FieldViolations []fieldViolation `json:"field_violations"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Len(t, rsp.FieldViolations, 3)
+				require.Equal(t, "tick_size", rsp.FieldViolations[0].Field)
+				require.Equal(t, "lot_size", rsp.FieldViolations[1].Field)
+				require.Equal(t, "min_notional", rsp.FieldViolations[2].Field)
+			},
+		},
+		{
+			name: "DuplicatePair",
+			body: gin.H{"base": pair.Base, "quote": pair.Quote, "tick_size": pair.TickSize, "lot_size": pair.LotSize},
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePair(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.Pair{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/pairs", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "admin", tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, server)
+		})
+	}
+}
+
+func TestUpdatePairAPI(t *testing.T) {
+	pair := db.Pair{
+		Symbol:   util.BTC_USDT,
+		Base:     util.BTC,
+		Quote:    util.USDT,
+		TickSize: decimal.NewFromInt(1),
+		LotSize:  decimal.NewFromInt(1),
+	}
+
+	bid := randomBid(1, 2)
+	bid.ID = 1
+	bid.Pair = pair.Symbol
+	bid.Status = util.CANCELED
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, server *Server)
+	}{
+		{
+			name: "Pause",
+			body: gin.H{"pair": pair.Symbol, "status": util.PAUSED},
+			buildStubs: func(store *mockdb.MockStore) {
+				paused := pair
+				paused.Status = util.PAUSED
+
+				arg := db.UpdatePairParams{
+					Symbol: pair.Symbol,
+					Status: sql.NullString{String: util.PAUSED, Valid: true},
+				}
+				store.EXPECT().UpdatePair(gomock.Any(), gomock.Eq(arg)).Times(1).Return(paused, nil)
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.True(t, server.registry.IsListedPair(pair.Symbol))
+				require.False(t, server.registry.IsActivePair(pair.Symbol))
+			},
+		},
+		{
+			name: "Delist",
+			body: gin.H{"pair": pair.Symbol, "status": util.DELISTED},
+			buildStubs: func(store *mockdb.MockStore) {
+				delisted := pair
+				delisted.Status = util.DELISTED
+
+				store.EXPECT().UpdatePair(gomock.Any(), gomock.Any()).Times(1).Return(delisted, nil)
+				store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(db.CancelOrdersTxParams{Pair: pair.Symbol})).Times(1).
+					Return(db.CancelOrdersTxResult{Bids: []db.Bid{bid}}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp updatePairResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, util.DELISTED, rsp.Pair.Status)
+				require.Equal(t, []int64{bid.ID}, rsp.CanceledBidIDs)
+				require.Empty(t, rsp.CanceledAskIDs)
+			},
+		},
+		{
+			name: "InvalidStatus",
+			body: gin.H{"pair": pair.Symbol, "status": util.CANCELED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnlistedPair",
+			body: gin.H{"pair": "XYZ/USDT", "status": util.PAUSED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdatePair(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{"pair": pair.Symbol, "status": util.PAUSED},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdatePair(gomock.Any(), gomock.Any()).Times(1).Return(db.Pair{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, server *Server) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+				require.True(t, server.registry.IsActivePair(pair.Symbol))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPatch, "/admin/pairs", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "admin", util.ADMIN, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, server)
+		})
+	}
+}
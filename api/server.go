@@ -3,8 +3,12 @@ package api
 import (
 	"fmt"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/registry"
 	"go-exchange/token"
 	"go-exchange/util"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -15,12 +19,14 @@ import (
 type Server struct {
 	config     util.Config
 	store      db.Store
+	engine     *engine.Engine
+	registry   *registry.Registry
 	tokenMaker token.Maker
 	router     *gin.Engine
 }
 
 // NewServer creates a new HTTP server and set up routing.
-func NewServer(config util.Config, store db.Store) (*Server, error) {
+func NewServer(config util.Config, store db.Store, engine *engine.Engine, registry *registry.Registry) (*Server, error) {
 	var tokenMaker token.Maker
 	var err error
 
@@ -39,12 +45,23 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 	server := &Server{
 		config:     config,
 		store:      store,
+		engine:     engine,
+		registry:   registry,
 		tokenMaker: tokenMaker,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-		v.RegisterValidation("currency", validCurrency)
-		v.RegisterValidation("pair", validPair)
+		v.RegisterCustomTypeFunc(decimalValue, decimal.Decimal{})
+		v.RegisterValidation("currency", validCurrency(registry))
+		v.RegisterValidation("pair", validPair(registry))
+		v.RegisterValidation("listed_pair", validListedPair(registry))
+		v.RegisterValidation("side", validSide)
+		v.RegisterValidation("order_type", validOrderType)
+		v.RegisterValidation("time_in_force", validTimeInForce)
+		v.RegisterValidation("order_group_type", validOrderGroupType)
+		v.RegisterValidation("self_trade_prevention", validSelfTradePrevention)
+		v.RegisterValidation("market_status", validMarketStatus)
+		v.RegisterValidation("candle_interval", validCandleInterval)
 	}
 
 	server.setupRouter()
@@ -65,6 +82,18 @@ func (server *Server) setupRouter() {
 	router.GET("/transfers/:id", server.getTransfer)
 	router.GET("/transfers", server.listTransfers)
 
+	router.GET("/currencies", server.listCurrencies)
+	router.GET("/pairs", server.listPairs)
+	router.GET("/fee_tiers", server.listFeeTiers)
+
+	router.GET("/markets/:base/:quote/book", server.getOrderBook)
+	router.GET("/markets/:base/:quote/trades", server.listMarketTrades)
+	router.GET("/markets/:base/:quote/candles", server.listCandles)
+	router.GET("/markets/:base/:quote/ticker", server.getTicker)
+	router.GET("/tickers", server.listTickers)
+
+	router.GET("/ws", server.serveWebsocket)
+
 	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
 
 	authRoutes.PATCH("/users", server.updateUser)
@@ -82,11 +111,35 @@ func (server *Server) setupRouter() {
 	authRoutes.GET("/bids/:id", server.getBid)
 	authRoutes.GET("/bids", server.listBids)
 	authRoutes.PATCH("/bids", server.updateBid)
+	authRoutes.GET("/bids/:id/events", server.listBidEvents)
 
 	authRoutes.POST("/asks", server.createAsk)
 	authRoutes.GET("/asks/:id", server.getAsk)
 	authRoutes.GET("/asks", server.listAsks)
 	authRoutes.PATCH("/asks", server.updateAsk)
+	authRoutes.GET("/asks/:id/events", server.listAskEvents)
+
+	authRoutes.POST("/orders/cancel", server.cancelOrders)
+
+	authRoutes.POST("/order_groups", server.createOrderGroup)
+	authRoutes.GET("/order_groups/:id", server.getOrderGroup)
+	authRoutes.PATCH("/order_groups", server.updateOrderGroup)
+
+	authRoutes.POST("/dead_man_switch", server.armDeadManSwitch)
+	authRoutes.POST("/dead_man_switch/heartbeat", server.refreshDeadManSwitch)
+	authRoutes.GET("/dead_man_switch", server.getDeadManSwitch)
+	authRoutes.DELETE("/dead_man_switch", server.disarmDeadManSwitch)
+	authRoutes.GET("/dead_man_switch/events", server.listDeadManSwitchEvents)
+
+	adminRoutes := router.Group("/admin").Use(authMiddleware(server.tokenMaker), adminMiddleware())
+
+	adminRoutes.POST("/currencies", server.createCurrency)
+	adminRoutes.PATCH("/currencies", server.updateCurrency)
+	adminRoutes.POST("/pairs", server.createPair)
+	adminRoutes.PATCH("/pairs", server.updatePair)
+	adminRoutes.POST("/fee_tiers", server.createFeeTier)
+	adminRoutes.DELETE("/fee_tiers/:id", server.deleteFeeTier)
+	adminRoutes.POST("/candles/backfill", server.backfillCandles)
 
 	server.router = router
 }
@@ -96,6 +149,30 @@ func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
 
+// WebsocketHandler serves only the websocket feed, for HTTP servers that don't run the whole router
+func (server *Server) WebsocketHandler() http.Handler {
+	router := gin.New()
+	router.GET("/ws", server.serveWebsocket)
+	return router
+}
+
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
+
+// fieldViolation describes why a field of a request is invalid
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+func newFieldViolation(field string, err error) fieldViolation {
+	return fieldViolation{
+		Field:       field,
+		Description: err.Error(),
+	}
+}
+
+func invalidArgumentResponse(violations []fieldViolation) gin.H {
+	return gin.H{"error": "invalid parameters", "field_violations": violations}
+}
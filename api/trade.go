@@ -3,20 +3,22 @@ package api
 import (
 	"database/sql"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
 	"go-exchange/util"
+	"go-exchange/val"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
 type tradeRequest struct {
-	FirstFromAccountID int64 `json:"first_from_account_id" binding:"required,min=1"`
-	FirstToAccountID   int64 `json:"first_to_account_id" binding:"required,min=1"`
-	FirstAmount        int64 `json:"first_amount" binding:"required,gt=0"`
+	FirstFromAccountID int64           `json:"first_from_account_id" binding:"required,min=1"`
+	FirstToAccountID   int64           `json:"first_to_account_id" binding:"required,min=1"`
+	FirstAmount        decimal.Decimal `json:"first_amount" binding:"required,gt=0"`
 
-	SecondFromAccountID int64 `json:"second_from_account_id" binding:"required,min=1"`
-	SecondToAccountID   int64 `json:"second_to_account_id" binding:"required,min=1"`
-	SecondAmount        int64 `json:"second_amount" binding:"required,gt=0"`
+	SecondFromAccountID int64           `json:"second_from_account_id" binding:"required,min=1"`
+	SecondToAccountID   int64           `json:"second_to_account_id" binding:"required,min=1"`
+	SecondAmount        decimal.Decimal `json:"second_amount" binding:"required,gt=0"`
 
 	Pair string `json:"pair" binding:"required,pair"`
 }
@@ -30,6 +32,20 @@ func (server *Server) createTrade(ctx *gin.Context) {
 
 	c1, c2 := util.CurrenciesFromPair(req.Pair)
 
+	var violations []fieldViolation
+	first, _ := server.registry.Currency(c1)
+	if err := val.ValidateDecimals(req.FirstAmount, first); err != nil {
+		violations = append(violations, newFieldViolation("first_amount", err))
+	}
+	second, _ := server.registry.Currency(c2)
+	if err := val.ValidateDecimals(req.SecondAmount, second); err != nil {
+		violations = append(violations, newFieldViolation("second_amount", err))
+	}
+	if violations != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse(violations))
+		return
+	}
+
 	_, valid := server.validAccount(ctx, req.FirstFromAccountID, c1)
 	if !valid {
 		return
@@ -58,6 +74,8 @@ func (server *Server) createTrade(ctx *gin.Context) {
 		SecondFromAccountID: req.SecondFromAccountID,
 		SecondToAccountID:   req.SecondToAccountID,
 		SecondAmount:        req.SecondAmount,
+
+		Pair: req.Pair,
 	}
 
 	result, err := server.store.TradeTx(ctx, arg)
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	mockdb "go-exchange/db/mock"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
 	"go-exchange/util"
 	"net/http"
 	"net/http/httptest"
@@ -32,7 +33,7 @@ func TestCreateTradeAPI(t *testing.T) {
 
 	pair := util.BTC_USDT
 
-	amount := int64(10)
+	amount := decimal.NewFromInt(10)
 
 	testCases := []struct {
 		name          string
@@ -65,6 +66,8 @@ func TestCreateTradeAPI(t *testing.T) {
 					SecondFromAccountID: account3.ID,
 					SecondToAccountID:   account4.ID,
 					SecondAmount:        amount,
+
+					Pair: pair,
 				}
 				store.EXPECT().TradeTx(gomock.Any(), gomock.Eq(arg)).Times(1)
 			},
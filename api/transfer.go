@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
 	"go-exchange/token"
+	"go-exchange/val"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -13,10 +15,10 @@ import (
 
 // POST http://localhost:8080/transfers
 type transferRequest struct {
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"required,currency"`
+	FromAccountID int64           `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64           `json:"to_account_id" binding:"required,min=1"`
+	Amount        decimal.Decimal `json:"amount" binding:"required,gt=0"`
+	Currency      string          `json:"currency" binding:"required,currency"`
 }
 
 func (server *Server) createTransfer(ctx *gin.Context) {
@@ -26,6 +28,12 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
+	currency, _ := server.registry.Currency(req.Currency)
+	if err := val.ValidateDecimals(req.Amount, currency); err != nil {
+		ctx.JSON(http.StatusBadRequest, invalidArgumentResponse([]fieldViolation{newFieldViolation("amount", err)}))
+		return
+	}
+
 	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, req.Currency)
 	if !valid {
 		return
@@ -51,6 +59,10 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 
 	result, err := server.store.TransferTx(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -22,20 +22,24 @@ type createUserRequest struct {
 }
 
 type userResponse struct {
-	Username          string    `json:"username"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	PasswordChangedAt time.Time `json:"password_changed_at"`
-	CreatedAt         time.Time `json:"created_at"`
+	Username            string    `json:"username"`
+	FullName            string    `json:"full_name"`
+	Email               string    `json:"email"`
+	PasswordChangedAt   time.Time `json:"password_changed_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	SelfTradePrevention string    `json:"self_trade_prevention"`
+	Role                string    `json:"role"`
 }
 
 func newUserResponse(user db.User) userResponse {
 	return userResponse{
-		Username:          user.Username,
-		FullName:          user.FullName,
-		Email:             user.Email,
-		PasswordChangedAt: user.PasswordChangedAt,
-		CreatedAt:         user.CreatedAt,
+		Username:            user.Username,
+		FullName:            user.FullName,
+		Email:               user.Email,
+		PasswordChangedAt:   user.PasswordChangedAt,
+		CreatedAt:           user.CreatedAt,
+		SelfTradePrevention: user.SelfTradePrevention,
+		Role:                user.Role,
 	}
 }
 
@@ -79,10 +83,11 @@ func (server *Server) createUser(ctx *gin.Context) {
 
 // PATCH http://localhost:8080/users
 type updateUserRequest struct {
-	Username string `json:"username" binding:"required,alphanum"`
-	Password string `json:"password" binding:"omitempty,min=6"`
-	FullName string `json:"full_name"`
-	Email    string `json:"email"`
+	Username            string `json:"username" binding:"required,alphanum"`
+	Password            string `json:"password" binding:"omitempty,min=6"`
+	FullName            string `json:"full_name"`
+	Email               string `json:"email"`
+	SelfTradePrevention string `json:"self_trade_prevention" binding:"omitempty,self_trade_prevention"`
 }
 
 func (server *Server) updateUser(ctx *gin.Context) {
@@ -109,6 +114,10 @@ func (server *Server) updateUser(ctx *gin.Context) {
 			String: req.Email,
 			Valid:  req.Email != "",
 		},
+		SelfTradePrevention: sql.NullString{
+			String: req.SelfTradePrevention,
+			Valid:  req.SelfTradePrevention != "",
+		},
 	}
 
 	if req.Password != "" {
@@ -215,6 +224,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.AccessTokenDuration,
 	)
 	if err != nil {
@@ -224,6 +234,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
+		user.Role,
 		server.config.RefreshTokenDuration,
 	)
 	if err != nil {
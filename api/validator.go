@@ -1,21 +1,97 @@
 package api
 
 import (
+	"go-exchange/decimal"
+	"go-exchange/registry"
 	"go-exchange/util"
+	"reflect"
 
 	"github.com/go-playground/validator/v10"
 )
 
-var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
-	if currency, ok := fieldLevel.Field().Interface().(string); ok {
-		return util.IsSupportedCurrency(currency)
+// decimalValue lets the numeric tags like gt and min compare decimals
+func decimalValue(field reflect.Value) interface{} {
+	if value, ok := field.Interface().(decimal.Decimal); ok {
+		return value.Float64()
+	}
+	return nil
+}
+
+// validCurrency accepts the active currencies of the registry
+func validCurrency(registry *registry.Registry) validator.Func {
+	return func(fieldLevel validator.FieldLevel) bool {
+		if currency, ok := fieldLevel.Field().Interface().(string); ok {
+			return registry.IsActiveCurrency(currency)
+		}
+		return false
+	}
+}
+
+// validPair accepts the pairs of the registry open for new orders
+func validPair(registry *registry.Registry) validator.Func {
+	return func(fieldLevel validator.FieldLevel) bool {
+		if pair, ok := fieldLevel.Field().Interface().(string); ok {
+			return registry.IsActivePair(pair)
+		}
+		return false
+	}
+}
+
+// validListedPair accepts every pair of the registry, whatever its trading status
+func validListedPair(registry *registry.Registry) validator.Func {
+	return func(fieldLevel validator.FieldLevel) bool {
+		if pair, ok := fieldLevel.Field().Interface().(string); ok {
+			return registry.IsListedPair(pair)
+		}
+		return false
+	}
+}
+
+var validSide validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if side, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedSide(side)
+	}
+	return false
+}
+
+var validOrderType validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if orderType, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedOrderType(orderType)
+	}
+	return false
+}
+
+var validTimeInForce validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if timeInForce, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedTimeInForce(timeInForce)
+	}
+	return false
+}
+
+var validOrderGroupType validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if groupType, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedOrderGroupType(groupType)
+	}
+	return false
+}
+
+var validSelfTradePrevention validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if mode, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedSelfTradePrevention(mode)
+	}
+	return false
+}
+
+var validMarketStatus validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if status, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedMarketStatus(status)
 	}
 	return false
 }
 
-var validPair validator.Func = func(fieldLevel validator.FieldLevel) bool {
-	if pair, ok := fieldLevel.Field().Interface().(string); ok {
-		return util.IsSupportedPair(pair)
+var validCandleInterval validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if interval, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedCandleInterval(interval)
 	}
 	return false
 }
@@ -0,0 +1,444 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/engine"
+	"go-exchange/feed"
+	"go-exchange/token"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// websocketBuffer is the number of messages buffered for a subscription and for a connection
+	// before they are considered too slow and dropped
+	websocketBuffer = 256
+	// websocketWriteWait is the time allowed to write a message to the peer
+	websocketWriteWait = 10 * time.Second
+	// websocketPongWait is the time allowed to read the next pong from the peer
+	websocketPongWait = 60 * time.Second
+	// websocketPingPeriod is how often pings are sent, it must be less than websocketPongWait
+	websocketPingPeriod = websocketPongWait * 9 / 10
+	// websocketMaxMessage is the largest message accepted from the peer
+	websocketMaxMessage = 4096
+	// websocketMaxAccounts is the most accounts sent in a balances snapshot
+	websocketMaxAccounts = 100
+)
+
+// Operations a client can send over the websocket
+const (
+	websocketAuth        = "auth"
+	websocketSubscribe   = "subscribe"
+	websocketUnsubscribe = "unsubscribe"
+)
+
+// Types of the control messages sent back to the client, next to the snapshot and update events of the feed
+const (
+	websocketAuthenticated = "authenticated"
+	websocketUnsubscribed  = "unsubscribed"
+	websocketError         = "error"
+)
+
+// websocketUpgrader accepts connections from any origin. Private channels are authenticated
+// with an access token sent over the connection, not with cookies, so other sites can't use them on behalf of a user
+var websocketUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// websocketRequest is a message sent by the client, like
+// {"op": "subscribe", "channel": "book", "pair": "BTC/USDT"} or {"op": "auth", "token": "..."}
+type websocketRequest struct {
+	Op      string `json:"op"`
+	Channel string `json:"channel"`
+	Pair    string `json:"pair"`
+	Token   string `json:"token"`
+}
+
+// websocketResponse is a control message sent to the client
+type websocketResponse struct {
+	Type    string `json:"type"`
+	Channel string `json:"channel,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// websocketClient is a websocket connection and the feed subscriptions it forwards
+type websocketClient struct {
+	server    *Server
+	conn      *websocket.Conn
+	send      chan interface{}
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+	payload   *token.Payload
+	subs      map[string]*feed.Subscription
+}
+
+// GET ws://localhost:8080/ws
+//
+// serveWebsocket streams the market data and private channels of the feed over a websocket.
+// Every subscription starts with a snapshot, followed by the updates published after it.
+// A client that sees a gap in the sequence numbers of a channel must subscribe again to resync from a new snapshot.
+// A subscription that falls behind is dropped with an error, and a connection that can't keep up is closed
+func (server *Server) serveWebsocket(ctx *gin.Context) {
+	conn, err := websocketUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// the upgrader already responded with an error
+		return
+	}
+
+	client := &websocketClient{
+		server: server,
+		conn:   conn,
+		send:   make(chan interface{}, websocketBuffer),
+		done:   make(chan struct{}),
+		subs:   make(map[string]*feed.Subscription),
+	}
+	defer client.close()
+
+	go client.writePump()
+	client.readPump(ctx)
+}
+
+// readPump handles the requests of the client until the connection is closed
+func (client *websocketClient) readPump(ctx *gin.Context) {
+	client.conn.SetReadLimit(websocketMaxMessage)
+	client.conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	client.conn.SetPongHandler(func(string) error {
+		return client.conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	})
+
+	for {
+		_, message, err := client.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var req websocketRequest
+		if err := json.Unmarshal(message, &req); err != nil {
+			client.reply(websocketResponse{Type: websocketError, Error: err.Error()})
+			continue
+		}
+
+		switch req.Op {
+		case websocketAuth:
+			client.authenticate(req)
+		case websocketSubscribe:
+			client.subscribe(ctx, req)
+		case websocketUnsubscribe:
+			client.unsubscribe(req)
+		default:
+			err := fmt.Errorf("unsupported op %q", req.Op)
+			client.reply(websocketResponse{Type: websocketError, Error: err.Error()})
+		}
+	}
+}
+
+// writePump writes the queued messages and pings to the client until the connection is closed
+func (client *websocketClient) writePump() {
+	ticker := time.NewTicker(websocketPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-client.done:
+			return
+		case message := <-client.send:
+			client.conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+			if err := client.conn.WriteJSON(message); err != nil {
+				client.close()
+				return
+			}
+		case <-ticker.C:
+			client.conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+			if err := client.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				client.close()
+				return
+			}
+		}
+	}
+}
+
+// authenticate verifies the access token of the client, which can then subscribe to its private channels
+func (client *websocketClient) authenticate(req websocketRequest) {
+	payload, err := client.server.tokenMaker.VerifyToken(req.Token)
+	if err != nil {
+		client.reply(websocketResponse{Type: websocketError, Error: err.Error()})
+		return
+	}
+
+	client.mu.Lock()
+	client.payload = payload
+	client.mu.Unlock()
+
+	client.reply(websocketResponse{Type: websocketAuthenticated})
+}
+
+// subscribe subscribes the client to a channel and forwards its snapshot and updates.
+// Market data channels are keyed by pair and private channels by the authenticated user
+func (client *websocketClient) subscribe(ctx *gin.Context, req websocketRequest) {
+	key, err := client.key(req)
+	if err != nil {
+		client.reply(websocketResponse{Type: websocketError, Channel: req.Channel, Key: req.Pair, Error: err.Error()})
+		return
+	}
+
+	topic := feed.Topic(req.Channel, key)
+
+	client.mu.Lock()
+	if client.closed() {
+		client.mu.Unlock()
+		return
+	}
+	if _, ok := client.subs[topic]; ok {
+		client.mu.Unlock()
+		err := fmt.Errorf("already subscribed to %s", topic)
+		client.reply(websocketResponse{Type: websocketError, Channel: req.Channel, Key: key, Error: err.Error()})
+		return
+	}
+	// the subscription starts before the snapshot is taken, so no update published in between is missed
+	sub := client.server.engine.Feed().Subscribe(topic, websocketBuffer)
+	client.subs[topic] = sub
+	client.mu.Unlock()
+
+	snapshot, err := client.server.snapshot(ctx, req.Channel, key)
+	if err != nil {
+		client.remove(sub)
+		client.reply(websocketResponse{Type: websocketError, Channel: req.Channel, Key: key, Error: err.Error()})
+		return
+	}
+
+	go client.forward(sub, snapshot)
+}
+
+// key returns the key of the topic a subscription request is for
+func (client *websocketClient) key(req websocketRequest) (string, error) {
+	if !feed.IsSupportedChannel(req.Channel) {
+		return "", fmt.Errorf("unsupported channel %q", req.Channel)
+	}
+
+	if feed.IsPrivateChannel(req.Channel) {
+		client.mu.Lock()
+		payload := client.payload
+		client.mu.Unlock()
+
+		if payload == nil {
+			return "", errors.New("channel requires authentication")
+		}
+		if err := payload.Valid(); err != nil {
+			return "", err
+		}
+		return payload.Username, nil
+	}
+
+	if !client.server.registry.IsListedPair(req.Pair) {
+		return "", fmt.Errorf("pair %s is not listed", req.Pair)
+	}
+	return req.Pair, nil
+}
+
+// unsubscribe stops forwarding a channel to the client
+func (client *websocketClient) unsubscribe(req websocketRequest) {
+	key := req.Pair
+	if feed.IsPrivateChannel(req.Channel) {
+		client.mu.Lock()
+		if client.payload != nil {
+			key = client.payload.Username
+		}
+		client.mu.Unlock()
+	}
+
+	topic := feed.Topic(req.Channel, key)
+
+	client.mu.Lock()
+	sub, ok := client.subs[topic]
+	client.mu.Unlock()
+
+	if !ok {
+		err := fmt.Errorf("not subscribed to %s", topic)
+		client.reply(websocketResponse{Type: websocketError, Channel: req.Channel, Key: key, Error: err.Error()})
+		return
+	}
+
+	client.remove(sub)
+	client.reply(websocketResponse{Type: websocketUnsubscribed, Channel: req.Channel, Key: key})
+}
+
+// forward queues the snapshot of a subscription and then the updates that follow it,
+// until the subscription is removed or dropped for falling behind
+func (client *websocketClient) forward(sub *feed.Subscription, snapshot feed.Event) {
+	if !client.enqueue(snapshot) {
+		return
+	}
+
+	for event := range sub.Events() {
+		if event.Sequence <= snapshot.Sequence {
+			continue
+		}
+		if !client.active(sub) || !client.enqueue(event) {
+			return
+		}
+	}
+
+	if sub.Dropped() && client.active(sub) {
+		client.remove(sub)
+		err := errors.New("subscription fell behind, subscribe again to resync")
+		client.reply(websocketResponse{Type: websocketError, Channel: snapshot.Channel, Key: snapshot.Key, Error: err.Error()})
+	}
+}
+
+// active returns true if the subscription is still one of the client
+func (client *websocketClient) active(sub *feed.Subscription) bool {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	return client.subs[sub.Topic()] == sub
+}
+
+// remove closes a subscription of the client
+func (client *websocketClient) remove(sub *feed.Subscription) {
+	client.mu.Lock()
+	if client.subs[sub.Topic()] == sub {
+		delete(client.subs, sub.Topic())
+	}
+	client.mu.Unlock()
+
+	sub.Close()
+}
+
+// reply queues a control message
+func (client *websocketClient) reply(rsp websocketResponse) {
+	client.enqueue(rsp)
+}
+
+// enqueue queues a message for the writer without blocking.
+// The connection is closed if its queue is full, since the client can't keep up
+func (client *websocketClient) enqueue(message interface{}) bool {
+	if client.closed() {
+		return false
+	}
+
+	select {
+	case client.send <- message:
+		return true
+	default:
+		client.close()
+		return false
+	}
+}
+
+// closed returns true once the connection is closed
+func (client *websocketClient) closed() bool {
+	select {
+	case <-client.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// close closes the connection and every subscription of the client
+func (client *websocketClient) close() {
+	client.closeOnce.Do(func() {
+		close(client.done)
+		client.conn.Close()
+
+		client.mu.Lock()
+		subs := client.subs
+		client.subs = make(map[string]*feed.Subscription)
+		client.mu.Unlock()
+
+		for _, sub := range subs {
+			sub.Close()
+		}
+	})
+}
+
+// snapshot returns the current state of a channel.
+// The book snapshot is taken under the book lock with the sequence of its last update.
+// The other channels only publish complete states or trades with ids, so their snapshot is read
+// after the sequence and an update already part of it may still follow
+func (server *Server) snapshot(ctx *gin.Context, channel string, key string) (feed.Event, error) {
+	event := feed.Event{
+		Channel:  channel,
+		Key:      key,
+		Type:     feed.SNAPSHOT,
+		Sequence: server.engine.Feed().Sequence(feed.Topic(channel, key)),
+	}
+
+	switch channel {
+	case feed.BOOK:
+		depth, err := server.engine.Depth(key, 0)
+		if err != nil {
+			return event, err
+		}
+		event.Sequence = depth.Sequence
+		event.Data = depth
+	case feed.TRADES:
+		trades, err := server.store.ListPairTrades(ctx, db.ListPairTradesParams{
+			Pair:  key,
+			Limit: defaultTradeTape,
+		})
+		if err != nil {
+			return event, err
+		}
+
+		tape := make([]engine.MarketTrade, 0, len(trades))
+		for _, trade := range trades {
+			tape = append(tape, engine.NewMarketTrade(trade))
+		}
+		event.Data = tape
+	case feed.TICKER:
+		ticker, err := server.engine.Ticker(key)
+		if err != nil {
+			return event, err
+		}
+		event.Data = ticker
+	case feed.ORDERS:
+		owner := sql.NullString{String: key, Valid: true}
+
+		bids, err := server.store.ListOpenBidsByOwner(ctx, db.ListOpenBidsByOwnerParams{Owner: owner})
+		if err != nil {
+			return event, err
+		}
+		asks, err := server.store.ListOpenAsksByOwner(ctx, db.ListOpenAsksByOwnerParams{Owner: owner})
+		if err != nil {
+			return event, err
+		}
+
+		orders := make([]feed.Order, 0, len(bids)+len(asks))
+		for _, bid := range bids {
+			orders = append(orders, feed.NewOrderFromBid(bid))
+		}
+		for _, ask := range asks {
+			orders = append(orders, feed.NewOrderFromAsk(ask))
+		}
+		event.Data = orders
+	case feed.BALANCES:
+		accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+			Owner: key,
+			Limit: websocketMaxAccounts,
+		})
+		if err != nil {
+			return event, err
+		}
+		event.Data = accounts
+	case feed.FILLS:
+		// fills are only streamed, their history is available with the orders they belong to
+		event.Data = []feed.Fill{}
+	}
+
+	return event, nil
+}
@@ -0,0 +1,292 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/feed"
+	"go-exchange/util"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// dialWebsocket serves the router of the server and opens a websocket connection to it
+func dialWebsocket(t *testing.T, server *Server) *websocket.Conn {
+	httpServer := httptest.NewServer(server.router)
+	t.Cleanup(httpServer.Close)
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// websocketMessage is a message read from the server, either a feed event or a control message
+type websocketMessage struct {
+	Type     string          `json:"type"`
+	Channel  string          `json:"channel"`
+	Key      string          `json:"key"`
+	Sequence uint64          `json:"sequence"`
+	Data     json.RawMessage `json:"data"`
+	Error    string          `json:"error"`
+}
+
+func sendWebsocket(t *testing.T, conn *websocket.Conn, req websocketRequest) {
+	err := conn.WriteJSON(req)
+	require.NoError(t, err)
+}
+
+func readWebsocket(t *testing.T, conn *websocket.Conn) websocketMessage {
+	err := conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	require.NoError(t, err)
+
+	var message websocketMessage
+	err = conn.ReadJSON(&message)
+	require.NoError(t, err)
+	return message
+}
+
+func TestWebsocketBook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	server := newTestServer(t, store)
+
+	bid := db.Bid{ID: 1, Pair: util.BTC_USDT, Type: util.LIMIT, TimeInForce: util.GTC, Price: decimal.NewFromInt(100), RemainingAmount: decimal.NewFromInt(2), Status: util.ACTIVE}
+	_, err := server.engine.PlaceBid(context.Background(), bid)
+	require.NoError(t, err)
+
+	conn := dialWebsocket(t, server)
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.BOOK, Pair: util.BTC_USDT})
+
+	message := readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, feed.BOOK, message.Channel)
+	require.Equal(t, util.BTC_USDT, message.Key)
+	require.Equal(t, uint64(1), message.Sequence)
+
+	var depth engine.Depth
+	err = json.Unmarshal(message.Data, &depth)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), depth.Sequence)
+	require.Equal(t, []engine.PriceLevel{{Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(2), OrderCount: 1}}, depth.Bids)
+
+	ask := db.Ask{ID: 2, Pair: util.BTC_USDT, Type: util.LIMIT, TimeInForce: util.GTC, Price: decimal.NewFromInt(110), RemainingAmount: decimal.NewFromInt(3), Status: util.ACTIVE}
+	_, err = server.engine.PlaceAsk(context.Background(), ask)
+	require.NoError(t, err)
+
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.UPDATE, message.Type)
+	require.Equal(t, uint64(2), message.Sequence)
+
+	var update engine.BookUpdate
+	err = json.Unmarshal(message.Data, &update)
+	require.NoError(t, err)
+	require.Empty(t, update.Bids)
+	require.Equal(t, []engine.PriceLevel{{Price: decimal.NewFromInt(110), Amount: decimal.NewFromInt(3), OrderCount: 1}}, update.Asks)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.BOOK, Pair: util.BTC_USDT})
+	message = readWebsocket(t, conn)
+	require.Equal(t, websocketError, message.Type)
+	require.Contains(t, message.Error, "already subscribed")
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketUnsubscribe, Channel: feed.BOOK, Pair: util.BTC_USDT})
+	message = readWebsocket(t, conn)
+	require.Equal(t, websocketUnsubscribed, message.Type)
+	require.Equal(t, util.BTC_USDT, message.Key)
+
+	// the snapshot of a new subscription carries on from the last update
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.BOOK, Pair: util.BTC_USDT})
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, uint64(2), message.Sequence)
+}
+
+func TestWebsocketPublicChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trade := db.Trade{
+		ID:           util.RandomInt(1, 1000),
+		FirstAmount:  decimal.NewFromInt(200),
+		SecondAmount: decimal.NewFromInt(2),
+		Pair:         sql.NullString{String: util.ETH_USDT, Valid: true},
+		Price:        decimal.NullDecimal{Decimal: decimal.NewFromInt(100), Valid: true},
+		TakerSide:    sql.NullString{String: util.ASK, Valid: true},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListPairTrades(gomock.Any(), gomock.Eq(db.ListPairTradesParams{Pair: util.ETH_USDT, Limit: defaultTradeTape})).
+		Times(1).
+		Return([]db.Trade{trade}, nil)
+
+	server := newTestServer(t, store)
+	conn := dialWebsocket(t, server)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.TRADES, Pair: util.ETH_USDT})
+	message := readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, feed.TRADES, message.Channel)
+
+	var tape []engine.MarketTrade
+	err := json.Unmarshal(message.Data, &tape)
+	require.NoError(t, err)
+	require.Len(t, tape, 1)
+	require.Equal(t, trade.ID, tape[0].ID)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.TICKER, Pair: util.ETH_USDT})
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, feed.TICKER, message.Channel)
+
+	var ticker engine.Ticker
+	err = json.Unmarshal(message.Data, &ticker)
+	require.NoError(t, err)
+	require.Equal(t, util.ETH_USDT, ticker.Pair)
+
+	server.engine.Feed().Publish(feed.Event{Channel: feed.TRADES, Key: util.ETH_USDT, Data: engine.NewMarketTrade(trade)})
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.UPDATE, message.Type)
+	require.Equal(t, feed.TRADES, message.Channel)
+	require.Equal(t, uint64(1), message.Sequence)
+}
+
+func TestWebsocketPrivateChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user, _ := randomUser(t)
+	bid := db.Bid{ID: 1, Pair: util.BTC_USDT, Status: util.ACTIVE, Owner: user.Username}
+	owner := sql.NullString{String: user.Username, Valid: true}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListOpenBidsByOwner(gomock.Any(), gomock.Eq(db.ListOpenBidsByOwnerParams{Owner: owner})).
+		Times(1).
+		Return([]db.Bid{bid}, nil)
+	store.EXPECT().
+		ListOpenAsksByOwner(gomock.Any(), gomock.Eq(db.ListOpenAsksByOwnerParams{Owner: owner})).
+		Times(1).
+		Return([]db.Ask{}, nil)
+
+	server := newTestServer(t, store)
+	conn := dialWebsocket(t, server)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.ORDERS})
+	message := readWebsocket(t, conn)
+	require.Equal(t, websocketError, message.Type)
+	require.Contains(t, message.Error, "authentication")
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketAuth, Token: "invalid"})
+	message = readWebsocket(t, conn)
+	require.Equal(t, websocketError, message.Type)
+
+	token, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketAuth, Token: token})
+	message = readWebsocket(t, conn)
+	require.Equal(t, websocketAuthenticated, message.Type)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.ORDERS})
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, user.Username, message.Key)
+
+	var orders []feed.Order
+	err = json.Unmarshal(message.Data, &orders)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, bid.ID, orders[0].ID)
+	require.Equal(t, util.BID, orders[0].Side)
+
+	// updates of other users are never forwarded
+	hub := server.engine.Feed()
+	hub.Publish(feed.Event{Channel: feed.ORDERS, Key: util.RandomOwner(), Data: feed.NewOrderFromBid(bid)})
+	bid.Status = util.CANCELED
+	hub.Publish(feed.Event{Channel: feed.ORDERS, Key: user.Username, Data: feed.NewOrderFromBid(bid)})
+
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.UPDATE, message.Type)
+	require.Equal(t, user.Username, message.Key)
+	require.Equal(t, uint64(1), message.Sequence)
+
+	var order feed.Order
+	err = json.Unmarshal(message.Data, &order)
+	require.NoError(t, err)
+	require.Equal(t, util.CANCELED, order.Status)
+
+	sendWebsocket(t, conn, websocketRequest{Op: websocketSubscribe, Channel: feed.FILLS})
+	message = readWebsocket(t, conn)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, feed.FILLS, message.Channel)
+	require.JSONEq(t, `[]`, string(message.Data))
+}
+
+func TestWebsocketInvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		message string
+		err     string
+	}{
+		{
+			name:    "InvalidJSON",
+			message: `{"op":`,
+			err:     "unexpected end of JSON input",
+		},
+		{
+			name:    "UnsupportedOp",
+			message: `{"op":"publish","channel":"book","pair":"BTC/USDT"}`,
+			err:     "unsupported op",
+		},
+		{
+			name:    "UnsupportedChannel",
+			message: `{"op":"subscribe","channel":"candles","pair":"BTC/USDT"}`,
+			err:     "unsupported channel",
+		},
+		{
+			name:    "UnlistedPair",
+			message: `{"op":"subscribe","channel":"book","pair":"XYZ/USDT"}`,
+			err:     "is not listed",
+		},
+		{
+			name:    "NotSubscribed",
+			message: `{"op":"unsubscribe","channel":"book","pair":"BTC/USDT"}`,
+			err:     "not subscribed",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store)
+			conn := dialWebsocket(t, server)
+
+			err := conn.WriteMessage(websocket.TextMessage, []byte(tc.message))
+			require.NoError(t, err)
+
+			message := readWebsocket(t, conn)
+			require.Equal(t, websocketError, message.Type)
+			require.Contains(t, message.Error, tc.err)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+ALTER TABLE "accounts" ADD COLUMN "held" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "accounts"."held" IS 'funds reserved by open orders';
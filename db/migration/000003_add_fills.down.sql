@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "fills";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "filled_amount";
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "remaining_amount";
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "average_price";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "filled_amount";
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "remaining_amount";
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "average_price";
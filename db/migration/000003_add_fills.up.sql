@@ -0,0 +1,44 @@
+ALTER TABLE "bids" ADD COLUMN "filled_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "bids" ADD COLUMN "remaining_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "bids" ADD COLUMN "average_price" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "asks" ADD COLUMN "filled_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "asks" ADD COLUMN "remaining_amount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "asks" ADD COLUMN "average_price" bigint NOT NULL DEFAULT 0;
+
+UPDATE "bids" SET "remaining_amount" = "amount" WHERE "status" = 'active';
+UPDATE "asks" SET "remaining_amount" = "amount" WHERE "status" = 'active';
+UPDATE "bids" SET "filled_amount" = "amount", "average_price" = "price" WHERE "status" = 'completed';
+UPDATE "asks" SET "filled_amount" = "amount", "average_price" = "price" WHERE "status" = 'completed';
+
+CREATE TABLE "fills" (
+  "id" bigserial PRIMARY KEY,
+  "trade_id" bigint NOT NULL,
+  "bid_id" bigint NOT NULL,
+  "ask_id" bigint NOT NULL,
+  "price" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "fills" ("trade_id");
+
+CREATE INDEX ON "fills" ("bid_id");
+
+CREATE INDEX ON "fills" ("ask_id");
+
+COMMENT ON COLUMN "bids"."remaining_amount" IS 'amount - filled_amount';
+
+COMMENT ON COLUMN "bids"."average_price" IS 'average price of the fills';
+
+COMMENT ON COLUMN "asks"."remaining_amount" IS 'amount - filled_amount';
+
+COMMENT ON COLUMN "asks"."average_price" IS 'average price of the fills';
+
+COMMENT ON COLUMN "fills"."amount" IS 'it must be positive';
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("trade_id") REFERENCES "trades" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("bid_id") REFERENCES "bids" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("ask_id") REFERENCES "asks" ("id");
@@ -0,0 +1,3 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "type";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "type";
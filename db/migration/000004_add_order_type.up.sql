@@ -0,0 +1,7 @@
+ALTER TABLE "bids" ADD COLUMN "type" varchar NOT NULL DEFAULT 'limit';
+
+ALTER TABLE "asks" ADD COLUMN "type" varchar NOT NULL DEFAULT 'limit';
+
+COMMENT ON COLUMN "bids"."type" IS 'limit or market';
+
+COMMENT ON COLUMN "asks"."type" IS 'limit or market';
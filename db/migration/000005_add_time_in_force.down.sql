@@ -0,0 +1,7 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "expires_at";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "time_in_force";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "expires_at";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "time_in_force";
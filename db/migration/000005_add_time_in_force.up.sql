@@ -0,0 +1,19 @@
+ALTER TABLE "bids" ADD COLUMN "time_in_force" varchar NOT NULL DEFAULT 'GTC';
+
+ALTER TABLE "bids" ADD COLUMN "expires_at" timestamptz;
+
+ALTER TABLE "asks" ADD COLUMN "time_in_force" varchar NOT NULL DEFAULT 'GTC';
+
+ALTER TABLE "asks" ADD COLUMN "expires_at" timestamptz;
+
+CREATE INDEX ON "bids" ("expires_at");
+
+CREATE INDEX ON "asks" ("expires_at");
+
+COMMENT ON COLUMN "bids"."time_in_force" IS 'GTC, IOC, FOK or GTD';
+
+COMMENT ON COLUMN "bids"."expires_at" IS 'only set for GTD orders';
+
+COMMENT ON COLUMN "asks"."time_in_force" IS 'GTC, IOC, FOK or GTD';
+
+COMMENT ON COLUMN "asks"."expires_at" IS 'only set for GTD orders';
@@ -0,0 +1,7 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "stop_price";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "stop_price";
+
+COMMENT ON COLUMN "bids"."type" IS 'limit or market';
+
+COMMENT ON COLUMN "asks"."type" IS 'limit or market';
@@ -0,0 +1,11 @@
+ALTER TABLE "bids" ADD COLUMN "stop_price" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "asks" ADD COLUMN "stop_price" bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN "bids"."stop_price" IS 'trigger price of stop orders';
+
+COMMENT ON COLUMN "asks"."stop_price" IS 'trigger price of stop orders';
+
+COMMENT ON COLUMN "bids"."type" IS 'limit, market, stop_limit or stop_market';
+
+COMMENT ON COLUMN "asks"."type" IS 'limit, market, stop_limit or stop_market';
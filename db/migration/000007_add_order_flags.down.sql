@@ -0,0 +1,11 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "hidden";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "display_amount";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "post_only";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "hidden";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "display_amount";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "post_only";
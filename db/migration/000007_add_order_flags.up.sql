@@ -0,0 +1,23 @@
+ALTER TABLE "bids" ADD COLUMN "post_only" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "bids" ADD COLUMN "display_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "bids" ADD COLUMN "hidden" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "asks" ADD COLUMN "post_only" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "asks" ADD COLUMN "display_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "asks" ADD COLUMN "hidden" boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN "bids"."post_only" IS 'canceled instead of taking liquidity';
+
+COMMENT ON COLUMN "bids"."display_amount" IS 'visible amount of iceberg orders, 0 shows the whole amount';
+
+COMMENT ON COLUMN "bids"."hidden" IS 'kept out of the public depth';
+
+COMMENT ON COLUMN "asks"."post_only" IS 'canceled instead of taking liquidity';
+
+COMMENT ON COLUMN "asks"."display_amount" IS 'visible amount of iceberg orders, 0 shows the whole amount';
+
+COMMENT ON COLUMN "asks"."hidden" IS 'kept out of the public depth';
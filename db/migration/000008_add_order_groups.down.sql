@@ -0,0 +1,9 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "group_leg";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "group_id";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "group_leg";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "group_id";
+
+DROP TABLE IF EXISTS "order_groups";
@@ -0,0 +1,27 @@
+CREATE TABLE "order_groups" (
+  "id" bigserial PRIMARY KEY,
+  "type" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "bids" ADD COLUMN "group_id" bigint;
+
+ALTER TABLE "bids" ADD COLUMN "group_leg" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "asks" ADD COLUMN "group_id" bigint;
+
+ALTER TABLE "asks" ADD COLUMN "group_leg" varchar NOT NULL DEFAULT '';
+
+CREATE INDEX ON "bids" ("group_id");
+
+CREATE INDEX ON "asks" ("group_id");
+
+COMMENT ON COLUMN "order_groups"."type" IS 'oco or bracket';
+
+COMMENT ON COLUMN "bids"."group_leg" IS 'entry, take_profit or stop_loss';
+
+COMMENT ON COLUMN "asks"."group_leg" IS 'entry, take_profit or stop_loss';
+
+ALTER TABLE "bids" ADD FOREIGN KEY ("group_id") REFERENCES "order_groups" ("id");
+
+ALTER TABLE "asks" ADD FOREIGN KEY ("group_id") REFERENCES "order_groups" ("id");
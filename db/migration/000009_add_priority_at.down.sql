@@ -0,0 +1,3 @@
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "priority_at";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "priority_at";
@@ -0,0 +1,11 @@
+ALTER TABLE "bids" ADD COLUMN "priority_at" timestamptz NOT NULL DEFAULT (now());
+
+ALTER TABLE "asks" ADD COLUMN "priority_at" timestamptz NOT NULL DEFAULT (now());
+
+UPDATE "bids" SET "priority_at" = "created_at";
+
+UPDATE "asks" SET "priority_at" = "created_at";
+
+COMMENT ON COLUMN "bids"."priority_at" IS 'time the order joined the queue of its price';
+
+COMMENT ON COLUMN "asks"."priority_at" IS 'time the order joined the queue of its price';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "dead_man_switch_events";
+
+DROP TABLE IF EXISTS "dead_man_switches";
@@ -0,0 +1,31 @@
+CREATE TABLE "dead_man_switches" (
+  "username" varchar PRIMARY KEY,
+  "timeout_seconds" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "dead_man_switch_events" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "timeout_seconds" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "canceled_orders" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "dead_man_switches" ("expires_at");
+
+CREATE INDEX ON "dead_man_switch_events" ("username");
+
+COMMENT ON COLUMN "dead_man_switches"."expires_at" IS 'open orders are canceled if no heartbeat arrives before';
+
+COMMENT ON COLUMN "dead_man_switch_events"."action" IS 'armed, refreshed, disarmed or fired';
+
+COMMENT ON COLUMN "dead_man_switch_events"."canceled_orders" IS 'number of orders canceled when fired';
+
+ALTER TABLE "dead_man_switches" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "dead_man_switch_events" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
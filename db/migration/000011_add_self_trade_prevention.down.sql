@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS "order_events";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "self_trade_prevention";
+
+ALTER TABLE "bids" DROP COLUMN IF EXISTS "owner";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "self_trade_prevention";
+
+ALTER TABLE "asks" DROP COLUMN IF EXISTS "owner";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "self_trade_prevention";
@@ -0,0 +1,44 @@
+ALTER TABLE "users" ADD COLUMN "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest';
+
+ALTER TABLE "bids" ADD COLUMN "owner" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "bids" ADD COLUMN "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest';
+
+ALTER TABLE "asks" ADD COLUMN "owner" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "asks" ADD COLUMN "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest';
+
+UPDATE "bids" SET "owner" = "accounts"."owner" FROM "accounts" WHERE "accounts"."id" = "bids"."from_account_id";
+
+UPDATE "asks" SET "owner" = "accounts"."owner" FROM "accounts" WHERE "accounts"."id" = "asks"."from_account_id";
+
+CREATE TABLE "order_events" (
+  "id" bigserial PRIMARY KEY,
+  "side" varchar NOT NULL,
+  "order_id" bigint NOT NULL,
+  "type" varchar NOT NULL,
+  "self_trade_prevention" varchar NOT NULL DEFAULT '',
+  "counter_order_id" bigint,
+  "amount" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "order_events" ("side", "order_id");
+
+COMMENT ON COLUMN "users"."self_trade_prevention" IS 'default self-trade prevention mode of new orders';
+
+COMMENT ON COLUMN "bids"."owner" IS 'owner of the from account';
+
+COMMENT ON COLUMN "bids"."self_trade_prevention" IS 'cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel';
+
+COMMENT ON COLUMN "asks"."owner" IS 'owner of the from account';
+
+COMMENT ON COLUMN "asks"."self_trade_prevention" IS 'cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel';
+
+COMMENT ON COLUMN "order_events"."side" IS 'bid or ask';
+
+COMMENT ON COLUMN "order_events"."type" IS 'self_trade_prevented';
+
+COMMENT ON COLUMN "order_events"."counter_order_id" IS 'order of the other side';
+
+COMMENT ON COLUMN "order_events"."amount" IS 'amount taken off the order';
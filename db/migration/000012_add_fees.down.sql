@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "fee_accounts";
+
+DROP TABLE IF EXISTS "fee_tiers";
+
+DROP INDEX IF EXISTS "fills_created_at_idx";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "second_fee";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "first_fee";
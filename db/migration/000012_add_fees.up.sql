@@ -0,0 +1,44 @@
+ALTER TABLE "trades" ADD COLUMN "first_fee" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "trades" ADD COLUMN "second_fee" bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE "fee_tiers" (
+  "id" bigserial PRIMARY KEY,
+  "pair" varchar NOT NULL,
+  "min_volume" bigint NOT NULL DEFAULT 0,
+  "maker_rate" bigint NOT NULL,
+  "taker_rate" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_accounts" (
+  "currency" varchar PRIMARY KEY,
+  "account_id" bigint UNIQUE NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "fee_tiers" ("pair", "min_volume");
+
+CREATE INDEX ON "fills" ("created_at");
+
+COMMENT ON COLUMN "trades"."first_fee" IS 'taken from the first amount by the exchange';
+
+COMMENT ON COLUMN "trades"."second_fee" IS 'taken from the second amount by the exchange';
+
+COMMENT ON COLUMN "fee_tiers"."min_volume" IS 'trailing 30-day volume in the quote currency to reach the tier';
+
+COMMENT ON COLUMN "fee_tiers"."maker_rate" IS 'basis points';
+
+COMMENT ON COLUMN "fee_tiers"."taker_rate" IS 'basis points';
+
+ALTER TABLE "fee_accounts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('exchange', '', 'Exchange', 'fees@exchange.local');
+
+INSERT INTO "accounts" ("owner", "balance", "currency")
+SELECT 'exchange', 0, "currency"
+FROM unnest(ARRAY['BRL', 'CAD', 'EUR', 'JPY', 'USD', 'BTC', 'ETH', 'MATIC', 'SOL', 'USDT']) AS "currency";
+
+INSERT INTO "fee_accounts" ("currency", "account_id")
+SELECT "currency", "id" FROM "accounts" WHERE "owner" = 'exchange';
@@ -0,0 +1,15 @@
+ALTER TABLE IF EXISTS "fee_accounts" DROP CONSTRAINT IF EXISTS "fee_accounts_currency_fkey";
+
+ALTER TABLE IF EXISTS "fee_tiers" DROP CONSTRAINT IF EXISTS "fee_tiers_pair_fkey";
+
+ALTER TABLE IF EXISTS "asks" DROP CONSTRAINT IF EXISTS "asks_pair_fkey";
+
+ALTER TABLE IF EXISTS "bids" DROP CONSTRAINT IF EXISTS "bids_pair_fkey";
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "accounts_currency_fkey";
+
+DROP TABLE IF EXISTS "pairs";
+
+DROP TABLE IF EXISTS "currencies";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "role";
@@ -0,0 +1,79 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'trader';
+
+CREATE TABLE "currencies" (
+  "code" varchar PRIMARY KEY,
+  "decimals" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pairs" (
+  "symbol" varchar PRIMARY KEY,
+  "base" varchar NOT NULL,
+  "quote" varchar NOT NULL,
+  "tick_size" bigint NOT NULL DEFAULT 1,
+  "lot_size" bigint NOT NULL DEFAULT 1,
+  "min_notional" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "pairs" ("base", "quote");
+
+COMMENT ON COLUMN "users"."role" IS 'trader or admin';
+
+COMMENT ON COLUMN "currencies"."decimals" IS 'decimal places of the smallest unit of an amount';
+
+COMMENT ON COLUMN "currencies"."status" IS 'active, paused or delisted';
+
+COMMENT ON COLUMN "pairs"."tick_size" IS 'prices must be a multiple of it';
+
+COMMENT ON COLUMN "pairs"."lot_size" IS 'amounts must be a multiple of it';
+
+COMMENT ON COLUMN "pairs"."min_notional" IS 'minimum price*amount of an order in the quote currency';
+
+COMMENT ON COLUMN "pairs"."status" IS 'active, paused or delisted';
+
+INSERT INTO "currencies" ("code", "decimals") VALUES
+  ('BRL', 2),
+  ('CAD', 2),
+  ('EUR', 2),
+  ('JPY', 0),
+  ('USD', 2),
+  ('BTC', 8),
+  ('ETH', 18),
+  ('MATIC', 18),
+  ('SOL', 9),
+  ('USDT', 6);
+
+INSERT INTO "pairs" ("symbol", "base", "quote") VALUES
+  ('USDT/BRL', 'USDT', 'BRL'),
+  ('USDT/CAD', 'USDT', 'CAD'),
+  ('USDT/EUR', 'USDT', 'EUR'),
+  ('USDT/JPY', 'USDT', 'JPY'),
+  ('USDT/USD', 'USDT', 'USD'),
+  ('BTC/USDT', 'BTC', 'USDT'),
+  ('ETH/USDT', 'ETH', 'USDT'),
+  ('MATIC/USDT', 'MATIC', 'USDT'),
+  ('SOL/USDT', 'SOL', 'USDT'),
+  ('ETH/BTC', 'ETH', 'BTC'),
+  ('MATIC/BTC', 'MATIC', 'BTC'),
+  ('SOL/BTC', 'SOL', 'BTC'),
+  ('MATIC/ETH', 'MATIC', 'ETH'),
+  ('SOL/ETH', 'SOL', 'ETH');
+
+ALTER TABLE "pairs" ADD FOREIGN KEY ("base") REFERENCES "currencies" ("code");
+
+ALTER TABLE "pairs" ADD FOREIGN KEY ("quote") REFERENCES "currencies" ("code");
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("currency") REFERENCES "currencies" ("code");
+
+ALTER TABLE "bids" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "asks" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "fee_tiers" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "fee_accounts" ADD FOREIGN KEY ("currency") REFERENCES "currencies" ("code");
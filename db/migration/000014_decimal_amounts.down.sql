@@ -0,0 +1,48 @@
+ALTER TABLE "pairs"
+  ALTER COLUMN "tick_size" TYPE bigint USING round("tick_size"),
+  ALTER COLUMN "lot_size" TYPE bigint USING round("lot_size"),
+  ALTER COLUMN "min_notional" TYPE bigint USING round("min_notional");
+
+ALTER TABLE "fee_tiers"
+  ALTER COLUMN "min_volume" TYPE bigint USING round("min_volume");
+
+ALTER TABLE "order_events"
+  ALTER COLUMN "amount" TYPE bigint USING round("amount");
+
+ALTER TABLE "fills"
+  ALTER COLUMN "price" TYPE bigint USING round("price"),
+  ALTER COLUMN "amount" TYPE bigint USING round("amount");
+
+ALTER TABLE "asks"
+  ALTER COLUMN "price" TYPE bigint USING round("price"),
+  ALTER COLUMN "amount" TYPE bigint USING round("amount"),
+  ALTER COLUMN "filled_amount" TYPE bigint USING round("filled_amount"),
+  ALTER COLUMN "remaining_amount" TYPE bigint USING round("remaining_amount"),
+  ALTER COLUMN "average_price" TYPE bigint USING round("average_price"),
+  ALTER COLUMN "stop_price" TYPE bigint USING round("stop_price"),
+  ALTER COLUMN "display_amount" TYPE bigint USING round("display_amount");
+
+ALTER TABLE "bids"
+  ALTER COLUMN "price" TYPE bigint USING round("price"),
+  ALTER COLUMN "amount" TYPE bigint USING round("amount"),
+  ALTER COLUMN "filled_amount" TYPE bigint USING round("filled_amount"),
+  ALTER COLUMN "remaining_amount" TYPE bigint USING round("remaining_amount"),
+  ALTER COLUMN "average_price" TYPE bigint USING round("average_price"),
+  ALTER COLUMN "stop_price" TYPE bigint USING round("stop_price"),
+  ALTER COLUMN "display_amount" TYPE bigint USING round("display_amount");
+
+ALTER TABLE "trades"
+  ALTER COLUMN "first_amount" TYPE bigint USING round("first_amount"),
+  ALTER COLUMN "second_amount" TYPE bigint USING round("second_amount"),
+  ALTER COLUMN "first_fee" TYPE bigint USING round("first_fee"),
+  ALTER COLUMN "second_fee" TYPE bigint USING round("second_fee");
+
+ALTER TABLE "transfers"
+  ALTER COLUMN "amount" TYPE bigint USING round("amount");
+
+ALTER TABLE "entries"
+  ALTER COLUMN "amount" TYPE bigint USING round("amount");
+
+ALTER TABLE "accounts"
+  ALTER COLUMN "balance" TYPE bigint USING round("balance"),
+  ALTER COLUMN "held" TYPE bigint USING round("held");
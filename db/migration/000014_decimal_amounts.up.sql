@@ -0,0 +1,48 @@
+ALTER TABLE "accounts"
+  ALTER COLUMN "balance" TYPE numeric,
+  ALTER COLUMN "held" TYPE numeric;
+
+ALTER TABLE "entries"
+  ALTER COLUMN "amount" TYPE numeric;
+
+ALTER TABLE "transfers"
+  ALTER COLUMN "amount" TYPE numeric;
+
+ALTER TABLE "trades"
+  ALTER COLUMN "first_amount" TYPE numeric,
+  ALTER COLUMN "second_amount" TYPE numeric,
+  ALTER COLUMN "first_fee" TYPE numeric,
+  ALTER COLUMN "second_fee" TYPE numeric;
+
+ALTER TABLE "bids"
+  ALTER COLUMN "price" TYPE numeric,
+  ALTER COLUMN "amount" TYPE numeric,
+  ALTER COLUMN "filled_amount" TYPE numeric,
+  ALTER COLUMN "remaining_amount" TYPE numeric,
+  ALTER COLUMN "average_price" TYPE numeric,
+  ALTER COLUMN "stop_price" TYPE numeric,
+  ALTER COLUMN "display_amount" TYPE numeric;
+
+ALTER TABLE "asks"
+  ALTER COLUMN "price" TYPE numeric,
+  ALTER COLUMN "amount" TYPE numeric,
+  ALTER COLUMN "filled_amount" TYPE numeric,
+  ALTER COLUMN "remaining_amount" TYPE numeric,
+  ALTER COLUMN "average_price" TYPE numeric,
+  ALTER COLUMN "stop_price" TYPE numeric,
+  ALTER COLUMN "display_amount" TYPE numeric;
+
+ALTER TABLE "fills"
+  ALTER COLUMN "price" TYPE numeric,
+  ALTER COLUMN "amount" TYPE numeric;
+
+ALTER TABLE "order_events"
+  ALTER COLUMN "amount" TYPE numeric;
+
+ALTER TABLE "fee_tiers"
+  ALTER COLUMN "min_volume" TYPE numeric;
+
+ALTER TABLE "pairs"
+  ALTER COLUMN "tick_size" TYPE numeric,
+  ALTER COLUMN "lot_size" TYPE numeric,
+  ALTER COLUMN "min_notional" TYPE numeric;
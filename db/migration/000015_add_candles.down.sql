@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "candles";
+
+DROP INDEX IF EXISTS "trades_pair_created_at_idx";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "price";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "pair";
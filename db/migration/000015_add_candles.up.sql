@@ -0,0 +1,40 @@
+ALTER TABLE "trades" ADD COLUMN "pair" varchar;
+
+ALTER TABLE "trades" ADD COLUMN "price" numeric;
+
+UPDATE "trades" SET "pair" = "bids"."pair", "price" = "fills"."price"
+FROM "fills" JOIN "bids" ON "bids"."id" = "fills"."bid_id"
+WHERE "fills"."trade_id" = "trades"."id";
+
+CREATE TABLE "candles" (
+  "pair" varchar NOT NULL,
+  "interval" varchar NOT NULL,
+  "open_time" timestamptz NOT NULL,
+  "open" numeric NOT NULL,
+  "high" numeric NOT NULL,
+  "low" numeric NOT NULL,
+  "close" numeric NOT NULL,
+  "volume" numeric NOT NULL,
+  "quote_volume" numeric NOT NULL,
+  "trade_count" bigint NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("pair", "interval", "open_time")
+);
+
+CREATE INDEX ON "trades" ("pair", "created_at");
+
+COMMENT ON COLUMN "trades"."pair" IS 'pair the trade was made on';
+
+COMMENT ON COLUMN "trades"."price" IS 'price of the fill, null for trades made outside the order book';
+
+COMMENT ON COLUMN "candles"."interval" IS '1m, 5m, 15m, 1h, 4h or 1d';
+
+COMMENT ON COLUMN "candles"."open_time" IS 'start of the interval, aligned to the unix epoch';
+
+COMMENT ON COLUMN "candles"."volume" IS 'traded amount of the base currency';
+
+COMMENT ON COLUMN "candles"."quote_volume" IS 'traded amount of the quote currency';
+
+ALTER TABLE "trades" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "candles" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
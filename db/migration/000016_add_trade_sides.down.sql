@@ -0,0 +1,5 @@
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "taker_order_id";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "maker_order_id";
+
+ALTER TABLE "trades" DROP COLUMN IF EXISTS "taker_side";
@@ -0,0 +1,38 @@
+ALTER TABLE "trades" ADD COLUMN "taker_side" varchar;
+
+ALTER TABLE "trades" ADD COLUMN "maker_order_id" bigint;
+
+ALTER TABLE "trades" ADD COLUMN "taker_order_id" bigint;
+
+-- the taker is left unknown where the orders can't tell it
+UPDATE "trades" SET
+  "taker_side" = "sides"."taker_side",
+  "maker_order_id" = CASE WHEN "sides"."taker_side" = 'bid' THEN "sides"."ask_id" ELSE "sides"."bid_id" END,
+  "taker_order_id" = CASE WHEN "sides"."taker_side" = 'bid' THEN "sides"."bid_id" ELSE "sides"."ask_id" END
+FROM (
+  SELECT
+    "fills"."trade_id",
+    "bids"."id" AS "bid_id",
+    "asks"."id" AS "ask_id",
+    CASE
+      -- market orders never rest on the book
+      WHEN "bids"."type" IN ('market', 'stop_market') THEN 'bid'
+      WHEN "asks"."type" IN ('market', 'stop_market') THEN 'ask'
+      -- stop limit orders take liquidity when triggered, long after they joined their queue
+      WHEN "bids"."type" = 'stop_limit' OR "asks"."type" = 'stop_limit' THEN NULL
+      -- an order amended after the fill joined its queue again later
+      WHEN "bids"."priority_at" > "fills"."created_at" OR "asks"."priority_at" > "fills"."created_at" THEN NULL
+      WHEN "bids"."priority_at" > "asks"."priority_at" THEN 'bid'
+      WHEN "asks"."priority_at" > "bids"."priority_at" THEN 'ask'
+    END AS "taker_side"
+  FROM "fills"
+  JOIN "bids" ON "bids"."id" = "fills"."bid_id"
+  JOIN "asks" ON "asks"."id" = "fills"."ask_id"
+) AS "sides"
+WHERE "sides"."trade_id" = "trades"."id" AND "sides"."taker_side" IS NOT NULL;
+
+COMMENT ON COLUMN "trades"."taker_side" IS 'side of the order that took liquidity, null for trades made outside the order book';
+
+COMMENT ON COLUMN "trades"."maker_order_id" IS 'bid or ask resting on the book, on the side opposite to the taker';
+
+COMMENT ON COLUMN "trades"."taker_order_id" IS 'bid or ask that took liquidity, on the taker side';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "fills_ask_id_created_at_idx";
+
+DROP INDEX IF EXISTS "fills_bid_id_created_at_idx";
+
+DROP INDEX IF EXISTS "asks_owner_pair_idx";
+
+DROP INDEX IF EXISTS "bids_owner_pair_idx";
@@ -0,0 +1,7 @@
+CREATE INDEX ON "bids" ("owner", "pair");
+
+CREATE INDEX ON "asks" ("owner", "pair");
+
+CREATE INDEX ON "fills" ("bid_id", "created_at");
+
+CREATE INDEX ON "fills" ("ask_id", "created_at");
@@ -6,8 +6,11 @@ package mockdb
 
 import (
 	context "context"
+	sql "database/sql"
 	db "go-exchange/db/sqlc"
+	decimal "go-exchange/decimal"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +39,36 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// ActivateAsk mocks base method.
+func (m *MockStore) ActivateAsk(arg0 context.Context, arg1 db.ActivateAskParams) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateAsk indicates an expected call of ActivateAsk.
+func (mr *MockStoreMockRecorder) ActivateAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateAsk", reflect.TypeOf((*MockStore)(nil).ActivateAsk), arg0, arg1)
+}
+
+// ActivateBid mocks base method.
+func (m *MockStore) ActivateBid(arg0 context.Context, arg1 db.ActivateBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateBid indicates an expected call of ActivateBid.
+func (mr *MockStoreMockRecorder) ActivateBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateBid", reflect.TypeOf((*MockStore)(nil).ActivateBid), arg0, arg1)
+}
+
 // AddAccountBalance mocks base method.
 func (m *MockStore) AddAccountBalance(arg0 context.Context, arg1 db.AddAccountBalanceParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +84,216 @@ func (mr *MockStoreMockRecorder) AddAccountBalance(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), arg0, arg1)
 }
 
+// AddAccountHeld mocks base method.
+func (m *MockStore) AddAccountHeld(arg0 context.Context, arg1 db.AddAccountHeldParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountHeld", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountHeld indicates an expected call of AddAccountHeld.
+func (mr *MockStoreMockRecorder) AddAccountHeld(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountHeld", reflect.TypeOf((*MockStore)(nil).AddAccountHeld), arg0, arg1)
+}
+
+// AmendAsk mocks base method.
+func (m *MockStore) AmendAsk(arg0 context.Context, arg1 db.AmendAskParams) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendAsk indicates an expected call of AmendAsk.
+func (mr *MockStoreMockRecorder) AmendAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendAsk", reflect.TypeOf((*MockStore)(nil).AmendAsk), arg0, arg1)
+}
+
+// AmendAskTx mocks base method.
+func (m *MockStore) AmendAskTx(arg0 context.Context, arg1 db.AmendAskParams) (db.AmendAskTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendAskTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AmendAskTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendAskTx indicates an expected call of AmendAskTx.
+func (mr *MockStoreMockRecorder) AmendAskTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendAskTx", reflect.TypeOf((*MockStore)(nil).AmendAskTx), arg0, arg1)
+}
+
+// AmendBid mocks base method.
+func (m *MockStore) AmendBid(arg0 context.Context, arg1 db.AmendBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendBid indicates an expected call of AmendBid.
+func (mr *MockStoreMockRecorder) AmendBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendBid", reflect.TypeOf((*MockStore)(nil).AmendBid), arg0, arg1)
+}
+
+// AmendBidTx mocks base method.
+func (m *MockStore) AmendBidTx(arg0 context.Context, arg1 db.AmendBidParams) (db.AmendBidTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendBidTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AmendBidTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendBidTx indicates an expected call of AmendBidTx.
+func (mr *MockStoreMockRecorder) AmendBidTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendBidTx", reflect.TypeOf((*MockStore)(nil).AmendBidTx), arg0, arg1)
+}
+
+// ArmDeadManSwitchTx mocks base method.
+func (m *MockStore) ArmDeadManSwitchTx(arg0 context.Context, arg1 db.ArmDeadManSwitchTxParams) (db.DeadManSwitchTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArmDeadManSwitchTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitchTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArmDeadManSwitchTx indicates an expected call of ArmDeadManSwitchTx.
+func (mr *MockStoreMockRecorder) ArmDeadManSwitchTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArmDeadManSwitchTx", reflect.TypeOf((*MockStore)(nil).ArmDeadManSwitchTx), arg0, arg1)
+}
+
+// BackfillCandles mocks base method.
+func (m *MockStore) BackfillCandles(arg0 context.Context, arg1 db.BackfillCandlesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillCandles", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillCandles indicates an expected call of BackfillCandles.
+func (mr *MockStoreMockRecorder) BackfillCandles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillCandles", reflect.TypeOf((*MockStore)(nil).BackfillCandles), arg0, arg1)
+}
+
+// BackfillCandlesTx mocks base method.
+func (m *MockStore) BackfillCandlesTx(arg0 context.Context, arg1 db.BackfillCandlesTxParams) (db.BackfillCandlesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillCandlesTx", arg0, arg1)
+	ret0, _ := ret[0].(db.BackfillCandlesTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillCandlesTx indicates an expected call of BackfillCandlesTx.
+func (mr *MockStoreMockRecorder) BackfillCandlesTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillCandlesTx", reflect.TypeOf((*MockStore)(nil).BackfillCandlesTx), arg0, arg1)
+}
+
+// CancelAskTx mocks base method.
+func (m *MockStore) CancelAskTx(arg0 context.Context, arg1 int64) (db.CancelAskTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAskTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelAskTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelAskTx indicates an expected call of CancelAskTx.
+func (mr *MockStoreMockRecorder) CancelAskTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAskTx", reflect.TypeOf((*MockStore)(nil).CancelAskTx), arg0, arg1)
+}
+
+// CancelBidTx mocks base method.
+func (m *MockStore) CancelBidTx(arg0 context.Context, arg1 int64) (db.CancelBidTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBidTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelBidTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBidTx indicates an expected call of CancelBidTx.
+func (mr *MockStoreMockRecorder) CancelBidTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBidTx", reflect.TypeOf((*MockStore)(nil).CancelBidTx), arg0, arg1)
+}
+
+// CancelOrderGroupTx mocks base method.
+func (m *MockStore) CancelOrderGroupTx(arg0 context.Context, arg1 int64) (db.CancelOrderGroupTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderGroupTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelOrderGroupTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrderGroupTx indicates an expected call of CancelOrderGroupTx.
+func (mr *MockStoreMockRecorder) CancelOrderGroupTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderGroupTx", reflect.TypeOf((*MockStore)(nil).CancelOrderGroupTx), arg0, arg1)
+}
+
+// CancelOrdersTx mocks base method.
+func (m *MockStore) CancelOrdersTx(arg0 context.Context, arg1 db.CancelOrdersTxParams) (db.CancelOrdersTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrdersTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelOrdersTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrdersTx indicates an expected call of CancelOrdersTx.
+func (mr *MockStoreMockRecorder) CancelOrdersTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrdersTx", reflect.TypeOf((*MockStore)(nil).CancelOrdersTx), arg0, arg1)
+}
+
+// CloseAsk mocks base method.
+func (m *MockStore) CloseAsk(arg0 context.Context, arg1 db.CloseAskParams) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAsk indicates an expected call of CloseAsk.
+func (mr *MockStoreMockRecorder) CloseAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAsk", reflect.TypeOf((*MockStore)(nil).CloseAsk), arg0, arg1)
+}
+
+// CloseBid mocks base method.
+func (m *MockStore) CloseBid(arg0 context.Context, arg1 db.CloseBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseBid indicates an expected call of CloseBid.
+func (mr *MockStoreMockRecorder) CloseBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseBid", reflect.TypeOf((*MockStore)(nil).CloseBid), arg0, arg1)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(arg0 context.Context, arg1 db.CreateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -75,353 +318,1207 @@ func (m *MockStore) CreateAsk(arg0 context.Context, arg1 db.CreateAskParams) (db
 	return ret0, ret1
 }
 
-// CreateAsk indicates an expected call of CreateAsk.
-func (mr *MockStoreMockRecorder) CreateAsk(arg0, arg1 interface{}) *gomock.Call {
+// CreateAsk indicates an expected call of CreateAsk.
+func (mr *MockStoreMockRecorder) CreateAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAsk", reflect.TypeOf((*MockStore)(nil).CreateAsk), arg0, arg1)
+}
+
+// CreateAskTx mocks base method.
+func (m *MockStore) CreateAskTx(arg0 context.Context, arg1 db.CreateAskParams) (db.CreateAskTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAskTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateAskTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAskTx indicates an expected call of CreateAskTx.
+func (mr *MockStoreMockRecorder) CreateAskTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAskTx", reflect.TypeOf((*MockStore)(nil).CreateAskTx), arg0, arg1)
+}
+
+// CreateBid mocks base method.
+func (m *MockStore) CreateBid(arg0 context.Context, arg1 db.CreateBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBid indicates an expected call of CreateBid.
+func (mr *MockStoreMockRecorder) CreateBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBid", reflect.TypeOf((*MockStore)(nil).CreateBid), arg0, arg1)
+}
+
+// CreateBidTx mocks base method.
+func (m *MockStore) CreateBidTx(arg0 context.Context, arg1 db.CreateBidParams) (db.CreateBidTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBidTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateBidTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBidTx indicates an expected call of CreateBidTx.
+func (mr *MockStoreMockRecorder) CreateBidTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBidTx", reflect.TypeOf((*MockStore)(nil).CreateBidTx), arg0, arg1)
+}
+
+// CreateCurrency mocks base method.
+func (m *MockStore) CreateCurrency(arg0 context.Context, arg1 db.CreateCurrencyParams) (db.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCurrency indicates an expected call of CreateCurrency.
+func (mr *MockStoreMockRecorder) CreateCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrency", reflect.TypeOf((*MockStore)(nil).CreateCurrency), arg0, arg1)
+}
+
+// CreateCurrencyTx mocks base method.
+func (m *MockStore) CreateCurrencyTx(arg0 context.Context, arg1 db.CreateCurrencyParams) (db.CreateCurrencyTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCurrencyTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateCurrencyTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCurrencyTx indicates an expected call of CreateCurrencyTx.
+func (mr *MockStoreMockRecorder) CreateCurrencyTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCurrencyTx", reflect.TypeOf((*MockStore)(nil).CreateCurrencyTx), arg0, arg1)
+}
+
+// CreateDeadManSwitchEvent mocks base method.
+func (m *MockStore) CreateDeadManSwitchEvent(arg0 context.Context, arg1 db.CreateDeadManSwitchEventParams) (db.DeadManSwitchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadManSwitchEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadManSwitchEvent indicates an expected call of CreateDeadManSwitchEvent.
+func (mr *MockStoreMockRecorder) CreateDeadManSwitchEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadManSwitchEvent", reflect.TypeOf((*MockStore)(nil).CreateDeadManSwitchEvent), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+}
+
+// CreateFeeAccount mocks base method.
+func (m *MockStore) CreateFeeAccount(arg0 context.Context, arg1 db.CreateFeeAccountParams) (db.FeeAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeAccount indicates an expected call of CreateFeeAccount.
+func (mr *MockStoreMockRecorder) CreateFeeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeAccount", reflect.TypeOf((*MockStore)(nil).CreateFeeAccount), arg0, arg1)
+}
+
+// CreateFeeTier mocks base method.
+func (m *MockStore) CreateFeeTier(arg0 context.Context, arg1 db.CreateFeeTierParams) (db.FeeTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeTier", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeTier indicates an expected call of CreateFeeTier.
+func (mr *MockStoreMockRecorder) CreateFeeTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeTier", reflect.TypeOf((*MockStore)(nil).CreateFeeTier), arg0, arg1)
+}
+
+// CreateFill mocks base method.
+func (m *MockStore) CreateFill(arg0 context.Context, arg1 db.CreateFillParams) (db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFill", arg0, arg1)
+	ret0, _ := ret[0].(db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFill indicates an expected call of CreateFill.
+func (mr *MockStoreMockRecorder) CreateFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFill", reflect.TypeOf((*MockStore)(nil).CreateFill), arg0, arg1)
+}
+
+// CreateOrderEvent mocks base method.
+func (m *MockStore) CreateOrderEvent(arg0 context.Context, arg1 db.CreateOrderEventParams) (db.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderEvent indicates an expected call of CreateOrderEvent.
+func (mr *MockStoreMockRecorder) CreateOrderEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderEvent", reflect.TypeOf((*MockStore)(nil).CreateOrderEvent), arg0, arg1)
+}
+
+// CreateOrderGroup mocks base method.
+func (m *MockStore) CreateOrderGroup(arg0 context.Context, arg1 string) (db.OrderGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderGroup", arg0, arg1)
+	ret0, _ := ret[0].(db.OrderGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderGroup indicates an expected call of CreateOrderGroup.
+func (mr *MockStoreMockRecorder) CreateOrderGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderGroup", reflect.TypeOf((*MockStore)(nil).CreateOrderGroup), arg0, arg1)
+}
+
+// CreateOrderGroupTx mocks base method.
+func (m *MockStore) CreateOrderGroupTx(arg0 context.Context, arg1 db.CreateOrderGroupTxParams) (db.CreateOrderGroupTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderGroupTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateOrderGroupTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderGroupTx indicates an expected call of CreateOrderGroupTx.
+func (mr *MockStoreMockRecorder) CreateOrderGroupTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderGroupTx", reflect.TypeOf((*MockStore)(nil).CreateOrderGroupTx), arg0, arg1)
+}
+
+// CreatePair mocks base method.
+func (m *MockStore) CreatePair(arg0 context.Context, arg1 db.CreatePairParams) (db.Pair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePair", arg0, arg1)
+	ret0, _ := ret[0].(db.Pair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePair indicates an expected call of CreatePair.
+func (mr *MockStoreMockRecorder) CreatePair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePair", reflect.TypeOf((*MockStore)(nil).CreatePair), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateTrade mocks base method.
+func (m *MockStore) CreateTrade(arg0 context.Context, arg1 db.CreateTradeParams) (db.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrade", arg0, arg1)
+	ret0, _ := ret[0].(db.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrade indicates an expected call of CreateTrade.
+func (mr *MockStoreMockRecorder) CreateTrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrade", reflect.TypeOf((*MockStore)(nil).CreateTrade), arg0, arg1)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// DecrementAsk mocks base method.
+func (m *MockStore) DecrementAsk(arg0 context.Context, arg1 db.DecrementAskParams) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecrementAsk indicates an expected call of DecrementAsk.
+func (mr *MockStoreMockRecorder) DecrementAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementAsk", reflect.TypeOf((*MockStore)(nil).DecrementAsk), arg0, arg1)
+}
+
+// DecrementBid mocks base method.
+func (m *MockStore) DecrementBid(arg0 context.Context, arg1 db.DecrementBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecrementBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecrementBid indicates an expected call of DecrementBid.
+func (mr *MockStoreMockRecorder) DecrementBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementBid", reflect.TypeOf((*MockStore)(nil).DecrementBid), arg0, arg1)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+}
+
+// DeleteDeadManSwitch mocks base method.
+func (m *MockStore) DeleteDeadManSwitch(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadManSwitch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadManSwitch indicates an expected call of DeleteDeadManSwitch.
+func (mr *MockStoreMockRecorder) DeleteDeadManSwitch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadManSwitch", reflect.TypeOf((*MockStore)(nil).DeleteDeadManSwitch), arg0, arg1)
+}
+
+// DeleteFeeTier mocks base method.
+func (m *MockStore) DeleteFeeTier(arg0 context.Context, arg1 int64) (db.FeeTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeTier", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeeTier indicates an expected call of DeleteFeeTier.
+func (mr *MockStoreMockRecorder) DeleteFeeTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeTier", reflect.TypeOf((*MockStore)(nil).DeleteFeeTier), arg0, arg1)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+}
+
+// DisarmDeadManSwitchTx mocks base method.
+func (m *MockStore) DisarmDeadManSwitchTx(arg0 context.Context, arg1 string) (db.DeadManSwitchTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisarmDeadManSwitchTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitchTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisarmDeadManSwitchTx indicates an expected call of DisarmDeadManSwitchTx.
+func (mr *MockStoreMockRecorder) DisarmDeadManSwitchTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisarmDeadManSwitchTx", reflect.TypeOf((*MockStore)(nil).DisarmDeadManSwitchTx), arg0, arg1)
+}
+
+// ExpireAskTx mocks base method.
+func (m *MockStore) ExpireAskTx(arg0 context.Context, arg1 int64) (db.CancelAskTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAskTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelAskTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireAskTx indicates an expected call of ExpireAskTx.
+func (mr *MockStoreMockRecorder) ExpireAskTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAskTx", reflect.TypeOf((*MockStore)(nil).ExpireAskTx), arg0, arg1)
+}
+
+// ExpireBidTx mocks base method.
+func (m *MockStore) ExpireBidTx(arg0 context.Context, arg1 int64) (db.CancelBidTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireBidTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CancelBidTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireBidTx indicates an expected call of ExpireBidTx.
+func (mr *MockStoreMockRecorder) ExpireBidTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireBidTx", reflect.TypeOf((*MockStore)(nil).ExpireBidTx), arg0, arg1)
+}
+
+// FillAsk mocks base method.
+func (m *MockStore) FillAsk(arg0 context.Context, arg1 db.FillAskParams) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillAsk indicates an expected call of FillAsk.
+func (mr *MockStoreMockRecorder) FillAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillAsk", reflect.TypeOf((*MockStore)(nil).FillAsk), arg0, arg1)
+}
+
+// FillBid mocks base method.
+func (m *MockStore) FillBid(arg0 context.Context, arg1 db.FillBidParams) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillBid indicates an expected call of FillBid.
+func (mr *MockStoreMockRecorder) FillBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillBid", reflect.TypeOf((*MockStore)(nil).FillBid), arg0, arg1)
+}
+
+// FillTx mocks base method.
+func (m *MockStore) FillTx(arg0 context.Context, arg1 db.FillTxParams) (db.FillTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FillTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillTx indicates an expected call of FillTx.
+func (mr *MockStoreMockRecorder) FillTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillTx", reflect.TypeOf((*MockStore)(nil).FillTx), arg0, arg1)
+}
+
+// FireDeadManSwitchTx mocks base method.
+func (m *MockStore) FireDeadManSwitchTx(arg0 context.Context, arg1 db.FireDeadManSwitchTxParams) (db.DeadManSwitchTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FireDeadManSwitchTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitchTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FireDeadManSwitchTx indicates an expected call of FireDeadManSwitchTx.
+func (mr *MockStoreMockRecorder) FireDeadManSwitchTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FireDeadManSwitchTx", reflect.TypeOf((*MockStore)(nil).FireDeadManSwitchTx), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+}
+
+// GetAsk mocks base method.
+func (m *MockStore) GetAsk(arg0 context.Context, arg1 int64) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsk indicates an expected call of GetAsk.
+func (mr *MockStoreMockRecorder) GetAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsk", reflect.TypeOf((*MockStore)(nil).GetAsk), arg0, arg1)
+}
+
+// GetAskForUpdate mocks base method.
+func (m *MockStore) GetAskForUpdate(arg0 context.Context, arg1 int64) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAskForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAskForUpdate indicates an expected call of GetAskForUpdate.
+func (mr *MockStoreMockRecorder) GetAskForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAskForUpdate", reflect.TypeOf((*MockStore)(nil).GetAskForUpdate), arg0, arg1)
+}
+
+// GetBid mocks base method.
+func (m *MockStore) GetBid(arg0 context.Context, arg1 int64) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBid indicates an expected call of GetBid.
+func (mr *MockStoreMockRecorder) GetBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBid", reflect.TypeOf((*MockStore)(nil).GetBid), arg0, arg1)
+}
+
+// GetBidFee mocks base method.
+func (m *MockStore) GetBidFee(arg0 context.Context, arg1 int64) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBidFee", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBidFee indicates an expected call of GetBidFee.
+func (mr *MockStoreMockRecorder) GetBidFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBidFee", reflect.TypeOf((*MockStore)(nil).GetBidFee), arg0, arg1)
+}
+
+// GetBidForUpdate mocks base method.
+func (m *MockStore) GetBidForUpdate(arg0 context.Context, arg1 int64) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBidForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBidForUpdate indicates an expected call of GetBidForUpdate.
+func (mr *MockStoreMockRecorder) GetBidForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBidForUpdate", reflect.TypeOf((*MockStore)(nil).GetBidForUpdate), arg0, arg1)
+}
+
+// GetCurrency mocks base method.
+func (m *MockStore) GetCurrency(arg0 context.Context, arg1 string) (db.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrency indicates an expected call of GetCurrency.
+func (mr *MockStoreMockRecorder) GetCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockStore)(nil).GetCurrency), arg0, arg1)
+}
+
+// GetDeadManSwitch mocks base method.
+func (m *MockStore) GetDeadManSwitch(arg0 context.Context, arg1 string) (db.DeadManSwitch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadManSwitch", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadManSwitch indicates an expected call of GetDeadManSwitch.
+func (mr *MockStoreMockRecorder) GetDeadManSwitch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadManSwitch", reflect.TypeOf((*MockStore)(nil).GetDeadManSwitch), arg0, arg1)
+}
+
+// GetDeadManSwitchForUpdate mocks base method.
+func (m *MockStore) GetDeadManSwitchForUpdate(arg0 context.Context, arg1 string) (db.DeadManSwitch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadManSwitchForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadManSwitchForUpdate indicates an expected call of GetDeadManSwitchForUpdate.
+func (mr *MockStoreMockRecorder) GetDeadManSwitchForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadManSwitchForUpdate", reflect.TypeOf((*MockStore)(nil).GetDeadManSwitchForUpdate), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+}
+
+// GetFeeAccount mocks base method.
+func (m *MockStore) GetFeeAccount(arg0 context.Context, arg1 string) (db.FeeAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeAccount indicates an expected call of GetFeeAccount.
+func (mr *MockStoreMockRecorder) GetFeeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeAccount", reflect.TypeOf((*MockStore)(nil).GetFeeAccount), arg0, arg1)
+}
+
+// GetFeeTier mocks base method.
+func (m *MockStore) GetFeeTier(arg0 context.Context, arg1 db.GetFeeTierParams) (db.FeeTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeTier", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeTier indicates an expected call of GetFeeTier.
+func (mr *MockStoreMockRecorder) GetFeeTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeTier", reflect.TypeOf((*MockStore)(nil).GetFeeTier), arg0, arg1)
+}
+
+// GetFill mocks base method.
+func (m *MockStore) GetFill(arg0 context.Context, arg1 int64) (db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFill", arg0, arg1)
+	ret0, _ := ret[0].(db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFill indicates an expected call of GetFill.
+func (mr *MockStoreMockRecorder) GetFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFill", reflect.TypeOf((*MockStore)(nil).GetFill), arg0, arg1)
+}
+
+// GetOrderGroup mocks base method.
+func (m *MockStore) GetOrderGroup(arg0 context.Context, arg1 int64) (db.OrderGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderGroup", arg0, arg1)
+	ret0, _ := ret[0].(db.OrderGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderGroup indicates an expected call of GetOrderGroup.
+func (mr *MockStoreMockRecorder) GetOrderGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderGroup", reflect.TypeOf((*MockStore)(nil).GetOrderGroup), arg0, arg1)
+}
+
+// GetPair mocks base method.
+func (m *MockStore) GetPair(arg0 context.Context, arg1 string) (db.Pair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPair", arg0, arg1)
+	ret0, _ := ret[0].(db.Pair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPair indicates an expected call of GetPair.
+func (mr *MockStoreMockRecorder) GetPair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPair", reflect.TypeOf((*MockStore)(nil).GetPair), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetTrade mocks base method.
+func (m *MockStore) GetTrade(arg0 context.Context, arg1 int64) (db.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrade", arg0, arg1)
+	ret0, _ := ret[0].(db.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrade indicates an expected call of GetTrade.
+func (mr *MockStoreMockRecorder) GetTrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrade", reflect.TypeOf((*MockStore)(nil).GetTrade), arg0, arg1)
+}
+
+// GetTradedVolume mocks base method.
+func (m *MockStore) GetTradedVolume(arg0 context.Context, arg1 db.GetTradedVolumeParams) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradedVolume", arg0, arg1)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradedVolume indicates an expected call of GetTradedVolume.
+func (mr *MockStoreMockRecorder) GetTradedVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradedVolume", reflect.TypeOf((*MockStore)(nil).GetTradedVolume), arg0, arg1)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+}
+
+// ListAskFills mocks base method.
+func (m *MockStore) ListAskFills(arg0 context.Context, arg1 int64) ([]db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAskFills", arg0, arg1)
+	ret0, _ := ret[0].([]db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAskFills indicates an expected call of ListAskFills.
+func (mr *MockStoreMockRecorder) ListAskFills(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAskFills", reflect.TypeOf((*MockStore)(nil).ListAskFills), arg0, arg1)
+}
+
+// ListAsks mocks base method.
+func (m *MockStore) ListAsks(arg0 context.Context, arg1 db.ListAsksParams) ([]db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAsks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsks indicates an expected call of ListAsks.
+func (mr *MockStoreMockRecorder) ListAsks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsks", reflect.TypeOf((*MockStore)(nil).ListAsks), arg0, arg1)
+}
+
+// ListAsksByGroup mocks base method.
+func (m *MockStore) ListAsksByGroup(arg0 context.Context, arg1 sql.NullInt64) ([]db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAsksByGroup", arg0, arg1)
+	ret0, _ := ret[0].([]db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsksByGroup indicates an expected call of ListAsksByGroup.
+func (mr *MockStoreMockRecorder) ListAsksByGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsksByGroup", reflect.TypeOf((*MockStore)(nil).ListAsksByGroup), arg0, arg1)
+}
+
+// ListAsksByStatus mocks base method.
+func (m *MockStore) ListAsksByStatus(arg0 context.Context, arg1 string) ([]db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAsksByStatus", arg0, arg1)
+	ret0, _ := ret[0].([]db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsksByStatus indicates an expected call of ListAsksByStatus.
+func (mr *MockStoreMockRecorder) ListAsksByStatus(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAsk", reflect.TypeOf((*MockStore)(nil).CreateAsk), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsksByStatus", reflect.TypeOf((*MockStore)(nil).ListAsksByStatus), arg0, arg1)
 }
 
-// CreateBid mocks base method.
-func (m *MockStore) CreateBid(arg0 context.Context, arg1 db.CreateBidParams) (db.Bid, error) {
+// ListBidFills mocks base method.
+func (m *MockStore) ListBidFills(arg0 context.Context, arg1 int64) ([]db.Fill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBid", arg0, arg1)
-	ret0, _ := ret[0].(db.Bid)
+	ret := m.ctrl.Call(m, "ListBidFills", arg0, arg1)
+	ret0, _ := ret[0].([]db.Fill)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateBid indicates an expected call of CreateBid.
-func (mr *MockStoreMockRecorder) CreateBid(arg0, arg1 interface{}) *gomock.Call {
+// ListBidFills indicates an expected call of ListBidFills.
+func (mr *MockStoreMockRecorder) ListBidFills(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBid", reflect.TypeOf((*MockStore)(nil).CreateBid), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBidFills", reflect.TypeOf((*MockStore)(nil).ListBidFills), arg0, arg1)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+// ListBids mocks base method.
+func (m *MockStore) ListBids(arg0 context.Context, arg1 db.ListBidsParams) ([]db.Bid, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ListBids", arg0, arg1)
+	ret0, _ := ret[0].([]db.Bid)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+// ListBids indicates an expected call of ListBids.
+func (mr *MockStoreMockRecorder) ListBids(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBids", reflect.TypeOf((*MockStore)(nil).ListBids), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+// ListBidsByGroup mocks base method.
+func (m *MockStore) ListBidsByGroup(arg0 context.Context, arg1 sql.NullInt64) ([]db.Bid, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ListBidsByGroup", arg0, arg1)
+	ret0, _ := ret[0].([]db.Bid)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// ListBidsByGroup indicates an expected call of ListBidsByGroup.
+func (mr *MockStoreMockRecorder) ListBidsByGroup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBidsByGroup", reflect.TypeOf((*MockStore)(nil).ListBidsByGroup), arg0, arg1)
 }
 
-// CreateTrade mocks base method.
-func (m *MockStore) CreateTrade(arg0 context.Context, arg1 db.CreateTradeParams) (db.Trade, error) {
+// ListBidsByStatus mocks base method.
+func (m *MockStore) ListBidsByStatus(arg0 context.Context, arg1 string) ([]db.Bid, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTrade", arg0, arg1)
-	ret0, _ := ret[0].(db.Trade)
+	ret := m.ctrl.Call(m, "ListBidsByStatus", arg0, arg1)
+	ret0, _ := ret[0].([]db.Bid)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTrade indicates an expected call of CreateTrade.
-func (mr *MockStoreMockRecorder) CreateTrade(arg0, arg1 interface{}) *gomock.Call {
+// ListBidsByStatus indicates an expected call of ListBidsByStatus.
+func (mr *MockStoreMockRecorder) ListBidsByStatus(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrade", reflect.TypeOf((*MockStore)(nil).CreateTrade), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBidsByStatus", reflect.TypeOf((*MockStore)(nil).ListBidsByStatus), arg0, arg1)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+// ListCandles mocks base method.
+func (m *MockStore) ListCandles(arg0 context.Context, arg1 db.ListCandlesParams) ([]db.Candle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ListCandles", arg0, arg1)
+	ret0, _ := ret[0].([]db.Candle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+// ListCandles indicates an expected call of ListCandles.
+func (mr *MockStoreMockRecorder) ListCandles(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCandles", reflect.TypeOf((*MockStore)(nil).ListCandles), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+// ListCurrencies mocks base method.
+func (m *MockStore) ListCurrencies(arg0 context.Context) ([]db.Currency, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "ListCurrencies", arg0)
+	ret0, _ := ret[0].([]db.Currency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// ListCurrencies indicates an expected call of ListCurrencies.
+func (mr *MockStoreMockRecorder) ListCurrencies(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrencies", reflect.TypeOf((*MockStore)(nil).ListCurrencies), arg0)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+// ListDeadManSwitchEvents mocks base method.
+func (m *MockStore) ListDeadManSwitchEvents(arg0 context.Context, arg1 db.ListDeadManSwitchEventsParams) ([]db.DeadManSwitchEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListDeadManSwitchEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.DeadManSwitchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+// ListDeadManSwitchEvents indicates an expected call of ListDeadManSwitchEvents.
+func (mr *MockStoreMockRecorder) ListDeadManSwitchEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadManSwitchEvents", reflect.TypeOf((*MockStore)(nil).ListDeadManSwitchEvents), arg0, arg1)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(arg0 context.Context, arg1 string) error {
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ListExpiredAsks mocks base method.
+func (m *MockStore) ListExpiredAsks(arg0 context.Context, arg1 time.Time) ([]db.Ask, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ListExpiredAsks", arg0, arg1)
+	ret0, _ := ret[0].([]db.Ask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+// ListExpiredAsks indicates an expected call of ListExpiredAsks.
+func (mr *MockStoreMockRecorder) ListExpiredAsks(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredAsks", reflect.TypeOf((*MockStore)(nil).ListExpiredAsks), arg0, arg1)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ListExpiredBids mocks base method.
+func (m *MockStore) ListExpiredBids(arg0 context.Context, arg1 time.Time) ([]db.Bid, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ListExpiredBids", arg0, arg1)
+	ret0, _ := ret[0].([]db.Bid)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+// ListExpiredBids indicates an expected call of ListExpiredBids.
+func (mr *MockStoreMockRecorder) ListExpiredBids(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredBids", reflect.TypeOf((*MockStore)(nil).ListExpiredBids), arg0, arg1)
 }
 
-// GetAsk mocks base method.
-func (m *MockStore) GetAsk(arg0 context.Context, arg1 int64) (db.Ask, error) {
+// ListExpiredDeadManSwitches mocks base method.
+func (m *MockStore) ListExpiredDeadManSwitches(arg0 context.Context, arg1 time.Time) ([]db.DeadManSwitch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAsk", arg0, arg1)
-	ret0, _ := ret[0].(db.Ask)
+	ret := m.ctrl.Call(m, "ListExpiredDeadManSwitches", arg0, arg1)
+	ret0, _ := ret[0].([]db.DeadManSwitch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAsk indicates an expected call of GetAsk.
-func (mr *MockStoreMockRecorder) GetAsk(arg0, arg1 interface{}) *gomock.Call {
+// ListExpiredDeadManSwitches indicates an expected call of ListExpiredDeadManSwitches.
+func (mr *MockStoreMockRecorder) ListExpiredDeadManSwitches(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsk", reflect.TypeOf((*MockStore)(nil).GetAsk), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredDeadManSwitches", reflect.TypeOf((*MockStore)(nil).ListExpiredDeadManSwitches), arg0, arg1)
 }
 
-// GetBid mocks base method.
-func (m *MockStore) GetBid(arg0 context.Context, arg1 int64) (db.Bid, error) {
+// ListFeeTiers mocks base method.
+func (m *MockStore) ListFeeTiers(arg0 context.Context, arg1 string) ([]db.FeeTier, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBid", arg0, arg1)
-	ret0, _ := ret[0].(db.Bid)
+	ret := m.ctrl.Call(m, "ListFeeTiers", arg0, arg1)
+	ret0, _ := ret[0].([]db.FeeTier)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBid indicates an expected call of GetBid.
-func (mr *MockStoreMockRecorder) GetBid(arg0, arg1 interface{}) *gomock.Call {
+// ListFeeTiers indicates an expected call of ListFeeTiers.
+func (mr *MockStoreMockRecorder) ListFeeTiers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBid", reflect.TypeOf((*MockStore)(nil).GetBid), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeTiers", reflect.TypeOf((*MockStore)(nil).ListFeeTiers), arg0, arg1)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+// ListOpenAsksByOwner mocks base method.
+func (m *MockStore) ListOpenAsksByOwner(arg0 context.Context, arg1 db.ListOpenAsksByOwnerParams) ([]db.Ask, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ListOpenAsksByOwner", arg0, arg1)
+	ret0, _ := ret[0].([]db.Ask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+// ListOpenAsksByOwner indicates an expected call of ListOpenAsksByOwner.
+func (mr *MockStoreMockRecorder) ListOpenAsksByOwner(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenAsksByOwner", reflect.TypeOf((*MockStore)(nil).ListOpenAsksByOwner), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// ListOpenBidsByOwner mocks base method.
+func (m *MockStore) ListOpenBidsByOwner(arg0 context.Context, arg1 db.ListOpenBidsByOwnerParams) ([]db.Bid, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ListOpenBidsByOwner", arg0, arg1)
+	ret0, _ := ret[0].([]db.Bid)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// ListOpenBidsByOwner indicates an expected call of ListOpenBidsByOwner.
+func (mr *MockStoreMockRecorder) ListOpenBidsByOwner(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenBidsByOwner", reflect.TypeOf((*MockStore)(nil).ListOpenBidsByOwner), arg0, arg1)
 }
 
-// GetTrade mocks base method.
-func (m *MockStore) GetTrade(arg0 context.Context, arg1 int64) (db.Trade, error) {
+// ListOrderEvents mocks base method.
+func (m *MockStore) ListOrderEvents(arg0 context.Context, arg1 db.ListOrderEventsParams) ([]db.OrderEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrade", arg0, arg1)
-	ret0, _ := ret[0].(db.Trade)
+	ret := m.ctrl.Call(m, "ListOrderEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.OrderEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrade indicates an expected call of GetTrade.
-func (mr *MockStoreMockRecorder) GetTrade(arg0, arg1 interface{}) *gomock.Call {
+// ListOrderEvents indicates an expected call of ListOrderEvents.
+func (mr *MockStoreMockRecorder) ListOrderEvents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrade", reflect.TypeOf((*MockStore)(nil).GetTrade), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrderEvents", reflect.TypeOf((*MockStore)(nil).ListOrderEvents), arg0, arg1)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+// ListPairTrades mocks base method.
+func (m *MockStore) ListPairTrades(arg0 context.Context, arg1 db.ListPairTradesParams) ([]db.Trade, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ListPairTrades", arg0, arg1)
+	ret0, _ := ret[0].([]db.Trade)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+// ListPairTrades indicates an expected call of ListPairTrades.
+func (mr *MockStoreMockRecorder) ListPairTrades(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPairTrades", reflect.TypeOf((*MockStore)(nil).ListPairTrades), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+// ListPairs mocks base method.
+func (m *MockStore) ListPairs(arg0 context.Context) ([]db.Pair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "ListPairs", arg0)
+	ret0, _ := ret[0].([]db.Pair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// ListPairs indicates an expected call of ListPairs.
+func (mr *MockStoreMockRecorder) ListPairs(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPairs", reflect.TypeOf((*MockStore)(nil).ListPairs), arg0)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+// ListTrades mocks base method.
+func (m *MockStore) ListTrades(arg0 context.Context, arg1 db.ListTradesParams) ([]db.Trade, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "ListTrades", arg0, arg1)
+	ret0, _ := ret[0].([]db.Trade)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+// ListTrades indicates an expected call of ListTrades.
+func (mr *MockStoreMockRecorder) ListTrades(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrades", reflect.TypeOf((*MockStore)(nil).ListTrades), arg0, arg1)
 }
 
-// ListAsks mocks base method.
-func (m *MockStore) ListAsks(arg0 context.Context, arg1 db.ListAsksParams) ([]db.Ask, error) {
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAsks", arg0, arg1)
-	ret0, _ := ret[0].([]db.Ask)
+	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAsks indicates an expected call of ListAsks.
-func (mr *MockStoreMockRecorder) ListAsks(arg0, arg1 interface{}) *gomock.Call {
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsks", reflect.TypeOf((*MockStore)(nil).ListAsks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
 }
 
-// ListBids mocks base method.
-func (m *MockStore) ListBids(arg0 context.Context, arg1 db.ListBidsParams) ([]db.Bid, error) {
+// RefreshDeadManSwitch mocks base method.
+func (m *MockStore) RefreshDeadManSwitch(arg0 context.Context, arg1 db.RefreshDeadManSwitchParams) (db.DeadManSwitch, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBids", arg0, arg1)
-	ret0, _ := ret[0].([]db.Bid)
+	ret := m.ctrl.Call(m, "RefreshDeadManSwitch", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBids indicates an expected call of ListBids.
-func (mr *MockStoreMockRecorder) ListBids(arg0, arg1 interface{}) *gomock.Call {
+// RefreshDeadManSwitch indicates an expected call of RefreshDeadManSwitch.
+func (mr *MockStoreMockRecorder) RefreshDeadManSwitch(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBids", reflect.TypeOf((*MockStore)(nil).ListBids), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDeadManSwitch", reflect.TypeOf((*MockStore)(nil).RefreshDeadManSwitch), arg0, arg1)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
+// RefreshDeadManSwitchTx mocks base method.
+func (m *MockStore) RefreshDeadManSwitchTx(arg0 context.Context, arg1 db.RefreshDeadManSwitchTxParams) (db.DeadManSwitchTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "RefreshDeadManSwitchTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitchTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
+// RefreshDeadManSwitchTx indicates an expected call of RefreshDeadManSwitchTx.
+func (mr *MockStoreMockRecorder) RefreshDeadManSwitchTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDeadManSwitchTx", reflect.TypeOf((*MockStore)(nil).RefreshDeadManSwitchTx), arg0, arg1)
 }
 
-// ListTrades mocks base method.
-func (m *MockStore) ListTrades(arg0 context.Context, arg1 db.ListTradesParams) ([]db.Trade, error) {
+// ResizeAsk mocks base method.
+func (m *MockStore) ResizeAsk(arg0 context.Context, arg1 db.ResizeAskParams) (db.Ask, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTrades", arg0, arg1)
-	ret0, _ := ret[0].([]db.Trade)
+	ret := m.ctrl.Call(m, "ResizeAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTrades indicates an expected call of ListTrades.
-func (mr *MockStoreMockRecorder) ListTrades(arg0, arg1 interface{}) *gomock.Call {
+// ResizeAsk indicates an expected call of ResizeAsk.
+func (mr *MockStoreMockRecorder) ResizeAsk(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrades", reflect.TypeOf((*MockStore)(nil).ListTrades), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeAsk", reflect.TypeOf((*MockStore)(nil).ResizeAsk), arg0, arg1)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
+// SelfTradeTx mocks base method.
+func (m *MockStore) SelfTradeTx(arg0 context.Context, arg1 db.SelfTradeTxParams) (db.SelfTradeTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "SelfTradeTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SelfTradeTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
+// SelfTradeTx indicates an expected call of SelfTradeTx.
+func (mr *MockStoreMockRecorder) SelfTradeTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradeTx", reflect.TypeOf((*MockStore)(nil).SelfTradeTx), arg0, arg1)
 }
 
 // TradeTx mocks base method.
@@ -454,6 +1551,36 @@ func (mr *MockStoreMockRecorder) TransferTx(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), arg0, arg1)
 }
 
+// TriggerAsk mocks base method.
+func (m *MockStore) TriggerAsk(arg0 context.Context, arg1 int64) (db.Ask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerAsk", arg0, arg1)
+	ret0, _ := ret[0].(db.Ask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerAsk indicates an expected call of TriggerAsk.
+func (mr *MockStoreMockRecorder) TriggerAsk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerAsk", reflect.TypeOf((*MockStore)(nil).TriggerAsk), arg0, arg1)
+}
+
+// TriggerBid mocks base method.
+func (m *MockStore) TriggerBid(arg0 context.Context, arg1 int64) (db.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerBid", arg0, arg1)
+	ret0, _ := ret[0].(db.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerBid indicates an expected call of TriggerBid.
+func (mr *MockStoreMockRecorder) TriggerBid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerBid", reflect.TypeOf((*MockStore)(nil).TriggerBid), arg0, arg1)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(arg0 context.Context, arg1 db.UpdateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -499,6 +1626,36 @@ func (mr *MockStoreMockRecorder) UpdateBid(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBid", reflect.TypeOf((*MockStore)(nil).UpdateBid), arg0, arg1)
 }
 
+// UpdateCurrency mocks base method.
+func (m *MockStore) UpdateCurrency(arg0 context.Context, arg1 db.UpdateCurrencyParams) (db.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCurrency indicates an expected call of UpdateCurrency.
+func (mr *MockStoreMockRecorder) UpdateCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrency", reflect.TypeOf((*MockStore)(nil).UpdateCurrency), arg0, arg1)
+}
+
+// UpdatePair mocks base method.
+func (m *MockStore) UpdatePair(arg0 context.Context, arg1 db.UpdatePairParams) (db.Pair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePair", arg0, arg1)
+	ret0, _ := ret[0].(db.Pair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePair indicates an expected call of UpdatePair.
+func (mr *MockStoreMockRecorder) UpdatePair(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePair", reflect.TypeOf((*MockStore)(nil).UpdatePair), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -513,3 +1670,33 @@ func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
 }
+
+// UpsertCandle mocks base method.
+func (m *MockStore) UpsertCandle(arg0 context.Context, arg1 db.UpsertCandleParams) (db.Candle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCandle", arg0, arg1)
+	ret0, _ := ret[0].(db.Candle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCandle indicates an expected call of UpsertCandle.
+func (mr *MockStoreMockRecorder) UpsertCandle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCandle", reflect.TypeOf((*MockStore)(nil).UpsertCandle), arg0, arg1)
+}
+
+// UpsertDeadManSwitch mocks base method.
+func (m *MockStore) UpsertDeadManSwitch(arg0 context.Context, arg1 db.UpsertDeadManSwitchParams) (db.DeadManSwitch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDeadManSwitch", arg0, arg1)
+	ret0, _ := ret[0].(db.DeadManSwitch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDeadManSwitch indicates an expected call of UpsertDeadManSwitch.
+func (mr *MockStoreMockRecorder) UpsertDeadManSwitch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDeadManSwitch", reflect.TypeOf((*MockStore)(nil).UpsertDeadManSwitch), arg0, arg1)
+}
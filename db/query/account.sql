@@ -35,3 +35,9 @@ UPDATE accounts
   SET balance = balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
+
+-- name: AddAccountHeld :one
+UPDATE accounts
+  SET held = held + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -3,6 +3,12 @@ SELECT * FROM asks
 WHERE id = $1
 LIMIT 1;
 
+-- name: GetAskForUpdate :one
+SELECT * FROM asks
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
 -- name: ListAsks :many
 SELECT * FROM asks
 WHERE from_account_id = $1 OR to_account_id = $2
@@ -11,7 +17,9 @@ LIMIT $3
 OFFSET $4;
 
 -- name: CreateAsk :one
-INSERT INTO asks (pair, from_account_id, to_account_id, price, amount, status) VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO asks (pair, from_account_id, to_account_id, price, amount, status, remaining_amount, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, owner, self_trade_prevention) VALUES ($1, $2, $3, $4, $5, $6, $5, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+  (SELECT owner FROM accounts WHERE id = $2),
+  COALESCE(NULLIF($16::varchar, ''), (SELECT u.self_trade_prevention FROM users u JOIN accounts a ON a.owner = u.username WHERE a.id = $2)))
 RETURNING *;
 
 -- name: UpdateAsk :one
@@ -19,3 +27,80 @@ UPDATE asks
   SET status = $2
 WHERE id = $1
 RETURNING *;
+
+-- name: ListAsksByStatus :many
+SELECT * FROM asks
+WHERE status = $1
+ORDER BY id;
+
+-- name: FillAsk :one
+UPDATE asks
+  SET filled_amount = filled_amount + sqlc.arg(amount),
+    remaining_amount = remaining_amount - sqlc.arg(amount),
+    average_price = round(
+      (SELECT sum(price * amount) / sum(amount) FROM fills WHERE ask_id = sqlc.arg(id)),
+      (SELECT decimals FROM pairs JOIN currencies ON currencies.code = pairs.quote WHERE pairs.symbol = asks.pair)::int
+    ),
+    status = CASE WHEN remaining_amount = sqlc.arg(amount) THEN 'completed' ELSE 'partially_filled' END
+WHERE id = sqlc.arg(id) AND status IN ('active', 'partially_filled') AND remaining_amount >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: CloseAsk :one
+UPDATE asks
+  SET status = $2
+WHERE id = $1 AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+RETURNING *;
+
+-- name: ListExpiredAsks :many
+SELECT * FROM asks
+WHERE status IN ('inactive', 'pending', 'active', 'partially_filled') AND expires_at <= sqlc.arg(now)::timestamptz
+ORDER BY id;
+
+-- name: TriggerAsk :one
+UPDATE asks
+  SET status = 'active'
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: ListAsksByGroup :many
+SELECT * FROM asks
+WHERE group_id = $1
+ORDER BY id;
+
+-- name: ActivateAsk :one
+UPDATE asks
+  SET status = $2
+WHERE id = $1 AND status = 'inactive'
+RETURNING *;
+
+-- name: AmendAsk :one
+UPDATE asks
+  SET price = sqlc.arg(price),
+    amount = sqlc.arg(amount),
+    remaining_amount = sqlc.arg(amount) - filled_amount,
+    priority_at = CASE WHEN price <> sqlc.arg(price) OR amount < sqlc.arg(amount) THEN now() ELSE priority_at END
+WHERE id = sqlc.arg(id) AND status IN ('pending', 'active', 'partially_filled') AND group_id IS NULL AND filled_amount < sqlc.arg(amount)
+RETURNING *;
+
+-- name: ListOpenAsksByOwner :many
+SELECT * FROM asks
+WHERE (sqlc.narg(owner)::varchar IS NULL OR from_account_id IN (SELECT id FROM accounts WHERE owner = sqlc.narg(owner)))
+  AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+  AND (sqlc.narg(pair)::varchar IS NULL OR pair = sqlc.narg(pair))
+  AND (sqlc.narg(account_id)::bigint IS NULL OR from_account_id = sqlc.narg(account_id) OR to_account_id = sqlc.narg(account_id))
+ORDER BY id;
+
+-- name: DecrementAsk :one
+UPDATE asks
+  SET amount = amount - sqlc.arg(amount),
+    remaining_amount = remaining_amount - sqlc.arg(amount)
+WHERE id = sqlc.arg(id) AND status IN ('active', 'partially_filled') AND remaining_amount > sqlc.arg(amount)
+RETURNING *;
+
+-- name: ResizeAsk :one
+UPDATE asks
+  SET amount = sqlc.arg(amount),
+    remaining_amount = sqlc.arg(amount),
+    display_amount = LEAST(display_amount, sqlc.arg(amount))
+WHERE id = sqlc.arg(id) AND status = 'inactive'
+RETURNING *;
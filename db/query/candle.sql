@@ -0,0 +1,50 @@
+-- name: UpsertCandle :one
+INSERT INTO candles (pair, "interval", open_time, open, high, low, close, volume, quote_volume, trade_count)
+VALUES (sqlc.arg(pair), sqlc.arg(interval), sqlc.arg(open_time), sqlc.arg(price), sqlc.arg(price), sqlc.arg(price), sqlc.arg(price), sqlc.arg(volume), sqlc.arg(quote_volume), 1)
+ON CONFLICT (pair, "interval", open_time) DO UPDATE SET
+  high = GREATEST(candles.high, EXCLUDED.high),
+  low = LEAST(candles.low, EXCLUDED.low),
+  close = EXCLUDED.close,
+  volume = candles.volume + EXCLUDED.volume,
+  quote_volume = candles.quote_volume + EXCLUDED.quote_volume,
+  trade_count = candles.trade_count + 1,
+  updated_at = now()
+RETURNING *;
+
+-- name: ListCandles :many
+SELECT * FROM candles
+WHERE pair = sqlc.arg(pair)
+  AND "interval" = sqlc.arg(interval)
+  AND open_time >= sqlc.arg(from_time)
+  AND open_time < sqlc.arg(to_time)
+ORDER BY open_time
+LIMIT sqlc.arg(limit);
+
+-- name: BackfillCandles :execrows
+INSERT INTO candles (pair, "interval", open_time, open, high, low, close, volume, quote_volume, trade_count)
+SELECT
+  trades.pair,
+  sqlc.arg(interval)::varchar,
+  to_timestamp(floor(extract(epoch FROM trades.created_at) / sqlc.arg(seconds)::bigint) * sqlc.arg(seconds)::bigint),
+  (array_agg(trades.price ORDER BY trades.id))[1],
+  max(trades.price),
+  min(trades.price),
+  (array_agg(trades.price ORDER BY trades.id DESC))[1],
+  sum(trades.second_amount),
+  sum(trades.first_amount),
+  count(*)
+FROM trades
+WHERE trades.pair = sqlc.arg(pair)::varchar
+  AND trades.price IS NOT NULL
+  AND trades.created_at >= sqlc.arg(from_time)
+  AND trades.created_at < sqlc.arg(to_time)
+GROUP BY 1, 3
+ON CONFLICT (pair, "interval", open_time) DO UPDATE SET
+  open = EXCLUDED.open,
+  high = EXCLUDED.high,
+  low = EXCLUDED.low,
+  close = EXCLUDED.close,
+  volume = EXCLUDED.volume,
+  quote_volume = EXCLUDED.quote_volume,
+  trade_count = EXCLUDED.trade_count,
+  updated_at = now();
@@ -0,0 +1,55 @@
+-- name: UpsertDeadManSwitch :one
+INSERT INTO dead_man_switches (
+  username,
+  timeout_seconds,
+  expires_at
+) VALUES (
+  $1, $2, $3
+) ON CONFLICT (username) DO UPDATE
+SET timeout_seconds = EXCLUDED.timeout_seconds,
+    expires_at = EXCLUDED.expires_at,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetDeadManSwitch :one
+SELECT * FROM dead_man_switches
+WHERE username = $1 LIMIT 1;
+
+-- name: GetDeadManSwitchForUpdate :one
+SELECT * FROM dead_man_switches
+WHERE username = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: RefreshDeadManSwitch :one
+UPDATE dead_man_switches
+SET expires_at = $2,
+    updated_at = now()
+WHERE username = $1
+RETURNING *;
+
+-- name: DeleteDeadManSwitch :exec
+DELETE FROM dead_man_switches
+WHERE username = $1;
+
+-- name: ListExpiredDeadManSwitches :many
+SELECT * FROM dead_man_switches
+WHERE expires_at <= $1
+ORDER BY expires_at;
+
+-- name: CreateDeadManSwitchEvent :one
+INSERT INTO dead_man_switch_events (
+  username,
+  action,
+  timeout_seconds,
+  expires_at,
+  canceled_orders
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListDeadManSwitchEvents :many
+SELECT * FROM dead_man_switch_events
+WHERE username = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
@@ -0,0 +1,54 @@
+-- name: CreateFeeTier :one
+INSERT INTO fee_tiers (
+  pair,
+  min_volume,
+  maker_rate,
+  taker_rate
+) VALUES (
+  $1, $2, $3, $4
+)
+RETURNING *;
+
+-- name: ListFeeTiers :many
+SELECT * FROM fee_tiers
+WHERE pair = $1
+ORDER BY min_volume;
+
+-- name: GetFeeTier :one
+SELECT * FROM fee_tiers
+WHERE pair = sqlc.arg(pair) AND min_volume <= sqlc.arg(volume)::numeric
+ORDER BY min_volume DESC
+LIMIT 1;
+
+-- name: DeleteFeeTier :one
+DELETE FROM fee_tiers
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateFeeAccount :one
+INSERT INTO fee_accounts (currency, account_id) VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetFeeAccount :one
+SELECT * FROM fee_accounts
+WHERE currency = $1 LIMIT 1;
+
+-- name: GetTradedVolume :one
+SELECT COALESCE(SUM(owner_fills.volume), 0)::numeric AS volume
+FROM (
+  SELECT fills.price * fills.amount AS volume
+  FROM fills
+  JOIN bids ON bids.id = fills.bid_id
+  WHERE bids.pair = sqlc.arg(pair)
+    AND bids.owner = sqlc.arg(owner)
+    AND fills.created_at >= now() - interval '30 days'
+  UNION ALL
+  SELECT fills.price * fills.amount AS volume
+  FROM fills
+  JOIN asks ON asks.id = fills.ask_id
+  JOIN bids ON bids.id = fills.bid_id
+  WHERE asks.pair = sqlc.arg(pair)
+    AND asks.owner = sqlc.arg(owner)
+    AND bids.owner <> sqlc.arg(owner)
+    AND fills.created_at >= now() - interval '30 days'
+) AS owner_fills;
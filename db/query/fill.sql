@@ -0,0 +1,24 @@
+-- name: GetFill :one
+SELECT * FROM fills
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListBidFills :many
+SELECT * FROM fills
+WHERE bid_id = $1
+ORDER BY id;
+
+-- name: ListAskFills :many
+SELECT * FROM fills
+WHERE ask_id = $1
+ORDER BY id;
+
+-- name: CreateFill :one
+INSERT INTO fills (trade_id, bid_id, ask_id, price, amount) VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetBidFee :one
+SELECT COALESCE(SUM(trades.second_fee), 0)::numeric AS fee
+FROM fills
+JOIN trades ON trades.id = fills.trade_id
+WHERE fills.bid_id = $1;
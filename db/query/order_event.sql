@@ -0,0 +1,18 @@
+-- name: CreateOrderEvent :one
+INSERT INTO order_events (
+  side,
+  order_id,
+  type,
+  self_trade_prevention,
+  counter_order_id,
+  amount
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListOrderEvents :many
+SELECT * FROM order_events
+WHERE side = $1 AND order_id = $2
+ORDER BY id
+LIMIT $3
+OFFSET $4;
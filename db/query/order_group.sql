@@ -0,0 +1,8 @@
+-- name: GetOrderGroup :one
+SELECT * FROM order_groups
+WHERE id = $1
+LIMIT 1;
+
+-- name: CreateOrderGroup :one
+INSERT INTO order_groups (type) VALUES ($1)
+RETURNING *;
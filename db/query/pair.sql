@@ -0,0 +1,32 @@
+-- name: CreatePair :one
+INSERT INTO pairs (
+  symbol,
+  base,
+  quote,
+  tick_size,
+  lot_size,
+  min_notional
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetPair :one
+SELECT * FROM pairs
+WHERE symbol = $1 LIMIT 1;
+
+-- name: ListPairs :many
+SELECT * FROM pairs
+ORDER BY symbol;
+
+-- name: UpdatePair :one
+UPDATE pairs
+SET
+  tick_size = COALESCE(sqlc.narg(tick_size), tick_size),
+  lot_size = COALESCE(sqlc.narg(lot_size), lot_size),
+  min_notional = COALESCE(sqlc.narg(min_notional), min_notional),
+  status = COALESCE(sqlc.narg(status), status),
+  updated_at = now()
+WHERE
+  symbol = sqlc.arg(symbol)
+RETURNING *;
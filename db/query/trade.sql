@@ -11,6 +11,12 @@ LIMIT $5
 OFFSET $6;
 
 -- name: CreateTrade :one
-INSERT INTO trades (first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount) 
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO trades (first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
+
+-- name: ListPairTrades :many
+SELECT * FROM trades
+WHERE pair = $1::varchar AND price IS NOT NULL
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
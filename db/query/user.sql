@@ -16,7 +16,8 @@ SET
   hashed_password = COALESCE(sqlc.narg(hashed_password), hashed_password),
   password_changed_at = COALESCE(sqlc.narg(password_changed_at), password_changed_at),
   full_name = COALESCE(sqlc.narg(full_name), full_name),
-  email = COALESCE(sqlc.narg(email), email)
+  email = COALESCE(sqlc.narg(email), email),
+  self_trade_prevention = COALESCE(sqlc.narg(self_trade_prevention), self_trade_prevention)
 WHERE
   username = sqlc.arg(username)
 RETURNING *;
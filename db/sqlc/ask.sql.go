@@ -7,20 +7,169 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"go-exchange/decimal"
 )
 
+const activateAsk = `-- name: ActivateAsk :one
+UPDATE asks
+  SET status = $2
+WHERE id = $1 AND status = 'inactive'
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type ActivateAskParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) ActivateAsk(ctx context.Context, arg ActivateAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, activateAsk, arg.ID, arg.Status)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const amendAsk = `-- name: AmendAsk :one
+UPDATE asks
+  SET price = $1,
+    amount = $2,
+    remaining_amount = $2 - filled_amount,
+    priority_at = CASE WHEN price <> $1 OR amount < $2 THEN now() ELSE priority_at END
+WHERE id = $3 AND status IN ('pending', 'active', 'partially_filled') AND group_id IS NULL AND filled_amount < $2
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type AmendAskParams struct {
+	Price  decimal.Decimal `json:"price"`
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) AmendAsk(ctx context.Context, arg AmendAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, amendAsk, arg.Price, arg.Amount, arg.ID)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const closeAsk = `-- name: CloseAsk :one
+UPDATE asks
+  SET status = $2
+WHERE id = $1 AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type CloseAskParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) CloseAsk(ctx context.Context, arg CloseAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, closeAsk, arg.ID, arg.Status)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
 const createAsk = `-- name: CreateAsk :one
-INSERT INTO asks (pair, from_account_id, to_account_id, price, amount, status) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at
+INSERT INTO asks (pair, from_account_id, to_account_id, price, amount, status, remaining_amount, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, owner, self_trade_prevention) VALUES ($1, $2, $3, $4, $5, $6, $5, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+  (SELECT owner FROM accounts WHERE id = $2),
+  COALESCE(NULLIF($16::varchar, ''), (SELECT u.self_trade_prevention FROM users u JOIN accounts a ON a.owner = u.username WHERE a.id = $2)))
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
 `
 
 type CreateAskParams struct {
-	Pair          string `json:"pair"`
-	FromAccountID int64  `json:"from_account_id"`
-	ToAccountID   int64  `json:"to_account_id"`
-	Price         int64  `json:"price"`
-	Amount        int64  `json:"amount"`
-	Status        string `json:"status"`
+	Pair                string          `json:"pair"`
+	FromAccountID       int64           `json:"from_account_id"`
+	ToAccountID         int64           `json:"to_account_id"`
+	Price               decimal.Decimal `json:"price"`
+	Amount              decimal.Decimal `json:"amount"`
+	Status              string          `json:"status"`
+	Type                string          `json:"type"`
+	TimeInForce         string          `json:"time_in_force"`
+	ExpiresAt           sql.NullTime    `json:"expires_at"`
+	StopPrice           decimal.Decimal `json:"stop_price"`
+	PostOnly            bool            `json:"post_only"`
+	DisplayAmount       decimal.Decimal `json:"display_amount"`
+	Hidden              bool            `json:"hidden"`
+	GroupID             sql.NullInt64   `json:"group_id"`
+	GroupLeg            string          `json:"group_leg"`
+	SelfTradePrevention string          `json:"self_trade_prevention"`
 }
 
 func (q *Queries) CreateAsk(ctx context.Context, arg CreateAskParams) (Ask, error) {
@@ -31,6 +180,16 @@ func (q *Queries) CreateAsk(ctx context.Context, arg CreateAskParams) (Ask, erro
 		arg.Price,
 		arg.Amount,
 		arg.Status,
+		arg.Type,
+		arg.TimeInForce,
+		arg.ExpiresAt,
+		arg.StopPrice,
+		arg.PostOnly,
+		arg.DisplayAmount,
+		arg.Hidden,
+		arg.GroupID,
+		arg.GroupLeg,
+		arg.SelfTradePrevention,
 	)
 	var i Ask
 	err := row.Scan(
@@ -42,12 +201,120 @@ func (q *Queries) CreateAsk(ctx context.Context, arg CreateAskParams) (Ask, erro
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const decrementAsk = `-- name: DecrementAsk :one
+UPDATE asks
+  SET amount = amount - $1,
+    remaining_amount = remaining_amount - $1
+WHERE id = $2 AND status IN ('active', 'partially_filled') AND remaining_amount > $1
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type DecrementAskParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) DecrementAsk(ctx context.Context, arg DecrementAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, decrementAsk, arg.Amount, arg.ID)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const fillAsk = `-- name: FillAsk :one
+UPDATE asks
+  SET filled_amount = filled_amount + $1,
+    remaining_amount = remaining_amount - $1,
+    average_price = round(
+      (SELECT sum(price * amount) / sum(amount) FROM fills WHERE ask_id = $2),
+      (SELECT decimals FROM pairs JOIN currencies ON currencies.code = pairs.quote WHERE pairs.symbol = asks.pair)::int
+    ),
+    status = CASE WHEN remaining_amount = $1 THEN 'completed' ELSE 'partially_filled' END
+WHERE id = $2 AND status IN ('active', 'partially_filled') AND remaining_amount >= $1
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type FillAskParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) FillAsk(ctx context.Context, arg FillAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, fillAsk, arg.Amount, arg.ID)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
 
 const getAsk = `-- name: GetAsk :one
-SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at FROM asks
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
 WHERE id = $1
 LIMIT 1
 `
@@ -64,12 +331,65 @@ func (q *Queries) GetAsk(ctx context.Context, id int64) (Ask, error) {
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const getAskForUpdate = `-- name: GetAskForUpdate :one
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetAskForUpdate(ctx context.Context, id int64) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, getAskForUpdate, id)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
 
 const listAsks = `-- name: ListAsks :many
-SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at FROM asks
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
 WHERE from_account_id = $1 OR to_account_id = $2
 ORDER BY id
 LIMIT $3
@@ -106,6 +426,74 @@ func (q *Queries) ListAsks(ctx context.Context, arg ListAsksParams) ([]Ask, erro
 			&i.Amount,
 			&i.Status,
 			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAsksByGroup = `-- name: ListAsksByGroup :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
+WHERE group_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAsksByGroup(ctx context.Context, groupID sql.NullInt64) ([]Ask, error) {
+	rows, err := q.db.QueryContext(ctx, listAsksByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ask{}
+	for rows.Next() {
+		var i Ask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
 		); err != nil {
 			return nil, err
 		}
@@ -120,11 +508,262 @@ func (q *Queries) ListAsks(ctx context.Context, arg ListAsksParams) ([]Ask, erro
 	return items, nil
 }
 
+const listAsksByStatus = `-- name: ListAsksByStatus :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
+WHERE status = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAsksByStatus(ctx context.Context, status string) ([]Ask, error) {
+	rows, err := q.db.QueryContext(ctx, listAsksByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ask{}
+	for rows.Next() {
+		var i Ask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredAsks = `-- name: ListExpiredAsks :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
+WHERE status IN ('inactive', 'pending', 'active', 'partially_filled') AND expires_at <= $1::timestamptz
+ORDER BY id
+`
+
+func (q *Queries) ListExpiredAsks(ctx context.Context, now time.Time) ([]Ask, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredAsks, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ask{}
+	for rows.Next() {
+		var i Ask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenAsksByOwner = `-- name: ListOpenAsksByOwner :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM asks
+WHERE ($1::varchar IS NULL OR from_account_id IN (SELECT id FROM accounts WHERE owner = $1))
+  AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+  AND ($2::varchar IS NULL OR pair = $2)
+  AND ($3::bigint IS NULL OR from_account_id = $3 OR to_account_id = $3)
+ORDER BY id
+`
+
+type ListOpenAsksByOwnerParams struct {
+	Owner     sql.NullString `json:"owner"`
+	Pair      sql.NullString `json:"pair"`
+	AccountID sql.NullInt64  `json:"account_id"`
+}
+
+func (q *Queries) ListOpenAsksByOwner(ctx context.Context, arg ListOpenAsksByOwnerParams) ([]Ask, error) {
+	rows, err := q.db.QueryContext(ctx, listOpenAsksByOwner, arg.Owner, arg.Pair, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ask{}
+	for rows.Next() {
+		var i Ask
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resizeAsk = `-- name: ResizeAsk :one
+UPDATE asks
+  SET amount = $1,
+    remaining_amount = $1,
+    display_amount = LEAST(display_amount, $1)
+WHERE id = $2 AND status = 'inactive'
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type ResizeAskParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) ResizeAsk(ctx context.Context, arg ResizeAskParams) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, resizeAsk, arg.Amount, arg.ID)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const triggerAsk = `-- name: TriggerAsk :one
+UPDATE asks
+  SET status = 'active'
+WHERE id = $1 AND status = 'pending'
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+func (q *Queries) TriggerAsk(ctx context.Context, id int64) (Ask, error) {
+	row := q.db.QueryRowContext(ctx, triggerAsk, id)
+	var i Ask
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
 const updateAsk = `-- name: UpdateAsk :one
 UPDATE asks
   SET status = $2
 WHERE id = $1
-RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
 `
 
 type UpdateAskParams struct {
@@ -144,6 +783,21 @@ func (q *Queries) UpdateAsk(ctx context.Context, arg UpdateAskParams) (Ask, erro
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"go-exchange/util"
 	"testing"
 	"time"
@@ -9,7 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func createRandomAsk(t *testing.T) Ask {
+func createRandomAsk(t *testing.T, status_optional ...string) Ask {
+	status := util.RandomStatus()
+	if len(status_optional) > 0 {
+		status = status_optional[0]
+	}
+
 	pair := util.RandomPair()
 	c1, c2 := util.CurrenciesFromPair(pair)
 	account1 := createRandomAccount(t, c1)
@@ -21,7 +27,9 @@ func createRandomAsk(t *testing.T) Ask {
 		ToAccountID:   account2.ID,
 		Price:         util.RandomMoney(),
 		Amount:        util.RandomMoney(),
-		Status:        util.RandomStatus(),
+		Status:        status,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTC,
 	}
 
 	ask, err := testQueries.CreateAsk(context.Background(), arg)
@@ -34,6 +42,10 @@ func createRandomAsk(t *testing.T) Ask {
 	require.Equal(t, arg.Price, ask.Price)
 	require.Equal(t, arg.Amount, ask.Amount)
 	require.Equal(t, arg.Status, ask.Status)
+	require.Equal(t, arg.TimeInForce, ask.TimeInForce)
+	require.False(t, ask.ExpiresAt.Valid)
+	require.Equal(t, arg.Amount, ask.RemainingAmount)
+	require.Zero(t, ask.FilledAmount)
 
 	require.NotZero(t, ask.ID)
 	require.NotZero(t, ask.CreatedAt)
@@ -83,3 +95,82 @@ func TestListAsks(t *testing.T) {
 		require.Contains(t, []int64{arg.FromAccountID, arg.ToAccountID}, ask.FromAccountID)
 	}
 }
+
+func TestListAsksByStatus(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		createRandomAsk(t, util.ACTIVE)
+	}
+
+	asks, err := testQueries.ListAsksByStatus(context.Background(), util.ACTIVE)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(asks), 5)
+
+	for i, ask := range asks {
+		require.NotEmpty(t, ask)
+		require.Equal(t, util.ACTIVE, ask.Status)
+		if i > 0 {
+			require.Greater(t, ask.ID, asks[i-1].ID)
+		}
+	}
+}
+
+func TestCloseAsk(t *testing.T) {
+	ask1 := createRandomAsk(t, util.ACTIVE)
+
+	ask2, err := testQueries.CloseAsk(context.Background(), CloseAskParams{
+		ID:     ask1.ID,
+		Status: util.CANCELED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CANCELED, ask2.Status)
+
+	// a closed ask can't be closed again
+	_, err = testQueries.CloseAsk(context.Background(), CloseAskParams{
+		ID:     ask1.ID,
+		Status: util.EXPIRED,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListExpiredAsks(t *testing.T) {
+	now := time.Now()
+
+	expired := createRandomAsk(t, util.ACTIVE)
+	pending := createRandomAsk(t, util.ACTIVE)
+	closed := createRandomAsk(t, util.CANCELED)
+
+	for _, ask := range []Ask{expired, pending, closed} {
+		expiresAt := now.Add(-time.Minute)
+		if ask.ID == pending.ID {
+			expiresAt = now.Add(time.Minute)
+		}
+		_, err := testDB.ExecContext(context.Background(), "UPDATE asks SET time_in_force = $2, expires_at = $3 WHERE id = $1", ask.ID, util.GTD, expiresAt)
+		require.NoError(t, err)
+	}
+
+	asks, err := testQueries.ListExpiredAsks(context.Background(), now)
+	require.NoError(t, err)
+
+	ids := map[int64]bool{}
+	for _, ask := range asks {
+		require.True(t, util.IsOpenStatus(ask.Status))
+		require.False(t, ask.ExpiresAt.Time.After(now))
+		ids[ask.ID] = true
+	}
+	require.True(t, ids[expired.ID])
+	require.False(t, ids[pending.ID])
+	require.False(t, ids[closed.ID])
+}
+
+func TestTriggerAsk(t *testing.T) {
+	ask1 := createRandomAsk(t, util.PENDING)
+
+	ask2, err := testQueries.TriggerAsk(context.Background(), ask1.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ACTIVE, ask2.Status)
+	require.Equal(t, ask1.RemainingAmount, ask2.RemainingAmount)
+
+	// only pending asks can be triggered
+	_, err = testQueries.TriggerAsk(context.Background(), ask1.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -7,20 +7,169 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"go-exchange/decimal"
 )
 
+const activateBid = `-- name: ActivateBid :one
+UPDATE bids
+  SET status = $2
+WHERE id = $1 AND status = 'inactive'
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type ActivateBidParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) ActivateBid(ctx context.Context, arg ActivateBidParams) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, activateBid, arg.ID, arg.Status)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const amendBid = `-- name: AmendBid :one
+UPDATE bids
+  SET price = $1,
+    amount = $2,
+    remaining_amount = $2 - filled_amount,
+    priority_at = CASE WHEN price <> $1 OR amount < $2 THEN now() ELSE priority_at END
+WHERE id = $3 AND status IN ('pending', 'active', 'partially_filled') AND group_id IS NULL AND filled_amount < $2
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type AmendBidParams struct {
+	Price  decimal.Decimal `json:"price"`
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) AmendBid(ctx context.Context, arg AmendBidParams) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, amendBid, arg.Price, arg.Amount, arg.ID)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const closeBid = `-- name: CloseBid :one
+UPDATE bids
+  SET status = $2
+WHERE id = $1 AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type CloseBidParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) CloseBid(ctx context.Context, arg CloseBidParams) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, closeBid, arg.ID, arg.Status)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
 const createBid = `-- name: CreateBid :one
-INSERT INTO bids (pair, from_account_id, to_account_id, price, amount, status) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at
+INSERT INTO bids (pair, from_account_id, to_account_id, price, amount, status, remaining_amount, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, owner, self_trade_prevention) VALUES ($1, $2, $3, $4, $5, $6, $5, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+  (SELECT owner FROM accounts WHERE id = $2),
+  COALESCE(NULLIF($16::varchar, ''), (SELECT u.self_trade_prevention FROM users u JOIN accounts a ON a.owner = u.username WHERE a.id = $2)))
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
 `
 
 type CreateBidParams struct {
-	Pair          string `json:"pair"`
-	FromAccountID int64  `json:"from_account_id"`
-	ToAccountID   int64  `json:"to_account_id"`
-	Price         int64  `json:"price"`
-	Amount        int64  `json:"amount"`
-	Status        string `json:"status"`
+	Pair                string          `json:"pair"`
+	FromAccountID       int64           `json:"from_account_id"`
+	ToAccountID         int64           `json:"to_account_id"`
+	Price               decimal.Decimal `json:"price"`
+	Amount              decimal.Decimal `json:"amount"`
+	Status              string          `json:"status"`
+	Type                string          `json:"type"`
+	TimeInForce         string          `json:"time_in_force"`
+	ExpiresAt           sql.NullTime    `json:"expires_at"`
+	StopPrice           decimal.Decimal `json:"stop_price"`
+	PostOnly            bool            `json:"post_only"`
+	DisplayAmount       decimal.Decimal `json:"display_amount"`
+	Hidden              bool            `json:"hidden"`
+	GroupID             sql.NullInt64   `json:"group_id"`
+	GroupLeg            string          `json:"group_leg"`
+	SelfTradePrevention string          `json:"self_trade_prevention"`
 }
 
 func (q *Queries) CreateBid(ctx context.Context, arg CreateBidParams) (Bid, error) {
@@ -31,7 +180,110 @@ func (q *Queries) CreateBid(ctx context.Context, arg CreateBidParams) (Bid, erro
 		arg.Price,
 		arg.Amount,
 		arg.Status,
+		arg.Type,
+		arg.TimeInForce,
+		arg.ExpiresAt,
+		arg.StopPrice,
+		arg.PostOnly,
+		arg.DisplayAmount,
+		arg.Hidden,
+		arg.GroupID,
+		arg.GroupLeg,
+		arg.SelfTradePrevention,
+	)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const decrementBid = `-- name: DecrementBid :one
+UPDATE bids
+  SET amount = amount - $1,
+    remaining_amount = remaining_amount - $1
+WHERE id = $2 AND status IN ('active', 'partially_filled') AND remaining_amount > $1
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type DecrementBidParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) DecrementBid(ctx context.Context, arg DecrementBidParams) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, decrementBid, arg.Amount, arg.ID)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
+	return i, err
+}
+
+const fillBid = `-- name: FillBid :one
+UPDATE bids
+  SET filled_amount = filled_amount + $1,
+    remaining_amount = remaining_amount - $1,
+    average_price = round(
+      (SELECT sum(price * amount) / sum(amount) FROM fills WHERE bid_id = $2),
+      (SELECT decimals FROM pairs JOIN currencies ON currencies.code = pairs.quote WHERE pairs.symbol = bids.pair)::int
+    ),
+    status = CASE WHEN remaining_amount = $1 THEN 'completed' ELSE 'partially_filled' END
+WHERE id = $2 AND status IN ('active', 'partially_filled') AND remaining_amount >= $1
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+type FillBidParams struct {
+	Amount decimal.Decimal `json:"amount"`
+	ID     int64           `json:"id"`
+}
+
+func (q *Queries) FillBid(ctx context.Context, arg FillBidParams) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, fillBid, arg.Amount, arg.ID)
 	var i Bid
 	err := row.Scan(
 		&i.ID,
@@ -42,12 +294,27 @@ func (q *Queries) CreateBid(ctx context.Context, arg CreateBidParams) (Bid, erro
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
 
 const getBid = `-- name: GetBid :one
-SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at FROM bids
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
 WHERE id = $1
 LIMIT 1
 `
@@ -64,12 +331,65 @@ func (q *Queries) GetBid(ctx context.Context, id int64) (Bid, error) {
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
+const getBidForUpdate = `-- name: GetBidForUpdate :one
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetBidForUpdate(ctx context.Context, id int64) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, getBidForUpdate, id)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
 
 const listBids = `-- name: ListBids :many
-SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at FROM bids
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
 WHERE from_account_id = $1 OR to_account_id = $2
 ORDER BY id
 LIMIT $3
@@ -106,6 +426,21 @@ func (q *Queries) ListBids(ctx context.Context, arg ListBidsParams) ([]Bid, erro
 			&i.Amount,
 			&i.Status,
 			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
 		); err != nil {
 			return nil, err
 		}
@@ -120,11 +455,270 @@ func (q *Queries) ListBids(ctx context.Context, arg ListBidsParams) ([]Bid, erro
 	return items, nil
 }
 
+const listBidsByGroup = `-- name: ListBidsByGroup :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
+WHERE group_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListBidsByGroup(ctx context.Context, groupID sql.NullInt64) ([]Bid, error) {
+	rows, err := q.db.QueryContext(ctx, listBidsByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Bid{}
+	for rows.Next() {
+		var i Bid
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBidsByStatus = `-- name: ListBidsByStatus :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
+WHERE status = $1
+ORDER BY id
+`
+
+func (q *Queries) ListBidsByStatus(ctx context.Context, status string) ([]Bid, error) {
+	rows, err := q.db.QueryContext(ctx, listBidsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Bid{}
+	for rows.Next() {
+		var i Bid
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredBids = `-- name: ListExpiredBids :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
+WHERE status IN ('inactive', 'pending', 'active', 'partially_filled') AND expires_at <= $1::timestamptz
+ORDER BY id
+`
+
+func (q *Queries) ListExpiredBids(ctx context.Context, now time.Time) ([]Bid, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredBids, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Bid{}
+	for rows.Next() {
+		var i Bid
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenBidsByOwner = `-- name: ListOpenBidsByOwner :many
+SELECT id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention FROM bids
+WHERE ($1::varchar IS NULL OR from_account_id IN (SELECT id FROM accounts WHERE owner = $1))
+  AND status IN ('inactive', 'pending', 'active', 'partially_filled')
+  AND ($2::varchar IS NULL OR pair = $2)
+  AND ($3::bigint IS NULL OR from_account_id = $3 OR to_account_id = $3)
+ORDER BY id
+`
+
+type ListOpenBidsByOwnerParams struct {
+	Owner     sql.NullString `json:"owner"`
+	Pair      sql.NullString `json:"pair"`
+	AccountID sql.NullInt64  `json:"account_id"`
+}
+
+func (q *Queries) ListOpenBidsByOwner(ctx context.Context, arg ListOpenBidsByOwnerParams) ([]Bid, error) {
+	rows, err := q.db.QueryContext(ctx, listOpenBidsByOwner, arg.Owner, arg.Pair, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Bid{}
+	for rows.Next() {
+		var i Bid
+		if err := rows.Scan(
+			&i.ID,
+			&i.Pair,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Price,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FilledAmount,
+			&i.RemainingAmount,
+			&i.AveragePrice,
+			&i.Type,
+			&i.TimeInForce,
+			&i.ExpiresAt,
+			&i.StopPrice,
+			&i.PostOnly,
+			&i.DisplayAmount,
+			&i.Hidden,
+			&i.GroupID,
+			&i.GroupLeg,
+			&i.PriorityAt,
+			&i.Owner,
+			&i.SelfTradePrevention,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const triggerBid = `-- name: TriggerBid :one
+UPDATE bids
+  SET status = 'active'
+WHERE id = $1 AND status = 'pending'
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
+`
+
+func (q *Queries) TriggerBid(ctx context.Context, id int64) (Bid, error) {
+	row := q.db.QueryRowContext(ctx, triggerBid, id)
+	var i Bid
+	err := row.Scan(
+		&i.ID,
+		&i.Pair,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Price,
+		&i.Amount,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
+	)
+	return i, err
+}
+
 const updateBid = `-- name: UpdateBid :one
 UPDATE bids
   SET status = $2
 WHERE id = $1
-RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at
+RETURNING id, pair, from_account_id, to_account_id, price, amount, status, created_at, filled_amount, remaining_amount, average_price, type, time_in_force, expires_at, stop_price, post_only, display_amount, hidden, group_id, group_leg, priority_at, owner, self_trade_prevention
 `
 
 type UpdateBidParams struct {
@@ -144,6 +738,21 @@ func (q *Queries) UpdateBid(ctx context.Context, arg UpdateBidParams) (Bid, erro
 		&i.Amount,
 		&i.Status,
 		&i.CreatedAt,
+		&i.FilledAmount,
+		&i.RemainingAmount,
+		&i.AveragePrice,
+		&i.Type,
+		&i.TimeInForce,
+		&i.ExpiresAt,
+		&i.StopPrice,
+		&i.PostOnly,
+		&i.DisplayAmount,
+		&i.Hidden,
+		&i.GroupID,
+		&i.GroupLeg,
+		&i.PriorityAt,
+		&i.Owner,
+		&i.SelfTradePrevention,
 	)
 	return i, err
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"go-exchange/util"
 	"testing"
 	"time"
@@ -9,7 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func createRandomBid(t *testing.T) Bid {
+func createRandomBid(t *testing.T, status_optional ...string) Bid {
+	status := util.RandomStatus()
+	if len(status_optional) > 0 {
+		status = status_optional[0]
+	}
+
 	pair := util.RandomPair()
 	c1, c2 := util.CurrenciesFromPair(pair)
 	account1 := createRandomAccount(t, c1)
@@ -21,7 +27,9 @@ func createRandomBid(t *testing.T) Bid {
 		ToAccountID:   account2.ID,
 		Price:         util.RandomMoney(),
 		Amount:        util.RandomMoney(),
-		Status:        util.RandomStatus(),
+		Status:        status,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTC,
 	}
 
 	bid, err := testQueries.CreateBid(context.Background(), arg)
@@ -34,6 +42,10 @@ func createRandomBid(t *testing.T) Bid {
 	require.Equal(t, arg.Price, bid.Price)
 	require.Equal(t, arg.Amount, bid.Amount)
 	require.Equal(t, arg.Status, bid.Status)
+	require.Equal(t, arg.TimeInForce, bid.TimeInForce)
+	require.False(t, bid.ExpiresAt.Valid)
+	require.Equal(t, arg.Amount, bid.RemainingAmount)
+	require.Zero(t, bid.FilledAmount)
 
 	require.NotZero(t, bid.ID)
 	require.NotZero(t, bid.CreatedAt)
@@ -83,3 +95,82 @@ func TestListBids(t *testing.T) {
 		require.Contains(t, []int64{bid.FromAccountID,bid.ToAccountID}, arg.FromAccountID)
 	}
 }
+
+func TestListBidsByStatus(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		createRandomBid(t, util.ACTIVE)
+	}
+
+	bids, err := testQueries.ListBidsByStatus(context.Background(), util.ACTIVE)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(bids), 5)
+
+	for i, bid := range bids {
+		require.NotEmpty(t, bid)
+		require.Equal(t, util.ACTIVE, bid.Status)
+		if i > 0 {
+			require.Greater(t, bid.ID, bids[i-1].ID)
+		}
+	}
+}
+
+func TestCloseBid(t *testing.T) {
+	bid1 := createRandomBid(t, util.ACTIVE)
+
+	bid2, err := testQueries.CloseBid(context.Background(), CloseBidParams{
+		ID:     bid1.ID,
+		Status: util.CANCELED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CANCELED, bid2.Status)
+
+	// a closed bid can't be closed again
+	_, err = testQueries.CloseBid(context.Background(), CloseBidParams{
+		ID:     bid1.ID,
+		Status: util.EXPIRED,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListExpiredBids(t *testing.T) {
+	now := time.Now()
+
+	expired := createRandomBid(t, util.ACTIVE)
+	pending := createRandomBid(t, util.ACTIVE)
+	closed := createRandomBid(t, util.CANCELED)
+
+	for _, bid := range []Bid{expired, pending, closed} {
+		expiresAt := now.Add(-time.Minute)
+		if bid.ID == pending.ID {
+			expiresAt = now.Add(time.Minute)
+		}
+		_, err := testDB.ExecContext(context.Background(), "UPDATE bids SET time_in_force = $2, expires_at = $3 WHERE id = $1", bid.ID, util.GTD, expiresAt)
+		require.NoError(t, err)
+	}
+
+	bids, err := testQueries.ListExpiredBids(context.Background(), now)
+	require.NoError(t, err)
+
+	ids := map[int64]bool{}
+	for _, bid := range bids {
+		require.True(t, util.IsOpenStatus(bid.Status))
+		require.False(t, bid.ExpiresAt.Time.After(now))
+		ids[bid.ID] = true
+	}
+	require.True(t, ids[expired.ID])
+	require.False(t, ids[pending.ID])
+	require.False(t, ids[closed.ID])
+}
+
+func TestTriggerBid(t *testing.T) {
+	bid1 := createRandomBid(t, util.PENDING)
+
+	bid2, err := testQueries.TriggerBid(context.Background(), bid1.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ACTIVE, bid2.Status)
+	require.Equal(t, bid1.RemainingAmount, bid2.RemainingAmount)
+
+	// only pending bids can be triggered
+	_, err = testQueries.TriggerBid(context.Background(), bid1.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
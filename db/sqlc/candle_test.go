@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createCandlePair lists a new pair no other test trades on, so its candles only hold what the test adds
+func createCandlePair(t *testing.T) string {
+	currency, err := testQueries.CreateCurrency(context.Background(), CreateCurrencyParams{
+		Code:     strings.ToUpper(util.RandomString(6)),
+		Decimals: 8,
+	})
+	require.NoError(t, err)
+
+	pair, err := testQueries.CreatePair(context.Background(), CreatePairParams{
+		Symbol:   currency.Code + "/" + util.USDT,
+		Base:     currency.Code,
+		Quote:    util.USDT,
+		TickSize: decimal.NewFromInt(1),
+		LotSize:  decimal.NewFromInt(1),
+	})
+	require.NoError(t, err)
+	return pair.Symbol
+}
+
+func TestUpsertCandle(t *testing.T) {
+	pair := createCandlePair(t)
+	openTime := time.Now().UTC().Truncate(time.Hour)
+
+	prices := []int64{10, 14, 8, 12}
+	var candle Candle
+	var err error
+	for _, price := range prices {
+		candle, err = testQueries.UpsertCandle(context.Background(), UpsertCandleParams{
+			Pair:        pair,
+			Interval:    util.ONE_HOUR,
+			OpenTime:    openTime,
+			Price:       decimal.NewFromInt(price),
+			Volume:      decimal.NewFromInt(2),
+			QuoteVolume: decimal.NewFromInt(2 * price),
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, pair, candle.Pair)
+	require.Equal(t, util.ONE_HOUR, candle.Interval)
+	require.WithinDuration(t, openTime, candle.OpenTime, time.Second)
+	require.Equal(t, decimal.NewFromInt(10), candle.Open)
+	require.Equal(t, decimal.NewFromInt(14), candle.High)
+	require.Equal(t, decimal.NewFromInt(8), candle.Low)
+	require.Equal(t, decimal.NewFromInt(12), candle.Close)
+	require.Equal(t, decimal.NewFromInt(8), candle.Volume)
+	require.Equal(t, decimal.NewFromInt(88), candle.QuoteVolume)
+	require.Equal(t, int64(len(prices)), candle.TradeCount)
+}
+
+func TestListCandles(t *testing.T) {
+	pair := createCandlePair(t)
+	start := time.Now().UTC().Truncate(time.Minute).Add(-10 * time.Minute)
+
+	for i := 0; i < 10; i++ {
+		_, err := testQueries.UpsertCandle(context.Background(), UpsertCandleParams{
+			Pair:        pair,
+			Interval:    util.ONE_MINUTE,
+			OpenTime:    start.Add(time.Duration(i) * time.Minute),
+			Price:       util.RandomMoney(),
+			Volume:      decimal.NewFromInt(1),
+			QuoteVolume: decimal.NewFromInt(1),
+		})
+		require.NoError(t, err)
+	}
+
+	candles, err := testQueries.ListCandles(context.Background(), ListCandlesParams{
+		Pair:     pair,
+		Interval: util.ONE_MINUTE,
+		FromTime: start.Add(2 * time.Minute),
+		ToTime:   start.Add(8 * time.Minute),
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, candles, 5)
+	for i, candle := range candles {
+		require.WithinDuration(t, start.Add(time.Duration(i+2)*time.Minute), candle.OpenTime, time.Second)
+	}
+
+	candles, err = testQueries.ListCandles(context.Background(), ListCandlesParams{
+		Pair:     pair,
+		Interval: util.ONE_HOUR,
+		FromTime: start,
+		ToTime:   start.Add(time.Hour),
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, candles)
+}
+
+func TestBackfillCandlesTx(t *testing.T) {
+	store := NewStore(testDB)
+	pair := createCandlePair(t)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	prices := []int64{10, 14, 8, 12}
+	for _, price := range prices {
+		_, err := testQueries.CreateTrade(context.Background(), CreateTradeParams{
+			FirstFromAccountID:  account1.ID,
+			FirstToAccountID:    account2.ID,
+			FirstAmount:         decimal.NewFromInt(2 * price),
+			SecondFromAccountID: account2.ID,
+			SecondToAccountID:   account1.ID,
+			SecondAmount:        decimal.NewFromInt(2),
+			Pair:                sql.NullString{String: pair, Valid: true},
+			Price:               decimal.NullDecimal{Decimal: decimal.NewFromInt(price), Valid: true},
+		})
+		require.NoError(t, err)
+	}
+
+	// trades made outside the order book have no price and are left out
+	_, err := testQueries.CreateTrade(context.Background(), CreateTradeParams{
+		FirstFromAccountID:  account1.ID,
+		FirstToAccountID:    account2.ID,
+		FirstAmount:         decimal.NewFromInt(1),
+		SecondFromAccountID: account2.ID,
+		SecondToAccountID:   account1.ID,
+		SecondAmount:        decimal.NewFromInt(1),
+		Pair:                sql.NullString{String: pair, Valid: true},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	result, err := store.BackfillCandlesTx(context.Background(), BackfillCandlesTxParams{
+		Pair:     pair,
+		FromTime: now.Add(-time.Minute),
+		ToTime:   now.Add(time.Second),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Candles, len(util.CandleIntervals))
+
+	for _, interval := range util.CandleIntervals {
+		// the trades may straddle the boundary of an interval
+		require.GreaterOrEqual(t, result.Candles[interval], int64(1))
+
+		candles, err := store.ListCandles(context.Background(), ListCandlesParams{
+			Pair:     pair,
+			Interval: interval,
+			FromTime: now.Add(-2 * util.CandleIntervalDuration(interval)),
+			ToTime:   now.Add(time.Second),
+			Limit:    10,
+		})
+		require.NoError(t, err)
+		require.Len(t, candles, int(result.Candles[interval]))
+
+		var count int64
+		for _, candle := range candles {
+			count += candle.TradeCount
+		}
+		require.Equal(t, int64(len(prices)), count)
+	}
+
+	candles, err := store.ListCandles(context.Background(), ListCandlesParams{
+		Pair:     pair,
+		Interval: util.ONE_DAY,
+		FromTime: now.Add(-48 * time.Hour),
+		ToTime:   now.Add(time.Second),
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	if len(candles) == 1 {
+		require.Equal(t, decimal.NewFromInt(10), candles[0].Open)
+		require.Equal(t, decimal.NewFromInt(14), candles[0].High)
+		require.Equal(t, decimal.NewFromInt(8), candles[0].Low)
+		require.Equal(t, decimal.NewFromInt(12), candles[0].Close)
+		require.Equal(t, decimal.NewFromInt(8), candles[0].Volume)
+		require.Equal(t, decimal.NewFromInt(88), candles[0].QuoteVolume)
+	}
+}
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"go-exchange/decimal"
 )
 
 const createEntry = `-- name: CreateEntry :one
@@ -15,8 +17,8 @@ RETURNING id, account_id, amount, created_at
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID int64           `json:"account_id"`
+	Amount    decimal.Decimal `json:"amount"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
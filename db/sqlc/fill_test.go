@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomFill(t *testing.T, bid Bid, ask Ask) Fill {
+	trade := createRandomTrade(t)
+
+	arg := CreateFillParams{
+		TradeID: trade.ID,
+		BidID:   bid.ID,
+		AskID:   ask.ID,
+		Price:   util.RandomMoney(),
+		Amount:  decimal.NewFromInt(util.RandomInt(1, 100)),
+	}
+
+	fill, err := testQueries.CreateFill(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, fill)
+
+	require.Equal(t, arg.TradeID, fill.TradeID)
+	require.Equal(t, arg.BidID, fill.BidID)
+	require.Equal(t, arg.AskID, fill.AskID)
+	require.Equal(t, arg.Price, fill.Price)
+	require.Equal(t, arg.Amount, fill.Amount)
+
+	require.NotZero(t, fill.ID)
+	require.NotZero(t, fill.CreatedAt)
+
+	return fill
+}
+
+func TestCreateFill(t *testing.T) {
+	createRandomFill(t, createRandomBid(t), createRandomAsk(t))
+}
+
+func TestGetFill(t *testing.T) {
+	fill1 := createRandomFill(t, createRandomBid(t), createRandomAsk(t))
+	fill2, err := testQueries.GetFill(context.Background(), fill1.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, fill2)
+
+	require.Equal(t, fill1.ID, fill2.ID)
+	require.Equal(t, fill1.TradeID, fill2.TradeID)
+	require.Equal(t, fill1.BidID, fill2.BidID)
+	require.Equal(t, fill1.AskID, fill2.AskID)
+	require.Equal(t, fill1.Price, fill2.Price)
+	require.Equal(t, fill1.Amount, fill2.Amount)
+	require.WithinDuration(t, fill1.CreatedAt, fill2.CreatedAt, time.Second)
+}
+
+func TestListOrderFills(t *testing.T) {
+	bid := createRandomBid(t)
+	ask1 := createRandomAsk(t)
+	ask2 := createRandomAsk(t)
+
+	for i := 0; i < 3; i++ {
+		createRandomFill(t, bid, ask1)
+	}
+	createRandomFill(t, bid, ask2)
+
+	fills, err := testQueries.ListBidFills(context.Background(), bid.ID)
+	require.NoError(t, err)
+	require.Len(t, fills, 4)
+	for _, fill := range fills {
+		require.Equal(t, bid.ID, fill.BidID)
+	}
+
+	fills, err = testQueries.ListAskFills(context.Background(), ask1.ID)
+	require.NoError(t, err)
+	require.Len(t, fills, 3)
+	for _, fill := range fills {
+		require.Equal(t, ask1.ID, fill.AskID)
+	}
+}
@@ -5,40 +5,149 @@
 package db
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
+	"go-exchange/decimal"
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
-	Balance   int64     `json:"balance"`
-	Currency  string    `json:"currency"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        int64           `json:"id"`
+	Owner     string          `json:"owner"`
+	Balance   decimal.Decimal `json:"balance"`
+	Currency  string          `json:"currency"`
+	CreatedAt time.Time       `json:"created_at"`
+	// funds reserved by open orders
+	Held decimal.Decimal `json:"held"`
 }
 
 type Ask struct {
-	ID            int64  `json:"id"`
-	Pair          string `json:"pair"`
-	FromAccountID int64  `json:"from_account_id"`
-	ToAccountID   int64  `json:"to_account_id"`
-	Price         int64  `json:"price"`
+	ID            int64           `json:"id"`
+	Pair          string          `json:"pair"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Price         decimal.Decimal `json:"price"`
 	// it must be positive
-	Amount    int64     `json:"amount"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	Amount       decimal.Decimal `json:"amount"`
+	Status       string          `json:"status"`
+	CreatedAt    time.Time       `json:"created_at"`
+	FilledAmount decimal.Decimal `json:"filled_amount"`
+	// amount - filled_amount
+	RemainingAmount decimal.Decimal `json:"remaining_amount"`
+	// average price of the fills
+	AveragePrice decimal.Decimal `json:"average_price"`
+	// limit, market, stop_limit or stop_market
+	Type string `json:"type"`
+	// GTC, IOC, FOK or GTD
+	TimeInForce string `json:"time_in_force"`
+	// only set for GTD orders
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	// trigger price of stop orders
+	StopPrice decimal.Decimal `json:"stop_price"`
+	// canceled instead of taking liquidity
+	PostOnly bool `json:"post_only"`
+	// visible amount of iceberg orders, 0 shows the whole amount
+	DisplayAmount decimal.Decimal `json:"display_amount"`
+	// kept out of the public depth
+	Hidden  bool          `json:"hidden"`
+	GroupID sql.NullInt64 `json:"group_id"`
+	// entry, take_profit or stop_loss
+	GroupLeg string `json:"group_leg"`
+	// time the order joined the queue of its price
+	PriorityAt time.Time `json:"priority_at"`
+	// owner of the from account
+	Owner string `json:"owner"`
+	// cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel
+	SelfTradePrevention string `json:"self_trade_prevention"`
 }
 
 type Bid struct {
-	ID            int64  `json:"id"`
-	Pair          string `json:"pair"`
-	FromAccountID int64  `json:"from_account_id"`
-	ToAccountID   int64  `json:"to_account_id"`
-	Price         int64  `json:"price"`
+	ID            int64           `json:"id"`
+	Pair          string          `json:"pair"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Price         decimal.Decimal `json:"price"`
 	// it must be positive
-	Amount    int64     `json:"amount"`
+	Amount       decimal.Decimal `json:"amount"`
+	Status       string          `json:"status"`
+	CreatedAt    time.Time       `json:"created_at"`
+	FilledAmount decimal.Decimal `json:"filled_amount"`
+	// amount - filled_amount
+	RemainingAmount decimal.Decimal `json:"remaining_amount"`
+	// average price of the fills
+	AveragePrice decimal.Decimal `json:"average_price"`
+	// limit, market, stop_limit or stop_market
+	Type string `json:"type"`
+	// GTC, IOC, FOK or GTD
+	TimeInForce string `json:"time_in_force"`
+	// only set for GTD orders
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	// trigger price of stop orders
+	StopPrice decimal.Decimal `json:"stop_price"`
+	// canceled instead of taking liquidity
+	PostOnly bool `json:"post_only"`
+	// visible amount of iceberg orders, 0 shows the whole amount
+	DisplayAmount decimal.Decimal `json:"display_amount"`
+	// kept out of the public depth
+	Hidden  bool          `json:"hidden"`
+	GroupID sql.NullInt64 `json:"group_id"`
+	// entry, take_profit or stop_loss
+	GroupLeg string `json:"group_leg"`
+	// time the order joined the queue of its price
+	PriorityAt time.Time `json:"priority_at"`
+	// owner of the from account
+	Owner string `json:"owner"`
+	// cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel
+	SelfTradePrevention string `json:"self_trade_prevention"`
+}
+
+type Candle struct {
+	Pair string `json:"pair"`
+	// 1m, 5m, 15m, 1h, 4h or 1d
+	Interval string `json:"interval"`
+	// start of the interval, aligned to the unix epoch
+	OpenTime time.Time       `json:"open_time"`
+	Open     decimal.Decimal `json:"open"`
+	High     decimal.Decimal `json:"high"`
+	Low      decimal.Decimal `json:"low"`
+	Close    decimal.Decimal `json:"close"`
+	// traded amount of the base currency
+	Volume decimal.Decimal `json:"volume"`
+	// traded amount of the quote currency
+	QuoteVolume decimal.Decimal `json:"quote_volume"`
+	TradeCount  int64           `json:"trade_count"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type Currency struct {
+	Code string `json:"code"`
+	// decimal places of the smallest unit of an amount
+	Decimals int64 `json:"decimals"`
+	// active, paused or delisted
 	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DeadManSwitchEvent struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// armed, refreshed, disarmed or fired
+	Action         string    `json:"action"`
+	TimeoutSeconds int64     `json:"timeout_seconds"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	// number of orders canceled when fired
+	CanceledOrders int64     `json:"canceled_orders"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type DeadManSwitch struct {
+	Username       string `json:"username"`
+	TimeoutSeconds int64  `json:"timeout_seconds"`
+	// open orders are canceled if no heartbeat arrives before
+	ExpiresAt time.Time `json:"expires_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -46,7 +155,74 @@ type Entry struct {
 	ID        int64 `json:"id"`
 	AccountID int64 `json:"account_id"`
 	// can be negative or positive
-	Amount    int64     `json:"amount"`
+	Amount    decimal.Decimal `json:"amount"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type FeeAccount struct {
+	Currency  string    `json:"currency"`
+	AccountID int64     `json:"account_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FeeTier struct {
+	ID   int64  `json:"id"`
+	Pair string `json:"pair"`
+	// trailing 30-day volume in the quote currency to reach the tier
+	MinVolume decimal.Decimal `json:"min_volume"`
+	// basis points
+	MakerRate int64 `json:"maker_rate"`
+	// basis points
+	TakerRate int64     `json:"taker_rate"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Fill struct {
+	ID      int64           `json:"id"`
+	TradeID int64           `json:"trade_id"`
+	BidID   int64           `json:"bid_id"`
+	AskID   int64           `json:"ask_id"`
+	Price   decimal.Decimal `json:"price"`
+	// it must be positive
+	Amount    decimal.Decimal `json:"amount"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type OrderEvent struct {
+	ID int64 `json:"id"`
+	// bid or ask
+	Side    string `json:"side"`
+	OrderID int64  `json:"order_id"`
+	// self_trade_prevented
+	Type                string `json:"type"`
+	SelfTradePrevention string `json:"self_trade_prevention"`
+	// order of the other side
+	CounterOrderID sql.NullInt64 `json:"counter_order_id"`
+	// amount taken off the order
+	Amount    decimal.Decimal `json:"amount"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type OrderGroup struct {
+	ID int64 `json:"id"`
+	// oco or bracket
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Pair struct {
+	Symbol string `json:"symbol"`
+	Base   string `json:"base"`
+	Quote  string `json:"quote"`
+	// prices must be a multiple of it
+	TickSize decimal.Decimal `json:"tick_size"`
+	// amounts must be a multiple of it
+	LotSize decimal.Decimal `json:"lot_size"`
+	// minimum price*amount of an order in the quote currency
+	MinNotional decimal.Decimal `json:"min_notional"`
+	// active, paused or delisted
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -66,12 +242,26 @@ type Trade struct {
 	FirstFromAccountID int64 `json:"first_from_account_id"`
 	FirstToAccountID   int64 `json:"first_to_account_id"`
 	// it must be positive
-	FirstAmount         int64 `json:"first_amount"`
-	SecondFromAccountID int64 `json:"second_from_account_id"`
-	SecondToAccountID   int64 `json:"second_to_account_id"`
+	FirstAmount         decimal.Decimal `json:"first_amount"`
+	SecondFromAccountID int64           `json:"second_from_account_id"`
+	SecondToAccountID   int64           `json:"second_to_account_id"`
 	// it must be positive
-	SecondAmount int64     `json:"second_amount"`
-	CreatedAt    time.Time `json:"created_at"`
+	SecondAmount decimal.Decimal `json:"second_amount"`
+	CreatedAt    time.Time       `json:"created_at"`
+	// taken from the first amount by the exchange
+	FirstFee decimal.Decimal `json:"first_fee"`
+	// taken from the second amount by the exchange
+	SecondFee decimal.Decimal `json:"second_fee"`
+	// pair the trade was made on
+	Pair sql.NullString `json:"pair"`
+	// price of the fill, null for trades made outside the order book
+	Price decimal.NullDecimal `json:"price"`
+	// side of the order that took liquidity, null for trades made outside the order book
+	TakerSide sql.NullString `json:"taker_side"`
+	// bid or ask resting on the book, on the side opposite to the taker
+	MakerOrderID sql.NullInt64 `json:"maker_order_id"`
+	// bid or ask that took liquidity, on the taker side
+	TakerOrderID sql.NullInt64 `json:"taker_order_id"`
 }
 
 type Transfer struct {
@@ -79,8 +269,8 @@ type Transfer struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	// it must be positive
-	Amount    int64     `json:"amount"`
-	CreatedAt time.Time `json:"created_at"`
+	Amount    decimal.Decimal `json:"amount"`
+	CreatedAt time.Time       `json:"created_at"`
 }
 
 type User struct {
@@ -90,4 +280,8 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	// default self-trade prevention mode of new orders
+	SelfTradePrevention string `json:"self_trade_prevention"`
+	// trader or admin
+	Role string `json:"role"`
 }
@@ -6,41 +6,104 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"go-exchange/decimal"
 )
 
 type Querier interface {
+	ActivateAsk(ctx context.Context, arg ActivateAskParams) (Ask, error)
+	ActivateBid(ctx context.Context, arg ActivateBidParams) (Bid, error)
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountHeld(ctx context.Context, arg AddAccountHeldParams) (Account, error)
+	AmendAsk(ctx context.Context, arg AmendAskParams) (Ask, error)
+	AmendBid(ctx context.Context, arg AmendBidParams) (Bid, error)
+	BackfillCandles(ctx context.Context, arg BackfillCandlesParams) (int64, error)
+	CloseAsk(ctx context.Context, arg CloseAskParams) (Ask, error)
+	CloseBid(ctx context.Context, arg CloseBidParams) (Bid, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
 	CreateAsk(ctx context.Context, arg CreateAskParams) (Ask, error)
 	CreateBid(ctx context.Context, arg CreateBidParams) (Bid, error)
+	CreateCurrency(ctx context.Context, arg CreateCurrencyParams) (Currency, error)
+	CreateDeadManSwitchEvent(ctx context.Context, arg CreateDeadManSwitchEventParams) (DeadManSwitchEvent, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFeeAccount(ctx context.Context, arg CreateFeeAccountParams) (FeeAccount, error)
+	CreateFeeTier(ctx context.Context, arg CreateFeeTierParams) (FeeTier, error)
+	CreateFill(ctx context.Context, arg CreateFillParams) (Fill, error)
+	CreateOrderEvent(ctx context.Context, arg CreateOrderEventParams) (OrderEvent, error)
+	CreateOrderGroup(ctx context.Context, type_ string) (OrderGroup, error)
+	CreatePair(ctx context.Context, arg CreatePairParams) (Pair, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTrade(ctx context.Context, arg CreateTradeParams) (Trade, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DecrementAsk(ctx context.Context, arg DecrementAskParams) (Ask, error)
+	DecrementBid(ctx context.Context, arg DecrementBidParams) (Bid, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteDeadManSwitch(ctx context.Context, username string) error
+	DeleteFeeTier(ctx context.Context, id int64) (FeeTier, error)
 	DeleteUser(ctx context.Context, username string) error
+	FillAsk(ctx context.Context, arg FillAskParams) (Ask, error)
+	FillBid(ctx context.Context, arg FillBidParams) (Bid, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
 	GetAsk(ctx context.Context, id int64) (Ask, error)
+	GetAskForUpdate(ctx context.Context, id int64) (Ask, error)
 	GetBid(ctx context.Context, id int64) (Bid, error)
+	GetBidFee(ctx context.Context, bidID int64) (decimal.Decimal, error)
+	GetBidForUpdate(ctx context.Context, id int64) (Bid, error)
+	GetCurrency(ctx context.Context, code string) (Currency, error)
+	GetDeadManSwitch(ctx context.Context, username string) (DeadManSwitch, error)
+	GetDeadManSwitchForUpdate(ctx context.Context, username string) (DeadManSwitch, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetFeeAccount(ctx context.Context, currency string) (FeeAccount, error)
+	GetFeeTier(ctx context.Context, arg GetFeeTierParams) (FeeTier, error)
+	GetFill(ctx context.Context, id int64) (Fill, error)
+	GetOrderGroup(ctx context.Context, id int64) (OrderGroup, error)
+	GetPair(ctx context.Context, symbol string) (Pair, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetTrade(ctx context.Context, id int64) (Trade, error)
+	GetTradedVolume(ctx context.Context, arg GetTradedVolumeParams) (decimal.Decimal, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAskFills(ctx context.Context, askID int64) ([]Fill, error)
 	ListAsks(ctx context.Context, arg ListAsksParams) ([]Ask, error)
+	ListAsksByGroup(ctx context.Context, groupID sql.NullInt64) ([]Ask, error)
+	ListAsksByStatus(ctx context.Context, status string) ([]Ask, error)
+	ListBidFills(ctx context.Context, bidID int64) ([]Fill, error)
 	ListBids(ctx context.Context, arg ListBidsParams) ([]Bid, error)
+	ListBidsByGroup(ctx context.Context, groupID sql.NullInt64) ([]Bid, error)
+	ListBidsByStatus(ctx context.Context, status string) ([]Bid, error)
+	ListCandles(ctx context.Context, arg ListCandlesParams) ([]Candle, error)
+	ListCurrencies(ctx context.Context) ([]Currency, error)
+	ListDeadManSwitchEvents(ctx context.Context, arg ListDeadManSwitchEventsParams) ([]DeadManSwitchEvent, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListExpiredAsks(ctx context.Context, now time.Time) ([]Ask, error)
+	ListExpiredBids(ctx context.Context, now time.Time) ([]Bid, error)
+	ListExpiredDeadManSwitches(ctx context.Context, expiresAt time.Time) ([]DeadManSwitch, error)
+	ListFeeTiers(ctx context.Context, pair string) ([]FeeTier, error)
+	ListOpenAsksByOwner(ctx context.Context, arg ListOpenAsksByOwnerParams) ([]Ask, error)
+	ListOpenBidsByOwner(ctx context.Context, arg ListOpenBidsByOwnerParams) ([]Bid, error)
+	ListOrderEvents(ctx context.Context, arg ListOrderEventsParams) ([]OrderEvent, error)
+	ListPairTrades(ctx context.Context, arg ListPairTradesParams) ([]Trade, error)
+	ListPairs(ctx context.Context) ([]Pair, error)
 	ListTrades(ctx context.Context, arg ListTradesParams) ([]Trade, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	RefreshDeadManSwitch(ctx context.Context, arg RefreshDeadManSwitchParams) (DeadManSwitch, error)
+	ResizeAsk(ctx context.Context, arg ResizeAskParams) (Ask, error)
+	TriggerAsk(ctx context.Context, id int64) (Ask, error)
+	TriggerBid(ctx context.Context, id int64) (Bid, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
 	UpdateAsk(ctx context.Context, arg UpdateAskParams) (Ask, error)
 	UpdateBid(ctx context.Context, arg UpdateBidParams) (Bid, error)
+	UpdateCurrency(ctx context.Context, arg UpdateCurrencyParams) (Currency, error)
+	UpdatePair(ctx context.Context, arg UpdatePairParams) (Pair, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpsertCandle(ctx context.Context, arg UpsertCandleParams) (Candle, error)
+	UpsertDeadManSwitch(ctx context.Context, arg UpsertDeadManSwitchParams) (DeadManSwitch, error)
 }
 
 var _ Querier = (*Queries)(nil)
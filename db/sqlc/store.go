@@ -3,14 +3,38 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"go-exchange/decimal"
 )
 
+// ErrInsufficientFunds is returned when an account can't cover an amount with its available balance
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
 // Store defines all functions to execute db queries and transactions
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
 	TradeTx(ctx context.Context, arg TradeTxParams) (TradeTxResult, error)
+	FillTx(ctx context.Context, arg FillTxParams) (FillTxResult, error)
+	CreateBidTx(ctx context.Context, arg CreateBidParams) (CreateBidTxResult, error)
+	AmendBidTx(ctx context.Context, arg AmendBidParams) (AmendBidTxResult, error)
+	CancelBidTx(ctx context.Context, id int64) (CancelBidTxResult, error)
+	ExpireBidTx(ctx context.Context, id int64) (CancelBidTxResult, error)
+	CreateAskTx(ctx context.Context, arg CreateAskParams) (CreateAskTxResult, error)
+	AmendAskTx(ctx context.Context, arg AmendAskParams) (AmendAskTxResult, error)
+	CancelAskTx(ctx context.Context, id int64) (CancelAskTxResult, error)
+	ExpireAskTx(ctx context.Context, id int64) (CancelAskTxResult, error)
+	CancelOrdersTx(ctx context.Context, arg CancelOrdersTxParams) (CancelOrdersTxResult, error)
+	SelfTradeTx(ctx context.Context, arg SelfTradeTxParams) (SelfTradeTxResult, error)
+	CreateOrderGroupTx(ctx context.Context, arg CreateOrderGroupTxParams) (CreateOrderGroupTxResult, error)
+	CancelOrderGroupTx(ctx context.Context, id int64) (CancelOrderGroupTxResult, error)
+	ArmDeadManSwitchTx(ctx context.Context, arg ArmDeadManSwitchTxParams) (DeadManSwitchTxResult, error)
+	RefreshDeadManSwitchTx(ctx context.Context, arg RefreshDeadManSwitchTxParams) (DeadManSwitchTxResult, error)
+	DisarmDeadManSwitchTx(ctx context.Context, username string) (DeadManSwitchTxResult, error)
+	FireDeadManSwitchTx(ctx context.Context, arg FireDeadManSwitchTxParams) (DeadManSwitchTxResult, error)
+	CreateCurrencyTx(ctx context.Context, arg CreateCurrencyParams) (CreateCurrencyTxResult, error)
+	BackfillCandlesTx(ctx context.Context, arg BackfillCandlesTxParams) (BackfillCandlesTxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -45,3 +69,20 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	return tx.Commit()
 }
+
+// holdMoney moves an amount from the available balance of an account to its held funds.
+// A negative amount releases held funds back to the available balance
+func holdMoney(ctx context.Context, q *Queries, accountID int64, amount decimal.Decimal) (Account, error) {
+	account, err := q.AddAccountHeld(ctx, AddAccountHeldParams{
+		ID:     accountID,
+		Amount: amount,
+	})
+	if err != nil {
+		return account, err
+	}
+
+	if account.Balance.LessThan(account.Held) {
+		return account, ErrInsufficientFunds
+	}
+	return account, nil
+}
@@ -2,21 +2,40 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
+func createFundedAccount(t *testing.T, balance int64, currency_optional ...string) Account {
+	account := createRandomAccount(t, currency_optional...)
+
+	account, err := testQueries.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      account.ID,
+		Balance: decimal.NewFromInt(balance),
+	})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(balance), account.Balance)
+	require.Zero(t, account.Held)
+
+	return account
+}
+
 func TestTransferTx(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
+	account1 := createFundedAccount(t, 1000)
+	account2 := createFundedAccount(t, 1000)
 	fmt.Println(">> before:", account1.Balance, account2.Balance)
 
 	n := 5
-	amount := int64(10)
+	amount := decimal.NewFromInt(10)
 
 	errs := make(chan error)
 	results := make(chan TransferTxResult)
@@ -61,7 +80,7 @@ func TestTransferTx(t *testing.T) {
 		fromEntry := result.FromEntry
 		require.NotEmpty(t, fromEntry)
 		require.Equal(t, account1.ID, fromEntry.AccountID)
-		require.Equal(t, -amount, fromEntry.Amount)
+		require.Equal(t, amount.Neg(), fromEntry.Amount)
 		require.NotZero(t, fromEntry.ID)
 		require.NotZero(t, fromEntry.CreatedAt)
 
@@ -90,13 +109,13 @@ func TestTransferTx(t *testing.T) {
 		// check balances
 		fmt.Println(">> tx:", fromAccount.Balance, toAccount.Balance)
 
-		diff1 := account1.Balance - fromAccount.Balance
-		diff2 := toAccount.Balance - account2.Balance
+		diff1 := account1.Balance.Sub(fromAccount.Balance)
+		diff2 := toAccount.Balance.Sub(account2.Balance)
 		require.Equal(t, diff1, diff2)
-		require.True(t, diff1 > 0)
-		require.True(t, diff1 % amount == 0) // 1 * amount, 2 * amount, 3 * amount, ..., n * amount
+		require.True(t, diff1.IsPositive())
+		require.True(t, diff1.IsMultipleOf(amount)) // 1 * amount, 2 * amount, 3 * amount, ..., n * amount
 
-		k := int(diff1 / amount)
+		k := int(diff1.Div(amount, 0, decimal.RoundDown).Float64())
 		require.True(t, k >= 1 && k <= n)
 		require.NotContains(t, existed, k)
 		existed[k] = true
@@ -111,19 +130,19 @@ func TestTransferTx(t *testing.T) {
 
 	fmt.Println(">> after:", updatedAccount1.Balance, updatedAccount2.Balance)
 
-	require.Equal(t, account1.Balance-int64(n)*amount, updatedAccount1.Balance)
-	require.Equal(t, account2.Balance+int64(n)*amount, updatedAccount2.Balance)
+	require.Equal(t, account1.Balance.Sub(decimal.NewFromInt(int64(n)).Mul(amount)), updatedAccount1.Balance)
+	require.Equal(t, account2.Balance.Add(decimal.NewFromInt(int64(n)).Mul(amount)), updatedAccount2.Balance)
 }
 
 func TestTransferTxDeadlock(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
+	account1 := createFundedAccount(t, 1000)
+	account2 := createFundedAccount(t, 1000)
 	fmt.Println(">> before:", account1.Balance, account2.Balance)
 
 	n := 10
-	amount := int64(10)
+	amount := decimal.NewFromInt(10)
 	errs := make(chan error)
 
 	for i := 0; i < n; i++ {
@@ -162,3 +181,1108 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedAccount1.Balance)
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
+
+func TestTransferTxHeldFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 1000, util.USDT)
+	account2 := createFundedAccount(t, 1000, util.USDT)
+	account3 := createRandomAccount(t, util.BTC)
+
+	_, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account3.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// 600 of the 1000 are held by the bid
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        decimal.NewFromInt(401),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        decimal.NewFromInt(400),
+	})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(600), result.FromAccount.Balance)
+	require.Equal(t, decimal.NewFromInt(600), result.FromAccount.Held)
+}
+
+func TestCreateBidTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 1000, util.USDT)
+	account2 := createRandomAccount(t, util.BTC)
+
+	arg := CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	}
+
+	result, err := store.CreateBidTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, result.Bid.ID)
+	require.Equal(t, arg.Amount, result.Bid.Amount)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+	require.Equal(t, arg.Price.Mul(arg.Amount), result.FromAccount.Held)
+
+	// only 400 are still available
+	arg.Amount = decimal.NewFromInt(41)
+	_, err = store.CreateBidTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	bids, err := store.ListBids(context.Background(), ListBidsParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Limit:         5,
+		Offset:        0,
+	})
+	require.NoError(t, err)
+	require.Len(t, bids, 1)
+}
+
+func TestCancelBidTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 1000, util.USDT)
+	account2 := createRandomAccount(t, util.BTC)
+
+	created, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	result, err := store.CancelBidTx(context.Background(), created.Bid.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CANCELED, result.Bid.Status)
+	require.Zero(t, result.FromAccount.Held)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+
+	// the funds are released only once
+	_, err = store.CancelBidTx(context.Background(), created.Bid.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestExpireAskTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 100, util.BTC)
+	account2 := createRandomAccount(t, util.USDT)
+
+	created, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTD,
+		ExpiresAt:     sql.NullTime{Time: time.Now().Add(time.Minute), Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.GTD, created.Ask.TimeInForce)
+	require.True(t, created.Ask.ExpiresAt.Valid)
+
+	result, err := store.ExpireAskTx(context.Background(), created.Ask.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.EXPIRED, result.Ask.Status)
+	require.Zero(t, result.FromAccount.Held)
+	require.Equal(t, account1.Balance, result.FromAccount.Balance)
+
+	_, err = store.CancelAskTx(context.Background(), created.Ask.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCreateAskTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 100, util.BTC)
+	account2 := createRandomAccount(t, util.USDT)
+
+	arg := CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	}
+
+	result, err := store.CreateAskTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Amount, result.FromAccount.Held)
+
+	_, err = store.CreateAskTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	canceled, err := store.CancelAskTx(context.Background(), result.Ask.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CANCELED, canceled.Ask.Status)
+	require.Zero(t, canceled.FromAccount.Held)
+}
+
+func TestTradeTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	buyerQuote := createFundedAccount(t, 1000, util.USDT)
+	buyerBase := createFundedAccount(t, 0, util.BTC)
+	sellerBase := createFundedAccount(t, 100, util.BTC)
+	sellerQuote := createFundedAccount(t, 0, util.USDT)
+
+	bid, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: buyerQuote.ID,
+		ToAccountID:   buyerBase.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(50),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	ask, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(50),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// the ask is the maker, so the trade runs at its price
+	result, err := store.TradeTx(context.Background(), TradeTxParams{
+		FirstFromAccountID:  buyerQuote.ID,
+		FirstToAccountID:    sellerQuote.ID,
+		FirstAmount:         ask.Ask.Price.Mul(decimal.NewFromInt(50)),
+		SecondFromAccountID: sellerBase.ID,
+		SecondToAccountID:   buyerBase.ID,
+		SecondAmount:        decimal.NewFromInt(50),
+		FirstReleased:       bid.Bid.Price.Mul(decimal.NewFromInt(50)),
+		SecondReleased:      decimal.NewFromInt(50),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Trade.ID)
+	require.Equal(t, buyerQuote.ID, result.FirstTransfer.FromAccountID)
+	require.Equal(t, sellerBase.ID, result.SecondTransfer.FromAccountID)
+
+	expected := map[int64][2]int64{
+		buyerQuote.ID:  {500, 0},
+		buyerBase.ID:   {50, 0},
+		sellerBase.ID:  {50, 0},
+		sellerQuote.ID: {500, 0},
+	}
+	for id, balance := range expected {
+		account, err := store.GetAccount(context.Background(), id)
+		require.NoError(t, err)
+		require.Equal(t, balance[0], account.Balance)
+		require.Equal(t, balance[1], account.Held)
+
+		entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+			AccountID: id,
+			Limit:     5,
+			Offset:    0,
+		})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	}
+}
+
+func TestTradeTxRollback(t *testing.T) {
+	store := NewStore(testDB)
+
+	buyerQuote := createFundedAccount(t, 1000, util.USDT)
+	buyerBase := createFundedAccount(t, 0, util.BTC)
+	sellerBase := createFundedAccount(t, 10, util.BTC)
+	sellerQuote := createFundedAccount(t, 0, util.USDT)
+
+	// the seller can't deliver the second leg, so the first one must not commit either
+	_, err := store.TradeTx(context.Background(), TradeTxParams{
+		FirstFromAccountID:  buyerQuote.ID,
+		FirstToAccountID:    sellerQuote.ID,
+		FirstAmount:         decimal.NewFromInt(500),
+		SecondFromAccountID: sellerBase.ID,
+		SecondToAccountID:   buyerBase.ID,
+		SecondAmount:        decimal.NewFromInt(50),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	for _, account := range []Account{buyerQuote, buyerBase, sellerBase, sellerQuote} {
+		updated, err := store.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+
+		entries, err := store.ListEntries(context.Background(), ListEntriesParams{
+			AccountID: account.ID,
+			Limit:     5,
+			Offset:    0,
+		})
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+}
+
+func TestTradeTxDeadlock(t *testing.T) {
+	store := NewStore(testDB)
+
+	quote1 := createFundedAccount(t, 1000, util.USDT)
+	base1 := createFundedAccount(t, 1000, util.BTC)
+	quote2 := createFundedAccount(t, 1000, util.USDT)
+	base2 := createFundedAccount(t, 1000, util.BTC)
+
+	n := 10
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		arg := TradeTxParams{
+			FirstFromAccountID:  quote1.ID,
+			FirstToAccountID:    quote2.ID,
+			FirstAmount:         decimal.NewFromInt(20),
+			SecondFromAccountID: base2.ID,
+			SecondToAccountID:   base1.ID,
+			SecondAmount:        decimal.NewFromInt(2),
+		}
+
+		// every other trade goes the opposite way and locks the accounts in reverse order
+		if i%2 == 1 {
+			arg = TradeTxParams{
+				FirstFromAccountID:  quote2.ID,
+				FirstToAccountID:    quote1.ID,
+				FirstAmount:         decimal.NewFromInt(20),
+				SecondFromAccountID: base1.ID,
+				SecondToAccountID:   base2.ID,
+				SecondAmount:        decimal.NewFromInt(2),
+			}
+		}
+
+		go func() {
+			_, err := store.TradeTx(context.Background(), arg)
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+	}
+
+	for _, account := range []Account{quote1, base1, quote2, base2} {
+		updated, err := store.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updated.Balance)
+	}
+}
+
+func TestFillTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	buyerQuote := createFundedAccount(t, 1000, util.USDT)
+	buyerBase := createFundedAccount(t, 0, util.BTC)
+	sellerBase := createFundedAccount(t, 100, util.BTC)
+	sellerQuote := createFundedAccount(t, 0, util.USDT)
+
+	bid, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: buyerQuote.ID,
+		ToAccountID:   buyerBase.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(50),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, bid.Bid.Amount, bid.Bid.RemainingAmount)
+	require.Zero(t, bid.Bid.FilledAmount)
+
+	ask, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(80),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	result, err := store.FillTx(context.Background(), FillTxParams{
+		BidID:  bid.Bid.ID,
+		AskID:  ask.Ask.ID,
+		Price:  decimal.NewFromInt(10),
+		Amount: decimal.NewFromInt(20),
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Trade.ID, result.Fill.TradeID)
+	require.Equal(t, decimal.NewFromInt(20), result.Fill.Amount)
+	require.Equal(t, util.BTC_USDT, result.Trade.Pair.String)
+	require.Equal(t, decimal.NewFromInt(10), result.Trade.Price.Decimal)
+
+	require.Equal(t, util.PARTIALLY_FILLED, result.Bid.Status)
+	require.Equal(t, decimal.NewFromInt(20), result.Bid.FilledAmount)
+	require.Equal(t, decimal.NewFromInt(30), result.Bid.RemainingAmount)
+	require.Equal(t, decimal.NewFromInt(10), result.Bid.AveragePrice)
+
+	result, err = store.FillTx(context.Background(), FillTxParams{
+		BidID:  bid.Bid.ID,
+		AskID:  ask.Ask.ID,
+		Price:  decimal.NewFromInt(12),
+		Amount: decimal.NewFromInt(30),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, util.COMPLETED, result.Bid.Status)
+	require.Equal(t, bid.Bid.Amount, result.Bid.FilledAmount)
+	require.Zero(t, result.Bid.RemainingAmount)
+	require.Equal(t, decimal.NewFromInt(11), result.Bid.AveragePrice) // (20*10 + 30*12) / 50 = 11.2
+
+	require.Equal(t, util.PARTIALLY_FILLED, result.Ask.Status)
+	require.Equal(t, decimal.NewFromInt(30), result.Ask.RemainingAmount)
+
+	fills, err := store.ListBidFills(context.Background(), bid.Bid.ID)
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+
+	fills, err = store.ListAskFills(context.Background(), ask.Ask.ID)
+	require.NoError(t, err)
+	require.Len(t, fills, 2)
+
+	// the bid is completed, so it can't be filled anymore
+	_, err = store.FillTx(context.Background(), FillTxParams{
+		BidID:  bid.Bid.ID,
+		AskID:  ask.Ask.ID,
+		Price:  decimal.NewFromInt(10),
+		Amount: decimal.NewFromInt(10),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// the bid held 12*50 and paid 20*10 + 30*12, the rest is available again
+	account, err := store.GetAccount(context.Background(), buyerQuote.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(1000-560), account.Balance)
+	require.Zero(t, account.Held)
+
+	account, err = store.GetAccount(context.Background(), sellerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(50), account.Balance)
+	require.Equal(t, decimal.NewFromInt(30), account.Held)
+}
+
+func TestFillTxFees(t *testing.T) {
+	store := NewStore(testDB)
+
+	pair := util.SOL_ETH
+	for _, arg := range []CreateFeeTierParams{
+		{Pair: pair, MinVolume: decimal.NewFromInt(0), MakerRate: 10, TakerRate: 20},
+		{Pair: pair, MinVolume: decimal.NewFromInt(100000), MakerRate: 0, TakerRate: 10},
+	} {
+		feeTier, err := store.CreateFeeTier(context.Background(), arg)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, err := store.DeleteFeeTier(context.Background(), feeTier.ID)
+			require.NoError(t, err)
+		})
+	}
+
+	baseFeeAccount, err := store.GetFeeAccount(context.Background(), util.SOL)
+	require.NoError(t, err)
+	baseFees, err := store.GetAccount(context.Background(), baseFeeAccount.AccountID)
+	require.NoError(t, err)
+
+	quoteFeeAccount, err := store.GetFeeAccount(context.Background(), util.ETH)
+	require.NoError(t, err)
+	quoteFees, err := store.GetAccount(context.Background(), quoteFeeAccount.AccountID)
+	require.NoError(t, err)
+
+	buyerQuote := createFundedAccount(t, 200000, util.ETH)
+	buyerBase := createFundedAccount(t, 0, util.SOL)
+	sellerBase := createFundedAccount(t, 20000, util.SOL)
+	sellerQuote := createFundedAccount(t, 0, util.ETH)
+
+	bid, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          pair,
+		FromAccountID: buyerQuote.ID,
+		ToAccountID:   buyerBase.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(20000),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	ask, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          pair,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(20000),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// no volume yet, so the bid pays the taker rate and the ask the maker rate of the first tier
+	result, err := store.FillTx(context.Background(), FillTxParams{
+		BidID:     bid.Bid.ID,
+		AskID:     ask.Ask.ID,
+		Price:     decimal.NewFromInt(10),
+		Amount:    decimal.NewFromInt(10000),
+		TakerSide: util.BID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(100), result.Trade.FirstFee)
+	require.Equal(t, decimal.NewFromInt(20), result.Trade.SecondFee)
+	require.Equal(t, util.BID, result.Trade.TakerSide.String)
+	require.Equal(t, bid.Bid.ID, result.Trade.TakerOrderID.Int64)
+	require.Equal(t, ask.Ask.ID, result.Trade.MakerOrderID.Int64)
+
+	// both reached the second tier with a volume of 10*10000
+	result, err = store.FillTx(context.Background(), FillTxParams{
+		BidID:     bid.Bid.ID,
+		AskID:     ask.Ask.ID,
+		Price:     decimal.NewFromInt(10),
+		Amount:    decimal.NewFromInt(10000),
+		TakerSide: util.ASK,
+	})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(100), result.Trade.FirstFee)
+	require.Zero(t, result.Trade.SecondFee)
+	require.Equal(t, util.ASK, result.Trade.TakerSide.String)
+	require.Equal(t, ask.Ask.ID, result.Trade.TakerOrderID.Int64)
+	require.Equal(t, bid.Bid.ID, result.Trade.MakerOrderID.Int64)
+
+	account, err := store.GetAccount(context.Background(), buyerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(20000-20), account.Balance)
+
+	account, err = store.GetAccount(context.Background(), sellerQuote.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(200000-200), account.Balance)
+
+	account, err = store.GetAccount(context.Background(), baseFees.ID)
+	require.NoError(t, err)
+	require.Equal(t, baseFees.Balance.Add(decimal.NewFromInt(20)), account.Balance)
+
+	account, err = store.GetAccount(context.Background(), quoteFees.ID)
+	require.NoError(t, err)
+	require.Equal(t, quoteFees.Balance.Add(decimal.NewFromInt(200)), account.Balance)
+}
+
+func TestOCOOrderGroupTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	sellerBase := createFundedAccount(t, 100, util.BTC)
+	sellerQuote := createFundedAccount(t, 0, util.USDT)
+	buyerQuote := createFundedAccount(t, 1000, util.USDT)
+	buyerBase := createFundedAccount(t, 0, util.BTC)
+
+	leg := CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTC,
+		GroupLeg:      util.TAKE_PROFIT_LEG,
+	}
+	stopLoss := leg
+	stopLoss.Price = decimal.NewFromInt(8)
+	stopLoss.Status = util.PENDING
+	stopLoss.Type = util.STOP_LIMIT
+	stopLoss.StopPrice = decimal.NewFromInt(9)
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	// both legs share a single hold
+	group, err := store.CreateOrderGroupTx(context.Background(), CreateOrderGroupTxParams{
+		Type: util.OCO,
+		Asks: []CreateAskParams{leg, stopLoss},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OCO, group.OrderGroup.Type)
+	require.Len(t, group.Legs.Asks, 2)
+	for _, ask := range group.Legs.Asks {
+		require.Equal(t, group.OrderGroup.ID, ask.GroupID.Int64)
+	}
+
+	account, err := store.GetAccount(context.Background(), sellerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(60), account.Held)
+
+	// the legs can't be held twice
+	_, err = store.CreateOrderGroupTx(context.Background(), CreateOrderGroupTxParams{
+		Type: util.OCO,
+		Asks: []CreateAskParams{leg, stopLoss},
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	bid, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: buyerQuote.ID,
+		ToAccountID:   buyerBase.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(20),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// the first fill of the take profit cancels the stop loss
+	result, err := store.FillTx(context.Background(), FillTxParams{
+		BidID:  bid.Bid.ID,
+		AskID:  group.Legs.Asks[0].ID,
+		Price:  decimal.NewFromInt(12),
+		Amount: decimal.NewFromInt(20),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Canceled.Asks, 1)
+	require.Equal(t, group.Legs.Asks[1].ID, result.Canceled.Asks[0].ID)
+	require.Equal(t, util.CANCELED, result.Canceled.Asks[0].Status)
+
+	account, err = store.GetAccount(context.Background(), sellerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(80), account.Balance)
+	require.Equal(t, decimal.NewFromInt(40), account.Held)
+
+	// canceling the group cancels the rest of the take profit
+	canceled, err := store.CancelOrderGroupTx(context.Background(), group.OrderGroup.ID)
+	require.NoError(t, err)
+	require.Len(t, canceled.Canceled.Asks, 1)
+	require.Equal(t, group.Legs.Asks[0].ID, canceled.Canceled.Asks[0].ID)
+
+	account, err = store.GetAccount(context.Background(), sellerBase.ID)
+	require.NoError(t, err)
+	require.Zero(t, account.Held)
+}
+
+func TestBracketOrderGroupTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	buyerQuote := createFundedAccount(t, 1000, util.USDT)
+	buyerBase := createFundedAccount(t, 0, util.BTC)
+	sellerBase := createFundedAccount(t, 100, util.BTC)
+	sellerQuote := createFundedAccount(t, 0, util.USDT)
+
+	takeProfit := CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: buyerBase.ID,
+		ToAccountID:   buyerQuote.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(50),
+		Status:        util.INACTIVE,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTC,
+		GroupLeg:      util.TAKE_PROFIT_LEG,
+	}
+	stopLoss := takeProfit
+	stopLoss.Price = decimal.NewFromInt(8)
+	stopLoss.Type = util.STOP_LIMIT
+	stopLoss.StopPrice = decimal.NewFromInt(9)
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	// only the entry holds funds until it is filled
+	group, err := store.CreateOrderGroupTx(context.Background(), CreateOrderGroupTxParams{
+		Type: util.BRACKET,
+		Bids: []CreateBidParams{{
+			Pair:          util.BTC_USDT,
+			FromAccountID: buyerQuote.ID,
+			ToAccountID:   buyerBase.ID,
+			Price:         decimal.NewFromInt(10),
+			Amount:        decimal.NewFromInt(50),
+			Status:        util.ACTIVE,
+			Type:          util.LIMIT,
+			TimeInForce:   util.GTC,
+			GroupLeg:      util.ENTRY_LEG,
+		}},
+		Asks: []CreateAskParams{takeProfit, stopLoss},
+	})
+	require.NoError(t, err)
+
+	account, err := store.GetAccount(context.Background(), buyerQuote.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(500), account.Held)
+
+	ask, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(50),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// the filled entry activates the take profit and the stop loss
+	result, err := store.FillTx(context.Background(), FillTxParams{
+		BidID:  group.Legs.Bids[0].ID,
+		AskID:  ask.Ask.ID,
+		Price:  decimal.NewFromInt(10),
+		Amount: decimal.NewFromInt(50),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Activated.Asks, 2)
+	require.Equal(t, util.ACTIVE, result.Activated.Asks[0].Status)
+	require.Equal(t, util.PENDING, result.Activated.Asks[1].Status)
+
+	account, err = store.GetAccount(context.Background(), buyerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(50), account.Balance)
+	require.Equal(t, decimal.NewFromInt(50), account.Held)
+
+	// canceling the stop loss cancels the take profit and releases their shared hold
+	canceled, err := store.CancelAskTx(context.Background(), group.Legs.Asks[1].ID)
+	require.NoError(t, err)
+	require.Len(t, canceled.Canceled.Asks, 1)
+	require.Equal(t, group.Legs.Asks[0].ID, canceled.Canceled.Asks[0].ID)
+	require.Zero(t, canceled.FromAccount.Held)
+}
+
+func TestBracketOrderGroupTxFees(t *testing.T) {
+	store := NewStore(testDB)
+
+	pair := util.SOL_ETH
+	feeTier, err := store.CreateFeeTier(context.Background(), CreateFeeTierParams{
+		Pair:      pair,
+		MinVolume: decimal.NewFromInt(0),
+		MakerRate: 10,
+		TakerRate: 20,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := store.DeleteFeeTier(context.Background(), feeTier.ID)
+		require.NoError(t, err)
+	})
+
+	buyerQuote := createFundedAccount(t, 10000, util.ETH)
+	buyerBase := createFundedAccount(t, 0, util.SOL)
+	sellerBase := createFundedAccount(t, 1000, util.SOL)
+	sellerQuote := createFundedAccount(t, 0, util.ETH)
+
+	takeProfit := CreateAskParams{
+		Pair:          pair,
+		FromAccountID: buyerBase.ID,
+		ToAccountID:   buyerQuote.ID,
+		Price:         decimal.NewFromInt(12),
+		Amount:        decimal.NewFromInt(1000),
+		Status:        util.INACTIVE,
+		Type:          util.LIMIT,
+		TimeInForce:   util.GTC,
+		GroupLeg:      util.TAKE_PROFIT_LEG,
+	}
+	stopLoss := takeProfit
+	stopLoss.Price = decimal.NewFromInt(8)
+	stopLoss.Type = util.STOP_LIMIT
+	stopLoss.StopPrice = decimal.NewFromInt(9)
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	group, err := store.CreateOrderGroupTx(context.Background(), CreateOrderGroupTxParams{
+		Type: util.BRACKET,
+		Bids: []CreateBidParams{{
+			Pair:          pair,
+			FromAccountID: buyerQuote.ID,
+			ToAccountID:   buyerBase.ID,
+			Price:         decimal.NewFromInt(10),
+			Amount:        decimal.NewFromInt(1000),
+			Status:        util.ACTIVE,
+			Type:          util.LIMIT,
+			TimeInForce:   util.GTC,
+			GroupLeg:      util.ENTRY_LEG,
+		}},
+		Asks: []CreateAskParams{takeProfit, stopLoss},
+	})
+	require.NoError(t, err)
+
+	ask, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          pair,
+		FromAccountID: sellerBase.ID,
+		ToAccountID:   sellerQuote.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(1000),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// the entry only receives 998 after its taker fee, so its exits are activated for what it can sell
+	result, err := store.FillTx(context.Background(), FillTxParams{
+		BidID:     group.Legs.Bids[0].ID,
+		AskID:     ask.Ask.ID,
+		Price:     decimal.NewFromInt(10),
+		Amount:    decimal.NewFromInt(1000),
+		TakerSide: util.BID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(2), result.Trade.SecondFee)
+	require.Empty(t, result.Canceled.Asks)
+	require.Len(t, result.Activated.Asks, 2)
+	for _, exit := range result.Activated.Asks {
+		require.Equal(t, decimal.NewFromInt(998), exit.Amount)
+		require.Equal(t, decimal.NewFromInt(998), exit.RemainingAmount)
+	}
+
+	account, err := store.GetAccount(context.Background(), buyerBase.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(998), account.Balance)
+	require.Equal(t, decimal.NewFromInt(998), account.Held)
+}
+
+func TestAmendBidTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 1000, util.USDT)
+	account2 := createRandomAccount(t, util.BTC)
+
+	created, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	// reducing the amount keeps the queue priority and releases the funds it no longer needs
+	result, err := store.AmendBidTx(context.Background(), AmendBidParams{ID: created.Bid.ID, Price: decimal.NewFromInt(10), Amount: decimal.NewFromInt(40)})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(40), result.Bid.RemainingAmount)
+	require.WithinDuration(t, created.Bid.PriorityAt, result.Bid.PriorityAt, 0)
+	require.Equal(t, decimal.NewFromInt(400), result.FromAccount.Held)
+
+	// a new price loses it
+	result, err = store.AmendBidTx(context.Background(), AmendBidParams{ID: created.Bid.ID, Price: decimal.NewFromInt(20), Amount: decimal.NewFromInt(40)})
+	require.NoError(t, err)
+	require.True(t, result.Bid.PriorityAt.After(created.Bid.PriorityAt))
+	require.Equal(t, decimal.NewFromInt(800), result.FromAccount.Held)
+
+	_, err = store.AmendBidTx(context.Background(), AmendBidParams{ID: created.Bid.ID, Price: decimal.NewFromInt(20), Amount: decimal.NewFromInt(60)})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.CancelBidTx(context.Background(), created.Bid.ID)
+	require.NoError(t, err)
+
+	_, err = store.AmendBidTx(context.Background(), AmendBidParams{ID: created.Bid.ID, Price: decimal.NewFromInt(20), Amount: decimal.NewFromInt(30)})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestAmendAskTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 100, util.BTC)
+	account2 := createRandomAccount(t, util.USDT)
+
+	created, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(60),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+
+	result, err := store.AmendAskTx(context.Background(), AmendAskParams{ID: created.Ask.ID, Price: decimal.NewFromInt(10), Amount: decimal.NewFromInt(80)})
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(80), result.Ask.RemainingAmount)
+	require.True(t, result.Ask.PriorityAt.After(created.Ask.PriorityAt))
+	require.Equal(t, decimal.NewFromInt(80), result.FromAccount.Held)
+
+	_, err = store.AmendAskTx(context.Background(), AmendAskParams{ID: created.Ask.ID, Price: decimal.NewFromInt(10), Amount: decimal.NewFromInt(120)})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestCancelOrdersTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createFundedAccount(t, 1000, util.USDT)
+	account2 := createRandomAccount(t, util.BTC)
+
+	bids := []Bid{}
+	for _, pair := range []string{util.BTC_USDT, util.BTC_USDT, util.USDT_USD} {
+		result, err := store.CreateBidTx(context.Background(), CreateBidParams{
+			Pair:          pair,
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Price:         decimal.NewFromInt(10),
+			Amount:        decimal.NewFromInt(20),
+			Status:        util.ACTIVE,
+		})
+		require.NoError(t, err)
+		bids = append(bids, result.Bid)
+	}
+
+	// the filters keep the bid of the other pair
+	result, err := store.CancelOrdersTx(context.Background(), CancelOrdersTxParams{
+		Owner: account1.Owner,
+		Pair:  util.BTC_USDT,
+		Side:  util.BID,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Bids, 2)
+	require.Empty(t, result.Asks)
+	for i, bid := range result.Bids {
+		require.Equal(t, bids[i].ID, bid.ID)
+		require.Equal(t, util.CANCELED, bid.Status)
+	}
+
+	account, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(200), account.Held)
+
+	// the orders of other owners are never canceled
+	result, err = store.CancelOrdersTx(context.Background(), CancelOrdersTxParams{Owner: account2.Owner})
+	require.NoError(t, err)
+	require.Empty(t, result.Bids)
+
+	result, err = store.CancelOrdersTx(context.Background(), CancelOrdersTxParams{Owner: account1.Owner})
+	require.NoError(t, err)
+	require.Len(t, result.Bids, 1)
+	require.Equal(t, bids[2].ID, result.Bids[0].ID)
+
+	account, err = store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Zero(t, account.Held)
+}
+
+func TestDeadManSwitchTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	now := time.Now()
+
+	armed, err := store.ArmDeadManSwitchTx(context.Background(), ArmDeadManSwitchTxParams{
+		Username:       user.Username,
+		TimeoutSeconds: 30,
+		Now:            now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Username, armed.DeadManSwitch.Username)
+	require.WithinDuration(t, now.Add(30*time.Second), armed.DeadManSwitch.ExpiresAt, time.Millisecond)
+	require.Equal(t, util.ARMED, armed.Event.Action)
+
+	refreshed, err := store.RefreshDeadManSwitchTx(context.Background(), RefreshDeadManSwitchTxParams{
+		Username: user.Username,
+		Now:      now.Add(10 * time.Second),
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, now.Add(40*time.Second), refreshed.DeadManSwitch.ExpiresAt, time.Millisecond)
+	require.Equal(t, util.REFRESHED, refreshed.Event.Action)
+
+	// a heartbeat after the deadline doesn't rescue the orders
+	_, err = store.RefreshDeadManSwitchTx(context.Background(), RefreshDeadManSwitchTxParams{
+		Username: user.Username,
+		Now:      now.Add(time.Minute),
+	})
+	require.ErrorIs(t, err, ErrDeadManSwitchExpired)
+
+	fired, err := store.FireDeadManSwitchTx(context.Background(), FireDeadManSwitchTxParams{
+		DeadManSwitch:  refreshed.DeadManSwitch,
+		CanceledOrders: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.FIRED, fired.Event.Action)
+	require.Equal(t, int64(3), fired.Event.CanceledOrders)
+
+	_, err = store.GetDeadManSwitch(context.Background(), user.Username)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = store.DisarmDeadManSwitchTx(context.Background(), user.Username)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	events, err := store.ListDeadManSwitchEvents(context.Background(), ListDeadManSwitchEventsParams{
+		Username: user.Username,
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, util.FIRED, events[0].Action)
+	require.Equal(t, util.REFRESHED, events[1].Action)
+	require.Equal(t, util.ARMED, events[2].Action)
+}
+
+func TestDisarmDeadManSwitchTx(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	armed, err := store.ArmDeadManSwitchTx(context.Background(), ArmDeadManSwitchTxParams{
+		Username:       user.Username,
+		TimeoutSeconds: 30,
+		Now:            time.Now(),
+	})
+	require.NoError(t, err)
+
+	disarmed, err := store.DisarmDeadManSwitchTx(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, armed.DeadManSwitch.ExpiresAt, disarmed.DeadManSwitch.ExpiresAt)
+	require.Equal(t, util.DISARMED, disarmed.Event.Action)
+
+	_, err = store.RefreshDeadManSwitchTx(context.Background(), RefreshDeadManSwitchTxParams{
+		Username: user.Username,
+		Now:      time.Now(),
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSelfTradeTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	usdtAccount := createFundedAccount(t, 1000, util.USDT)
+	btcAccount := createFundedAccount(t, 100, util.BTC)
+
+	bidResult, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: usdtAccount.ID,
+		ToAccountID:   createRandomAccount(t, util.BTC).ID,
+		Price:         decimal.NewFromInt(10),
+		Amount:        decimal.NewFromInt(5),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+	// new orders belong to the owner of their from account and take the default mode of the owner
+	require.Equal(t, usdtAccount.Owner, bidResult.Bid.Owner)
+	require.Equal(t, util.CANCEL_NEWEST, bidResult.Bid.SelfTradePrevention)
+
+	askResult, err := store.CreateAskTx(context.Background(), CreateAskParams{
+		Pair:                util.BTC_USDT,
+		FromAccountID:       btcAccount.ID,
+		ToAccountID:         createRandomAccount(t, util.USDT).ID,
+		Price:               decimal.NewFromInt(10),
+		Amount:              decimal.NewFromInt(8),
+		Status:              util.ACTIVE,
+		SelfTradePrevention: util.DECREMENT_AND_CANCEL,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DECREMENT_AND_CANCEL, askResult.Ask.SelfTradePrevention)
+
+	result, err := store.SelfTradeTx(context.Background(), SelfTradeTxParams{
+		BidID:     bidResult.Bid.ID,
+		AskID:     askResult.Ask.ID,
+		TakerSide: util.ASK,
+		Mode:      util.DECREMENT_AND_CANCEL,
+	})
+	require.NoError(t, err)
+
+	// the smaller bid is canceled and the ask keeps what is left of it
+	require.Equal(t, util.CANCELED, result.Bid.Status)
+	require.Equal(t, util.ACTIVE, result.Ask.Status)
+	require.Equal(t, decimal.NewFromInt(3), result.Ask.Amount)
+	require.Equal(t, decimal.NewFromInt(3), result.Ask.RemainingAmount)
+	require.Len(t, result.Canceled.Bids, 1)
+	require.Empty(t, result.Canceled.Asks)
+
+	updatedUSDT, err := store.GetAccount(context.Background(), usdtAccount.ID)
+	require.NoError(t, err)
+	require.Zero(t, updatedUSDT.Held)
+
+	updatedBTC, err := store.GetAccount(context.Background(), btcAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(3), updatedBTC.Held)
+
+	require.Len(t, result.Events, 2)
+	require.Equal(t, util.SELF_TRADE_PREVENTED, result.Events[0].Type)
+	require.Equal(t, util.BID, result.Events[0].Side)
+	require.Equal(t, askResult.Ask.ID, result.Events[0].CounterOrderID.Int64)
+	require.Equal(t, decimal.NewFromInt(5), result.Events[0].Amount)
+	require.Equal(t, util.ASK, result.Events[1].Side)
+	require.Equal(t, decimal.NewFromInt(5), result.Events[1].Amount)
+
+	events, err := store.ListOrderEvents(context.Background(), ListOrderEventsParams{
+		Side:    util.ASK,
+		OrderID: askResult.Ask.ID,
+		Limit:   5,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, util.DECREMENT_AND_CANCEL, events[0].SelfTradePrevention)
+
+	// the bid is no longer open
+	_, err = store.SelfTradeTx(context.Background(), SelfTradeTxParams{
+		BidID:     bidResult.Bid.ID,
+		AskID:     askResult.Ask.ID,
+		TakerSide: util.ASK,
+		Mode:      util.CANCEL_OLDEST,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	result, err = store.SelfTradeTx(context.Background(), SelfTradeTxParams{
+		BidID:     createActiveBid(t, store, usdtAccount.ID, 10, 2).ID,
+		AskID:     askResult.Ask.ID,
+		TakerSide: util.BID,
+		Mode:      util.CANCEL_OLDEST,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ACTIVE, result.Bid.Status)
+	require.Equal(t, util.CANCELED, result.Ask.Status)
+	require.Zero(t, result.Events[0].Amount)
+	require.Equal(t, decimal.NewFromInt(3), result.Events[1].Amount)
+}
+
+func createActiveBid(t *testing.T, store Store, fromAccountID int64, price int64, amount int64) Bid {
+	result, err := store.CreateBidTx(context.Background(), CreateBidParams{
+		Pair:          util.BTC_USDT,
+		FromAccountID: fromAccountID,
+		ToAccountID:   createRandomAccount(t, util.BTC).ID,
+		Price:         decimal.NewFromInt(price),
+		Amount:        decimal.NewFromInt(amount),
+		Status:        util.ACTIVE,
+	})
+	require.NoError(t, err)
+	return result.Bid
+}
+
+func TestCreateCurrencyTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	code := strings.ToUpper(util.RandomString(6))
+	result, err := store.CreateCurrencyTx(context.Background(), CreateCurrencyParams{
+		Code:     code,
+		Decimals: 8,
+	})
+	require.NoError(t, err)
+	require.Equal(t, code, result.Currency.Code)
+	require.Equal(t, int64(8), result.Currency.Decimals)
+	require.Equal(t, util.ACTIVE, result.Currency.Status)
+	require.Equal(t, code, result.FeeAccount.Currency)
+
+	account, err := store.GetAccount(context.Background(), result.FeeAccount.AccountID)
+	require.NoError(t, err)
+	require.Equal(t, feeAccountOwner, account.Owner)
+	require.Equal(t, code, account.Currency)
+	require.Zero(t, account.Balance)
+
+	// the code is taken, so neither the currency nor its fee account are created
+	_, err = store.CreateCurrencyTx(context.Background(), CreateCurrencyParams{
+		Code:     code,
+		Decimals: 2,
+	})
+	require.Error(t, err)
+
+	pair, err := store.CreatePair(context.Background(), CreatePairParams{
+		Symbol:   code + "/" + util.USDT,
+		Base:     code,
+		Quote:    util.USDT,
+		TickSize: decimal.NewFromInt(1),
+		LotSize:  decimal.NewFromInt(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ACTIVE, pair.Status)
+
+	pair, err = store.UpdatePair(context.Background(), UpdatePairParams{
+		Symbol: pair.Symbol,
+		Status: sql.NullString{String: util.DELISTED, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DELISTED, pair.Status)
+	require.Equal(t, decimal.NewFromInt(1), pair.TickSize)
+}
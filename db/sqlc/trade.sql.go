@@ -7,21 +7,31 @@ package db
 
 import (
 	"context"
+	"database/sql"
+
+	"go-exchange/decimal"
 )
 
 const createTrade = `-- name: CreateTrade :one
-INSERT INTO trades (first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount) 
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at
+INSERT INTO trades (first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id
 `
 
 type CreateTradeParams struct {
-	FirstFromAccountID  int64 `json:"first_from_account_id"`
-	FirstToAccountID    int64 `json:"first_to_account_id"`
-	FirstAmount         int64 `json:"first_amount"`
-	SecondFromAccountID int64 `json:"second_from_account_id"`
-	SecondToAccountID   int64 `json:"second_to_account_id"`
-	SecondAmount        int64 `json:"second_amount"`
+	FirstFromAccountID  int64               `json:"first_from_account_id"`
+	FirstToAccountID    int64               `json:"first_to_account_id"`
+	FirstAmount         decimal.Decimal     `json:"first_amount"`
+	SecondFromAccountID int64               `json:"second_from_account_id"`
+	SecondToAccountID   int64               `json:"second_to_account_id"`
+	SecondAmount        decimal.Decimal     `json:"second_amount"`
+	FirstFee            decimal.Decimal     `json:"first_fee"`
+	SecondFee           decimal.Decimal     `json:"second_fee"`
+	Pair                sql.NullString      `json:"pair"`
+	Price               decimal.NullDecimal `json:"price"`
+	TakerSide           sql.NullString      `json:"taker_side"`
+	MakerOrderID        sql.NullInt64       `json:"maker_order_id"`
+	TakerOrderID        sql.NullInt64       `json:"taker_order_id"`
 }
 
 func (q *Queries) CreateTrade(ctx context.Context, arg CreateTradeParams) (Trade, error) {
@@ -32,6 +42,13 @@ func (q *Queries) CreateTrade(ctx context.Context, arg CreateTradeParams) (Trade
 		arg.SecondFromAccountID,
 		arg.SecondToAccountID,
 		arg.SecondAmount,
+		arg.FirstFee,
+		arg.SecondFee,
+		arg.Pair,
+		arg.Price,
+		arg.TakerSide,
+		arg.MakerOrderID,
+		arg.TakerOrderID,
 	)
 	var i Trade
 	err := row.Scan(
@@ -43,12 +60,19 @@ func (q *Queries) CreateTrade(ctx context.Context, arg CreateTradeParams) (Trade
 		&i.SecondToAccountID,
 		&i.SecondAmount,
 		&i.CreatedAt,
+		&i.FirstFee,
+		&i.SecondFee,
+		&i.Pair,
+		&i.Price,
+		&i.TakerSide,
+		&i.MakerOrderID,
+		&i.TakerOrderID,
 	)
 	return i, err
 }
 
 const getTrade = `-- name: GetTrade :one
-SELECT id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at FROM trades
+SELECT id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id FROM trades
 WHERE id = $1
 LIMIT 1
 `
@@ -65,12 +89,70 @@ func (q *Queries) GetTrade(ctx context.Context, id int64) (Trade, error) {
 		&i.SecondToAccountID,
 		&i.SecondAmount,
 		&i.CreatedAt,
+		&i.FirstFee,
+		&i.SecondFee,
+		&i.Pair,
+		&i.Price,
+		&i.TakerSide,
+		&i.MakerOrderID,
+		&i.TakerOrderID,
 	)
 	return i, err
 }
 
+const listPairTrades = `-- name: ListPairTrades :many
+SELECT id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id FROM trades
+WHERE pair = $1::varchar AND price IS NOT NULL
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type ListPairTradesParams struct {
+	Pair  string `json:"pair"`
+	Limit int32  `json:"limit"`
+}
+
+func (q *Queries) ListPairTrades(ctx context.Context, arg ListPairTradesParams) ([]Trade, error) {
+	rows, err := q.db.QueryContext(ctx, listPairTrades, arg.Pair, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Trade{}
+	for rows.Next() {
+		var i Trade
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstFromAccountID,
+			&i.FirstToAccountID,
+			&i.FirstAmount,
+			&i.SecondFromAccountID,
+			&i.SecondToAccountID,
+			&i.SecondAmount,
+			&i.CreatedAt,
+			&i.FirstFee,
+			&i.SecondFee,
+			&i.Pair,
+			&i.Price,
+			&i.TakerSide,
+			&i.MakerOrderID,
+			&i.TakerOrderID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTrades = `-- name: ListTrades :many
-SELECT id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at FROM trades
+SELECT id, first_from_account_id, first_to_account_id, first_amount, second_from_account_id, second_to_account_id, second_amount, created_at, first_fee, second_fee, pair, price, taker_side, maker_order_id, taker_order_id FROM trades
 WHERE first_from_account_id = $1 OR first_to_account_id = $2 OR second_from_account_id = $3 OR second_to_account_id = $4
 ORDER BY id
 LIMIT $5
@@ -111,6 +193,13 @@ func (q *Queries) ListTrades(ctx context.Context, arg ListTradesParams) ([]Trade
 			&i.SecondToAccountID,
 			&i.SecondAmount,
 			&i.CreatedAt,
+			&i.FirstFee,
+			&i.SecondFee,
+			&i.Pair,
+			&i.Price,
+			&i.TakerSide,
+			&i.MakerOrderID,
+			&i.TakerOrderID,
 		); err != nil {
 			return nil, err
 		}
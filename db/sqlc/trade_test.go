@@ -2,6 +2,9 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"go-exchange/decimal"
+	"go-exchange/util"
 	"testing"
 	"time"
 
@@ -95,3 +98,43 @@ func TestListTrades(t *testing.T) {
 			arg.FirstFromAccountID)
 	}
 }
+
+func TestListPairTrades(t *testing.T) {
+	pair := createCandlePair(t)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	var trades []Trade
+	for i := 0; i < 5; i++ {
+		trade, err := testQueries.CreateTrade(context.Background(), CreateTradeParams{
+			FirstFromAccountID:  account1.ID,
+			FirstToAccountID:    account2.ID,
+			FirstAmount:         decimal.NewFromInt(10),
+			SecondFromAccountID: account2.ID,
+			SecondToAccountID:   account1.ID,
+			SecondAmount:        decimal.NewFromInt(1),
+			Pair:                sql.NullString{String: pair, Valid: true},
+			Price:               decimal.NullDecimal{Decimal: decimal.NewFromInt(10), Valid: true},
+			TakerSide:           sql.NullString{String: util.BID, Valid: true},
+		})
+		require.NoError(t, err)
+		trades = append(trades, trade)
+	}
+
+	// trades made outside the order book aren't on the tape
+	createRandomTrade(t)
+
+	tape, err := testQueries.ListPairTrades(context.Background(), ListPairTradesParams{
+		Pair:  pair,
+		Limit: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, tape, 3)
+
+	// newest first
+	for i, trade := range tape {
+		require.Equal(t, trades[len(trades)-1-i].ID, trade.ID)
+		require.Equal(t, pair, trade.Pair.String)
+		require.Equal(t, util.BID, trade.TakerSide.String)
+	}
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"go-exchange/util"
+)
+
+// CreateAskTxResult is the result of the create ask transaction
+type CreateAskTxResult struct {
+	Ask         Ask     `json:"ask"`
+	FromAccount Account `json:"from_account"`
+}
+
+// CreateAskTx creates an ask and holds the amount in its from account within a database transaction.
+// It fails with ErrInsufficientFunds if the available balance can't cover it
+func (store *SQLStore) CreateAskTx(ctx context.Context, arg CreateAskParams) (CreateAskTxResult, error) {
+	var result CreateAskTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Ask, err = q.CreateAsk(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.FromAccount, err = holdMoney(ctx, q, arg.FromAccountID, arg.Amount)
+		return err
+	})
+
+	return result, err
+}
+
+// AmendAskTxResult is the result of the amend ask transaction
+type AmendAskTxResult struct {
+	Ask         Ask     `json:"ask"`
+	FromAccount Account `json:"from_account"`
+}
+
+// AmendAskTx changes the price and amount of an open ask and adjusts the funds held for its remaining amount
+// within a database transaction. The ask loses its queue priority if its price changes or its amount increases.
+// It fails with sql.ErrNoRows if the ask is no longer open, belongs to an order group or already filled the new amount,
+// and with ErrInsufficientFunds if the available balance can't cover the increase
+func (store *SQLStore) AmendAskTx(ctx context.Context, arg AmendAskParams) (AmendAskTxResult, error) {
+	var result AmendAskTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		old, err := q.GetAskForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Ask, err = q.AmendAsk(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		hold := result.Ask.RemainingAmount.Sub(old.RemainingAmount)
+		result.FromAccount, err = holdMoney(ctx, q, result.Ask.FromAccountID, hold)
+		return err
+	})
+
+	return result, err
+}
+
+// CancelAskTxResult is the result of the cancel and expire ask transactions
+type CancelAskTxResult struct {
+	Ask         Ask            `json:"ask"`
+	FromAccount Account        `json:"from_account"`
+	Canceled    OrderGroupLegs `json:"canceled"`
+}
+
+// CancelAskTx cancels an open ask and releases the funds still held for its remaining amount within a database transaction.
+// The legs of its order group that depend on it are canceled with it.
+// It fails with sql.ErrNoRows if the ask is no longer open
+func (store *SQLStore) CancelAskTx(ctx context.Context, id int64) (CancelAskTxResult, error) {
+	return store.closeAskTx(ctx, id, util.CANCELED)
+}
+
+// ExpireAskTx expires an open ask and releases the funds still held for its remaining amount within a database transaction.
+// It fails with sql.ErrNoRows if the ask is no longer open
+func (store *SQLStore) ExpireAskTx(ctx context.Context, id int64) (CancelAskTxResult, error) {
+	return store.closeAskTx(ctx, id, util.EXPIRED)
+}
+
+func (store *SQLStore) closeAskTx(ctx context.Context, id int64, status string) (CancelAskTxResult, error) {
+	var result CancelAskTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Ask, err = q.CloseAsk(ctx, CloseAskParams{
+			ID:     id,
+			Status: status,
+		})
+		if err != nil {
+			return err
+		}
+
+		release := result.Ask.RemainingAmount
+		if result.Ask.GroupID.Valid {
+			release, err = closeGroupLeg(ctx, q, askLeg(result.Ask), &result.Canceled)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.FromAccount, err = holdMoney(ctx, q, result.Ask.FromAccountID, release.Neg())
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"go-exchange/util"
+)
+
+// CreateBidTxResult is the result of the create bid transaction
+type CreateBidTxResult struct {
+	Bid         Bid     `json:"bid"`
+	FromAccount Account `json:"from_account"`
+}
+
+// CreateBidTx creates a bid and holds price*amount in its from account within a database transaction.
+// It fails with ErrInsufficientFunds if the available balance can't cover it
+func (store *SQLStore) CreateBidTx(ctx context.Context, arg CreateBidParams) (CreateBidTxResult, error) {
+	var result CreateBidTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Bid, err = q.CreateBid(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.FromAccount, err = holdMoney(ctx, q, arg.FromAccountID, arg.Price.Mul(arg.Amount))
+		return err
+	})
+
+	return result, err
+}
+
+// AmendBidTxResult is the result of the amend bid transaction
+type AmendBidTxResult struct {
+	Bid         Bid     `json:"bid"`
+	FromAccount Account `json:"from_account"`
+}
+
+// AmendBidTx changes the price and amount of an open bid and adjusts the funds held for its remaining amount
+// within a database transaction. The bid loses its queue priority if its price changes or its amount increases.
+// It fails with sql.ErrNoRows if the bid is no longer open, belongs to an order group or already filled the new amount,
+// and with ErrInsufficientFunds if the available balance can't cover the increase
+func (store *SQLStore) AmendBidTx(ctx context.Context, arg AmendBidParams) (AmendBidTxResult, error) {
+	var result AmendBidTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		old, err := q.GetBidForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Bid, err = q.AmendBid(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		hold := result.Bid.Price.Mul(result.Bid.RemainingAmount).Sub(old.Price.Mul(old.RemainingAmount))
+		result.FromAccount, err = holdMoney(ctx, q, result.Bid.FromAccountID, hold)
+		return err
+	})
+
+	return result, err
+}
+
+// CancelBidTxResult is the result of the cancel and expire bid transactions
+type CancelBidTxResult struct {
+	Bid         Bid            `json:"bid"`
+	FromAccount Account        `json:"from_account"`
+	Canceled    OrderGroupLegs `json:"canceled"`
+}
+
+// CancelBidTx cancels an open bid and releases the funds still held for its remaining amount within a database transaction.
+// The legs of its order group that depend on it are canceled with it.
+// It fails with sql.ErrNoRows if the bid is no longer open
+func (store *SQLStore) CancelBidTx(ctx context.Context, id int64) (CancelBidTxResult, error) {
+	return store.closeBidTx(ctx, id, util.CANCELED)
+}
+
+// ExpireBidTx expires an open bid and releases the funds still held for its remaining amount within a database transaction.
+// It fails with sql.ErrNoRows if the bid is no longer open
+func (store *SQLStore) ExpireBidTx(ctx context.Context, id int64) (CancelBidTxResult, error) {
+	return store.closeBidTx(ctx, id, util.EXPIRED)
+}
+
+func (store *SQLStore) closeBidTx(ctx context.Context, id int64, status string) (CancelBidTxResult, error) {
+	var result CancelBidTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Bid, err = q.CloseBid(ctx, CloseBidParams{
+			ID:     id,
+			Status: status,
+		})
+		if err != nil {
+			return err
+		}
+
+		release := result.Bid.Price.Mul(result.Bid.RemainingAmount)
+		if result.Bid.GroupID.Valid {
+			release, err = closeGroupLeg(ctx, q, bidLeg(result.Bid), &result.Canceled)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.FromAccount, err = holdMoney(ctx, q, result.Bid.FromAccountID, release.Neg())
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// CancelOrdersTxParams contains the input parameters of the cancel orders transaction.
+// Empty filters match every open order of the owner, and an empty owner matches the orders of every owner
+type CancelOrdersTxParams struct {
+	Owner     string `json:"owner"`
+	Pair      string `json:"pair"`
+	Side      string `json:"side"`
+	AccountID int64  `json:"account_id"`
+}
+
+// CancelOrdersTxResult is the result of the cancel orders transaction
+type CancelOrdersTxResult struct {
+	Bids []Bid `json:"bids"`
+	Asks []Ask `json:"asks"`
+}
+
+// CancelOrdersTx cancels every open order that matches the filters and releases their funds
+// within a single database transaction. The legs of order groups that depend on them are canceled with them
+func (store *SQLStore) CancelOrdersTx(ctx context.Context, arg CancelOrdersTxParams) (CancelOrdersTxResult, error) {
+	var result CancelOrdersTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		legs := []groupLeg{}
+
+		if arg.Side != util.ASK {
+			bids, err := q.ListOpenBidsByOwner(ctx, ListOpenBidsByOwnerParams{
+				Owner:     sql.NullString{String: arg.Owner, Valid: arg.Owner != ""},
+				Pair:      sql.NullString{String: arg.Pair, Valid: arg.Pair != ""},
+				AccountID: sql.NullInt64{Int64: arg.AccountID, Valid: arg.AccountID != 0},
+			})
+			if err != nil {
+				return err
+			}
+			for _, bid := range bids {
+				legs = append(legs, bidLeg(bid))
+			}
+		}
+
+		if arg.Side != util.BID {
+			asks, err := q.ListOpenAsksByOwner(ctx, ListOpenAsksByOwnerParams{
+				Owner:     sql.NullString{String: arg.Owner, Valid: arg.Owner != ""},
+				Pair:      sql.NullString{String: arg.Pair, Valid: arg.Pair != ""},
+				AccountID: sql.NullInt64{Int64: arg.AccountID, Valid: arg.AccountID != 0},
+			})
+			if err != nil {
+				return err
+			}
+			for _, ask := range asks {
+				legs = append(legs, askLeg(ask))
+			}
+		}
+
+		var canceled OrderGroupLegs
+		releases := map[int64]decimal.Decimal{}
+
+		for _, leg := range legs {
+			// canceling an order group may have canceled the order already
+			ok, err := cancelLeg(ctx, q, leg, &canceled)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			release := leg.hold
+			if leg.groupID != 0 {
+				release, err = closeGroupLeg(ctx, q, leg, &canceled)
+				if err != nil {
+					return err
+				}
+			}
+			releases[leg.fromAccountID] = releases[leg.fromAccountID].Add(release)
+		}
+
+		for _, accountID := range sortedAccountIDs(releases) {
+			if _, err := holdMoney(ctx, q, accountID, releases[accountID].Neg()); err != nil {
+				return err
+			}
+		}
+
+		result.Bids = canceled.Bids
+		result.Asks = canceled.Asks
+		return nil
+	})
+
+	return result, err
+}
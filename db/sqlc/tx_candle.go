@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"go-exchange/util"
+	"time"
+)
+
+// BackfillCandlesTxParams contains the input parameters of the backfill candles transaction
+type BackfillCandlesTxParams struct {
+	Pair     string    `json:"pair"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+}
+
+// BackfillCandlesTxResult is the result of the backfill candles transaction
+type BackfillCandlesTxResult struct {
+	Candles map[string]int64 `json:"candles"`
+}
+
+// BackfillCandlesTx rebuilds the candles of every interval of a pair from the trades executed between two times.
+// The range is widened to whole intervals, so the candles at both ends are complete.
+// It returns the number of candles written for each interval
+func (store *SQLStore) BackfillCandlesTx(ctx context.Context, arg BackfillCandlesTxParams) (BackfillCandlesTxResult, error) {
+	result := BackfillCandlesTxResult{
+		Candles: make(map[string]int64, len(util.CandleIntervals)),
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for _, interval := range util.CandleIntervals {
+			duration := util.CandleIntervalDuration(interval)
+
+			fromTime := arg.FromTime.Truncate(duration)
+			toTime := arg.ToTime.Truncate(duration)
+			if toTime.Before(arg.ToTime) {
+				toTime = toTime.Add(duration)
+			}
+
+			count, err := q.BackfillCandles(ctx, BackfillCandlesParams{
+				Interval: interval,
+				Seconds:  int64(duration / time.Second),
+				Pair:     arg.Pair,
+				FromTime: fromTime,
+				ToTime:   toTime,
+			})
+			if err != nil {
+				return err
+			}
+			result.Candles[interval] = count
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// updateCandles adds a trade made on the order book to the candle of every interval it falls in
+func updateCandles(ctx context.Context, q *Queries, trade Trade) error {
+	for _, interval := range util.CandleIntervals {
+		_, err := q.UpsertCandle(ctx, UpsertCandleParams{
+			Pair:        trade.Pair.String,
+			Interval:    interval,
+			OpenTime:    trade.CreatedAt.Truncate(util.CandleIntervalDuration(interval)),
+			Price:       trade.Price.Decimal,
+			Volume:      trade.SecondAmount,
+			QuoteVolume: trade.FirstAmount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
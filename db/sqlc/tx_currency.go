@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"go-exchange/decimal"
+)
+
+// feeAccountOwner is the exchange user that owns the fee account of every currency
+const feeAccountOwner = "exchange"
+
+// CreateCurrencyTxResult is the result of the create currency transaction
+type CreateCurrencyTxResult struct {
+	Currency   Currency   `json:"currency"`
+	FeeAccount FeeAccount `json:"fee_account"`
+}
+
+// CreateCurrencyTx lists a new currency and opens the exchange account its fees are collected into
+// within a single database transaction
+func (store *SQLStore) CreateCurrencyTx(ctx context.Context, arg CreateCurrencyParams) (CreateCurrencyTxResult, error) {
+	var result CreateCurrencyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Currency, err = q.CreateCurrency(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		account, err := q.CreateAccount(ctx, CreateAccountParams{
+			Owner:    feeAccountOwner,
+			Balance:  decimal.Zero,
+			Currency: result.Currency.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.FeeAccount, err = q.CreateFeeAccount(ctx, CreateFeeAccountParams{
+			Currency:  result.Currency.Code,
+			AccountID: account.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-exchange/util"
+	"time"
+)
+
+// ErrDeadManSwitchExpired is returned when a heartbeat arrives after the deadline of a dead man's switch
+var ErrDeadManSwitchExpired = errors.New("dead man's switch has expired")
+
+// ArmDeadManSwitchTxParams contains the input parameters of the arm dead man's switch transaction
+type ArmDeadManSwitchTxParams struct {
+	Username       string    `json:"username"`
+	TimeoutSeconds int64     `json:"timeout_seconds"`
+	Now            time.Time `json:"now"`
+}
+
+// RefreshDeadManSwitchTxParams contains the input parameters of the refresh dead man's switch transaction
+type RefreshDeadManSwitchTxParams struct {
+	Username string    `json:"username"`
+	Now      time.Time `json:"now"`
+}
+
+// FireDeadManSwitchTxParams contains the input parameters of the fire dead man's switch transaction
+type FireDeadManSwitchTxParams struct {
+	DeadManSwitch  DeadManSwitch `json:"dead_man_switch"`
+	CanceledOrders int64         `json:"canceled_orders"`
+}
+
+// DeadManSwitchTxResult is the result of the dead man's switch transactions
+type DeadManSwitchTxResult struct {
+	DeadManSwitch DeadManSwitch      `json:"dead_man_switch"`
+	Event         DeadManSwitchEvent `json:"event"`
+}
+
+// ArmDeadManSwitchTx arms the dead man's switch of a user, or re-arms it with a new timeout,
+// and audits it within a database transaction
+func (store *SQLStore) ArmDeadManSwitchTx(ctx context.Context, arg ArmDeadManSwitchTxParams) (DeadManSwitchTxResult, error) {
+	var result DeadManSwitchTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.DeadManSwitch, err = q.UpsertDeadManSwitch(ctx, UpsertDeadManSwitchParams{
+			Username:       arg.Username,
+			TimeoutSeconds: arg.TimeoutSeconds,
+			ExpiresAt:      deadManSwitchDeadline(arg.Now, arg.TimeoutSeconds),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Event, err = auditDeadManSwitch(ctx, q, result.DeadManSwitch, util.ARMED, 0)
+		return err
+	})
+
+	return result, err
+}
+
+// RefreshDeadManSwitchTx pushes the deadline of the dead man's switch of a user by its timeout
+// and audits it within a database transaction.
+// It fails with sql.ErrNoRows if the switch isn't armed and with ErrDeadManSwitchExpired if its deadline has passed
+func (store *SQLStore) RefreshDeadManSwitchTx(ctx context.Context, arg RefreshDeadManSwitchTxParams) (DeadManSwitchTxResult, error) {
+	var result DeadManSwitchTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		deadManSwitch, err := q.GetDeadManSwitchForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+		if !deadManSwitch.ExpiresAt.After(arg.Now) {
+			return ErrDeadManSwitchExpired
+		}
+
+		result.DeadManSwitch, err = q.RefreshDeadManSwitch(ctx, RefreshDeadManSwitchParams{
+			Username:  arg.Username,
+			ExpiresAt: deadManSwitchDeadline(arg.Now, deadManSwitch.TimeoutSeconds),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Event, err = auditDeadManSwitch(ctx, q, result.DeadManSwitch, util.REFRESHED, 0)
+		return err
+	})
+
+	return result, err
+}
+
+// DisarmDeadManSwitchTx removes the dead man's switch of a user and audits it within a database transaction.
+// It fails with sql.ErrNoRows if the switch isn't armed
+func (store *SQLStore) DisarmDeadManSwitchTx(ctx context.Context, username string) (DeadManSwitchTxResult, error) {
+	var result DeadManSwitchTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.DeadManSwitch, err = q.GetDeadManSwitchForUpdate(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteDeadManSwitch(ctx, username)
+		if err != nil {
+			return err
+		}
+
+		result.Event, err = auditDeadManSwitch(ctx, q, result.DeadManSwitch, util.DISARMED, 0)
+		return err
+	})
+
+	return result, err
+}
+
+// FireDeadManSwitchTx audits a dead man's switch that fired after the open orders of its user were canceled
+// and removes it within a database transaction. A switch that was re-armed in the meantime is kept
+func (store *SQLStore) FireDeadManSwitchTx(ctx context.Context, arg FireDeadManSwitchTxParams) (DeadManSwitchTxResult, error) {
+	var result DeadManSwitchTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.DeadManSwitch = arg.DeadManSwitch
+
+		deadManSwitch, err := q.GetDeadManSwitchForUpdate(ctx, arg.DeadManSwitch.Username)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && deadManSwitch.ExpiresAt.Equal(arg.DeadManSwitch.ExpiresAt) {
+			err = q.DeleteDeadManSwitch(ctx, arg.DeadManSwitch.Username)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Event, err = auditDeadManSwitch(ctx, q, arg.DeadManSwitch, util.FIRED, arg.CanceledOrders)
+		return err
+	})
+
+	return result, err
+}
+
+// deadManSwitchDeadline returns the time a switch armed with the timeout at now fires
+func deadManSwitchDeadline(now time.Time, timeoutSeconds int64) time.Time {
+	return now.Add(time.Duration(timeoutSeconds) * time.Second)
+}
+
+// auditDeadManSwitch records an action taken on a dead man's switch
+func auditDeadManSwitch(ctx context.Context, q *Queries, deadManSwitch DeadManSwitch, action string, canceledOrders int64) (DeadManSwitchEvent, error) {
+	return q.CreateDeadManSwitchEvent(ctx, CreateDeadManSwitchEventParams{
+		Username:       deadManSwitch.Username,
+		Action:         action,
+		TimeoutSeconds: deadManSwitch.TimeoutSeconds,
+		ExpiresAt:      deadManSwitch.ExpiresAt,
+		CanceledOrders: canceledOrders,
+	})
+}
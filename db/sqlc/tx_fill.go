@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// FillTxParams contains the input parameters of the fill transaction
+type FillTxParams struct {
+	BidID     int64           `json:"bid_id"`
+	AskID     int64           `json:"ask_id"`
+	Price     decimal.Decimal `json:"price"`
+	Amount    decimal.Decimal `json:"amount"`
+	TakerSide string          `json:"taker_side"`
+}
+
+// FillTxResult is the result of the fill transaction
+type FillTxResult struct {
+	Fill      Fill           `json:"fill"`
+	Trade     Trade          `json:"trade"`
+	Bid       Bid            `json:"bid"`
+	Ask       Ask            `json:"ask"`
+	Canceled  OrderGroupLegs `json:"canceled"`
+	Activated OrderGroupLegs `json:"activated"`
+}
+
+// FillTx executes amount of a bid against an ask at price.
+// It settles the trade with the funds held by both orders, records the fill
+// and updates the filled amount of both orders and the candles of the pair within a database transaction.
+// Each side pays the maker or taker fee of its volume tier out of what it receives.
+// Orders of an order group also cancel or activate the other legs of their group
+func (store *SQLStore) FillTx(ctx context.Context, arg FillTxParams) (FillTxResult, error) {
+	var result FillTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		bid, err := q.GetBid(ctx, arg.BidID)
+		if err != nil {
+			return err
+		}
+
+		ask, err := q.GetAsk(ctx, arg.AskID)
+		if err != nil {
+			return err
+		}
+
+		// the bid pays price*amount of the quote currency and receives amount of the base currency
+		base, quote := util.CurrenciesFromPair(bid.Pair)
+
+		bidFee, bidFeeAccountID, err := orderFee(ctx, q, bid.Pair, bid.Owner, arg.TakerSide == util.BID, base, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		value := arg.Price.Mul(arg.Amount)
+		askFee, askFeeAccountID, err := orderFee(ctx, q, ask.Pair, ask.Owner, arg.TakerSide == util.ASK, quote, value)
+		if err != nil {
+			return err
+		}
+
+		var makerOrderID, takerOrderID int64
+		switch arg.TakerSide {
+		case util.BID:
+			makerOrderID, takerOrderID = ask.ID, bid.ID
+		case util.ASK:
+			makerOrderID, takerOrderID = bid.ID, ask.ID
+		}
+
+		tradeResult, err := trade(ctx, q, TradeTxParams{
+			FirstFromAccountID:  bid.FromAccountID,
+			FirstToAccountID:    ask.ToAccountID,
+			FirstAmount:         value,
+			SecondFromAccountID: ask.FromAccountID,
+			SecondToAccountID:   bid.ToAccountID,
+			SecondAmount:        arg.Amount,
+			FirstReleased:       bid.Price.Mul(arg.Amount),
+			SecondReleased:      arg.Amount,
+			FirstFee:            askFee,
+			FirstFeeAccountID:   askFeeAccountID,
+			SecondFee:           bidFee,
+			SecondFeeAccountID:  bidFeeAccountID,
+			Pair:                bid.Pair,
+			Price:               decimal.NullDecimal{Decimal: arg.Price, Valid: true},
+			TakerSide:           arg.TakerSide,
+			MakerOrderID:        makerOrderID,
+			TakerOrderID:        takerOrderID,
+		})
+		if err != nil {
+			return err
+		}
+		result.Trade = tradeResult.Trade
+
+		err = updateCandles(ctx, q, result.Trade)
+		if err != nil {
+			return err
+		}
+
+		result.Fill, err = q.CreateFill(ctx, CreateFillParams{
+			TradeID: result.Trade.ID,
+			BidID:   arg.BidID,
+			AskID:   arg.AskID,
+			Price:   arg.Price,
+			Amount:  arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Bid, err = q.FillBid(ctx, FillBidParams{
+			ID:     arg.BidID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Ask, err = q.FillAsk(ctx, FillAskParams{
+			ID:     arg.AskID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.Bid.GroupID.Valid {
+			err = fillGroupLeg(ctx, q, bidLeg(result.Bid), &result.Canceled, &result.Activated)
+			if err != nil {
+				return err
+			}
+		}
+
+		if result.Ask.GroupID.Valid {
+			err = fillGroupLeg(ctx, q, askLeg(result.Ask), &result.Canceled, &result.Activated)
+		}
+		return err
+	})
+
+	return result, err
+}
+
+// orderFee returns the fee owner pays on amount of currency received from a fill on pair,
+// and the fee account of the currency it goes to.
+// The rate is the maker or taker rate of the highest fee tier reached by the trailing 30-day volume of owner on pair.
+// Fees are rounded down to the decimals of the currency, so they never exceed the rate.
+// Pairs without a fee schedule trade for free
+func orderFee(ctx context.Context, q *Queries, pair string, owner string, taker bool, currency string, amount decimal.Decimal) (decimal.Decimal, int64, error) {
+	volume, err := q.GetTradedVolume(ctx, GetTradedVolumeParams{
+		Pair:  pair,
+		Owner: owner,
+	})
+	if err != nil {
+		return decimal.Zero, 0, err
+	}
+
+	tier, err := q.GetFeeTier(ctx, GetFeeTierParams{
+		Pair:   pair,
+		Volume: volume,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return decimal.Zero, 0, nil
+	}
+	if err != nil {
+		return decimal.Zero, 0, err
+	}
+
+	rate := tier.MakerRate
+	if taker {
+		rate = tier.TakerRate
+	}
+
+	listed, err := q.GetCurrency(ctx, currency)
+	if err != nil {
+		return decimal.Zero, 0, err
+	}
+
+	fee := amount.Mul(decimal.NewFromInt(rate)).Div(decimal.NewFromInt(10000), int32(listed.Decimals), decimal.RoundDown)
+	if !fee.IsPositive() {
+		return decimal.Zero, 0, nil
+	}
+
+	feeAccount, err := q.GetFeeAccount(ctx, currency)
+	if err != nil {
+		return decimal.Zero, 0, err
+	}
+	return fee, feeAccount.AccountID, nil
+}
@@ -0,0 +1,461 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"sort"
+)
+
+// OrderGroupLegs are bids and asks of an order group
+type OrderGroupLegs struct {
+	Bids []Bid `json:"bids"`
+	Asks []Ask `json:"asks"`
+}
+
+// CreateOrderGroupTxParams contains the input parameters of the create order group transaction
+type CreateOrderGroupTxParams struct {
+	Type string            `json:"type"`
+	Bids []CreateBidParams `json:"bids"`
+	Asks []CreateAskParams `json:"asks"`
+}
+
+// CreateOrderGroupTxResult is the result of the create order group transaction
+type CreateOrderGroupTxResult struct {
+	OrderGroup OrderGroup     `json:"order_group"`
+	Legs       OrderGroupLegs `json:"legs"`
+}
+
+// CreateOrderGroupTx creates an order group with all its legs and holds their funds within a database transaction.
+// The take profit and stop loss legs cancel each other, so they share the hold of the larger one.
+// The legs of a bracket only hold funds once its entry is filled.
+// It fails with ErrInsufficientFunds if the available balance can't cover it
+func (store *SQLStore) CreateOrderGroupTx(ctx context.Context, arg CreateOrderGroupTxParams) (CreateOrderGroupTxResult, error) {
+	var result CreateOrderGroupTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.OrderGroup, err = q.CreateOrderGroup(ctx, arg.Type)
+		if err != nil {
+			return err
+		}
+		groupID := sql.NullInt64{Int64: result.OrderGroup.ID, Valid: true}
+
+		for _, bidArg := range arg.Bids {
+			bidArg.GroupID = groupID
+			bid, err := q.CreateBid(ctx, bidArg)
+			if err != nil {
+				return err
+			}
+			result.Legs.Bids = append(result.Legs.Bids, bid)
+		}
+
+		for _, askArg := range arg.Asks {
+			askArg.GroupID = groupID
+			ask, err := q.CreateAsk(ctx, askArg)
+			if err != nil {
+				return err
+			}
+			result.Legs.Asks = append(result.Legs.Asks, ask)
+		}
+
+		legs := groupLegs(result.Legs)
+		holds := groupHolds(legs, findLeg(legs, util.ENTRY_LEG) == nil)
+		for _, accountID := range sortedAccountIDs(holds) {
+			_, err = holdMoney(ctx, q, accountID, holds[accountID])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// CancelOrderGroupTxResult is the result of the cancel order group transaction
+type CancelOrderGroupTxResult struct {
+	OrderGroup OrderGroup     `json:"order_group"`
+	Canceled   OrderGroupLegs `json:"canceled"`
+}
+
+// CancelOrderGroupTx cancels every open leg of an order group and releases their funds within a database transaction
+func (store *SQLStore) CancelOrderGroupTx(ctx context.Context, id int64) (CancelOrderGroupTxResult, error) {
+	var result CancelOrderGroupTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.OrderGroup, err = q.GetOrderGroup(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		legs, err := listGroupLegs(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		activated := legsActivated(legs)
+
+		closed := []groupLeg{}
+		for _, leg := range legs {
+			if !util.IsOpenStatus(leg.status) {
+				continue
+			}
+
+			ok, err := cancelLeg(ctx, q, leg, &result.Canceled)
+			if err != nil {
+				return err
+			}
+			if ok {
+				closed = append(closed, leg)
+			}
+		}
+
+		holds := groupHolds(closed, activated)
+		for _, accountID := range sortedAccountIDs(holds) {
+			_, err = holdMoney(ctx, q, accountID, holds[accountID].Neg())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// groupLeg is a bid or an ask of an order group with the funds it holds
+type groupLeg struct {
+	side          string
+	id            int64
+	groupID       int64
+	fromAccountID int64
+	orderType     string
+	status        string
+	leg           string
+	hold          decimal.Decimal // funds held for the remaining amount
+	fullHold      decimal.Decimal // funds held for the whole amount
+}
+
+func bidLeg(bid Bid) groupLeg {
+	return groupLeg{
+		side:          util.BID,
+		id:            bid.ID,
+		groupID:       bid.GroupID.Int64,
+		fromAccountID: bid.FromAccountID,
+		orderType:     bid.Type,
+		status:        bid.Status,
+		leg:           bid.GroupLeg,
+		hold:          bid.Price.Mul(bid.RemainingAmount),
+		fullHold:      bid.Price.Mul(bid.Amount),
+	}
+}
+
+func askLeg(ask Ask) groupLeg {
+	return groupLeg{
+		side:          util.ASK,
+		id:            ask.ID,
+		groupID:       ask.GroupID.Int64,
+		fromAccountID: ask.FromAccountID,
+		orderType:     ask.Type,
+		status:        ask.Status,
+		leg:           ask.GroupLeg,
+		hold:          ask.RemainingAmount,
+		fullHold:      ask.Amount,
+	}
+}
+
+func groupLegs(legs OrderGroupLegs) []groupLeg {
+	result := []groupLeg{}
+	for _, bid := range legs.Bids {
+		result = append(result, bidLeg(bid))
+	}
+	for _, ask := range legs.Asks {
+		result = append(result, askLeg(ask))
+	}
+	return result
+}
+
+func listGroupLegs(ctx context.Context, q *Queries, groupID int64) ([]groupLeg, error) {
+	var legs OrderGroupLegs
+	var err error
+
+	legs.Bids, err = q.ListBidsByGroup(ctx, sql.NullInt64{Int64: groupID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	legs.Asks, err = q.ListAsksByGroup(ctx, sql.NullInt64{Int64: groupID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupLegs(legs), nil
+}
+
+func findLeg(legs []groupLeg, name string) *groupLeg {
+	for i := range legs {
+		if legs[i].leg == name {
+			return &legs[i]
+		}
+	}
+	return nil
+}
+
+// legsActivated returns true if the take profit and stop loss legs of a group are activated.
+// They are activated right away unless the group has an entry, which must be filled first
+func legsActivated(legs []groupLeg) bool {
+	entry := findLeg(legs, util.ENTRY_LEG)
+	return entry == nil || entry.status == util.COMPLETED
+}
+
+// groupHolds returns the funds held by the legs for every account
+func groupHolds(legs []groupLeg, activated bool) map[int64]decimal.Decimal {
+	holds := map[int64]decimal.Decimal{}
+	contingent := map[int64]decimal.Decimal{}
+
+	for _, leg := range legs {
+		if !util.IsContingentLeg(leg.leg) {
+			holds[leg.fromAccountID] = holds[leg.fromAccountID].Add(leg.hold)
+			continue
+		}
+
+		if activated && leg.hold.GreaterThan(contingent[leg.fromAccountID]) {
+			contingent[leg.fromAccountID] = leg.hold
+		}
+	}
+
+	for accountID, hold := range contingent {
+		holds[accountID] = holds[accountID].Add(hold)
+	}
+	return holds
+}
+
+// sortedAccountIDs returns the accounts of the holds in ID order, so they are always updated in the same order
+func sortedAccountIDs(holds map[int64]decimal.Decimal) []int64 {
+	ids := make([]int64, 0, len(holds))
+	for id, hold := range holds {
+		if !hold.IsZero() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// cancelLeg cancels a leg and adds it to the canceled legs.
+// It returns false if the leg was already closed
+func cancelLeg(ctx context.Context, q *Queries, leg groupLeg, canceled *OrderGroupLegs) (bool, error) {
+	var err error
+	if leg.side == util.BID {
+		var bid Bid
+		bid, err = q.CloseBid(ctx, CloseBidParams{ID: leg.id, Status: util.CANCELED})
+		if err == nil {
+			canceled.Bids = append(canceled.Bids, bid)
+		}
+	} else {
+		var ask Ask
+		ask, err = q.CloseAsk(ctx, CloseAskParams{ID: leg.id, Status: util.CANCELED})
+		if err == nil {
+			canceled.Asks = append(canceled.Asks, ask)
+		}
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// closeGroupLeg cancels the legs that depend on a leg that was just canceled or expired.
+// Closing the entry cancels the take profit and stop loss, which never activated,
+// and closing one of them cancels the other. It returns the funds to release from the from account of the closed leg
+func closeGroupLeg(ctx context.Context, q *Queries, closed groupLeg, canceled *OrderGroupLegs) (decimal.Decimal, error) {
+	legs, err := listGroupLegs(ctx, q, closed.groupID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	activated := legsActivated(legs)
+
+	release := closed.hold
+	if util.IsContingentLeg(closed.leg) && !activated {
+		release = decimal.Zero
+	}
+
+	for _, leg := range legs {
+		if leg.side == closed.side && leg.id == closed.id || !util.IsOpenStatus(leg.status) {
+			continue
+		}
+		if closed.leg != util.ENTRY_LEG && !(util.IsContingentLeg(closed.leg) && util.IsContingentLeg(leg.leg)) {
+			continue
+		}
+
+		ok, err := cancelLeg(ctx, q, leg, canceled)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		// both legs shared the hold of the larger one
+		if ok && activated && util.IsContingentLeg(leg.leg) {
+			release = release.Add(decimal.Max(closed.fullHold, leg.hold).Sub(closed.fullHold))
+		}
+	}
+
+	return release, nil
+}
+
+// fillGroupLeg updates the group of a leg that was just filled.
+// The first fill of the take profit or stop loss cancels the other one and releases the part of the shared hold
+// it doesn't need. A filled entry activates its take profit and stop loss and holds their funds,
+// or cancels them if the available balance can't cover them.
+// The take profit and stop loss of an entry bid only sell what it received after fees
+func fillGroupLeg(ctx context.Context, q *Queries, filled groupLeg, canceled *OrderGroupLegs, activated *OrderGroupLegs) error {
+	legs, err := listGroupLegs(ctx, q, filled.groupID)
+	if err != nil {
+		return err
+	}
+
+	if util.IsContingentLeg(filled.leg) {
+		release := decimal.Zero
+		for _, leg := range legs {
+			if !util.IsContingentLeg(leg.leg) || leg.id == filled.id && leg.side == filled.side || !util.IsOpenStatus(leg.status) {
+				continue
+			}
+
+			ok, err := cancelLeg(ctx, q, leg, canceled)
+			if err != nil {
+				return err
+			}
+			if ok {
+				release = release.Add(decimal.Max(filled.fullHold, leg.hold).Sub(filled.fullHold))
+			}
+		}
+
+		if release.IsPositive() {
+			_, err = holdMoney(ctx, q, filled.fromAccountID, release.Neg())
+		}
+		return err
+	}
+
+	if filled.leg != util.ENTRY_LEG || filled.status != util.COMPLETED {
+		return nil
+	}
+
+	inactive := []groupLeg{}
+	for _, leg := range legs {
+		if leg.status == util.INACTIVE {
+			inactive = append(inactive, leg)
+		}
+	}
+
+	if filled.side == util.BID {
+		var sized bool
+		inactive, sized, err = sizeExitsNetOfFee(ctx, q, filled.id, inactive)
+		if err != nil {
+			return err
+		}
+		if !sized {
+			return cancelLegs(ctx, q, inactive, canceled)
+		}
+	}
+
+	holds := groupHolds(inactive, true)
+	for _, accountID := range sortedAccountIDs(holds) {
+		account, err := q.GetAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Balance.Sub(account.Held).LessThan(holds[accountID]) {
+			return cancelLegs(ctx, q, inactive, canceled)
+		}
+	}
+
+	for _, leg := range inactive {
+		if err := activateLeg(ctx, q, leg, activated); err != nil {
+			return err
+		}
+	}
+
+	for _, accountID := range sortedAccountIDs(holds) {
+		if _, err := holdMoney(ctx, q, accountID, holds[accountID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelLegs cancels the inactive legs of a group, which hold no funds
+func cancelLegs(ctx context.Context, q *Queries, legs []groupLeg, canceled *OrderGroupLegs) error {
+	for _, leg := range legs {
+		if _, err := cancelLeg(ctx, q, leg, canceled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sizeExitsNetOfFee shrinks the inactive asks of a group to the amount its filled entry bid received after fees,
+// rounded down to the lot size of the pair. It returns false if no lot is left for them to sell
+func sizeExitsNetOfFee(ctx context.Context, q *Queries, entryID int64, legs []groupLeg) ([]groupLeg, bool, error) {
+	fee, err := q.GetBidFee(ctx, entryID)
+	if err != nil || !fee.IsPositive() {
+		return legs, true, err
+	}
+
+	entry, err := q.GetBid(ctx, entryID)
+	if err != nil {
+		return legs, false, err
+	}
+
+	pair, err := q.GetPair(ctx, entry.Pair)
+	if err != nil {
+		return legs, false, err
+	}
+
+	received := entry.FilledAmount.Sub(fee).RoundToMultiple(pair.LotSize, decimal.RoundDown)
+	if !received.IsPositive() {
+		return legs, false, nil
+	}
+
+	sized := make([]groupLeg, 0, len(legs))
+	for _, leg := range legs {
+		if leg.side == util.ASK && leg.fullHold.GreaterThan(received) {
+			ask, err := q.ResizeAsk(ctx, ResizeAskParams{ID: leg.id, Amount: received})
+			if err != nil {
+				return legs, false, err
+			}
+			leg = askLeg(ask)
+		}
+		sized = append(sized, leg)
+	}
+	return sized, true, nil
+}
+
+// activateLeg activates an inactive leg, stop orders wait for their trigger
+func activateLeg(ctx context.Context, q *Queries, leg groupLeg, activated *OrderGroupLegs) error {
+	status := util.ACTIVE
+	if util.IsStopOrderType(leg.orderType) {
+		status = util.PENDING
+	}
+
+	if leg.side == util.BID {
+		bid, err := q.ActivateBid(ctx, ActivateBidParams{ID: leg.id, Status: status})
+		if err != nil {
+			return err
+		}
+		activated.Bids = append(activated.Bids, bid)
+		return nil
+	}
+
+	ask, err := q.ActivateAsk(ctx, ActivateAskParams{ID: leg.id, Status: status})
+	if err != nil {
+		return err
+	}
+	activated.Asks = append(activated.Asks, ask)
+	return nil
+}
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// SelfTradeTxParams contains the input parameters of the self-trade transaction.
+// Mode is the self-trade prevention mode of the taker
+type SelfTradeTxParams struct {
+	BidID     int64  `json:"bid_id"`
+	AskID     int64  `json:"ask_id"`
+	TakerSide string `json:"taker_side"`
+	Mode      string `json:"mode"`
+}
+
+// SelfTradeTxResult is the result of the self-trade transaction
+type SelfTradeTxResult struct {
+	Bid      Bid            `json:"bid"`
+	Ask      Ask            `json:"ask"`
+	Canceled OrderGroupLegs `json:"canceled"`
+	Events   []OrderEvent   `json:"events"`
+}
+
+// SelfTradeTx prevents a bid and an ask of the same owner from trading against each other within a database transaction.
+// cancel_newest cancels the taker, cancel_oldest cancels the maker and cancel_both cancels both of them.
+// decrement_and_cancel takes the smaller remaining amount off both orders and cancels the ones left without any,
+// but cancels orders of an order group instead since their legs share holds.
+// The funds held for what is taken off are released and the prevented match is recorded in the events of both orders.
+// It fails with sql.ErrNoRows if one of the orders is no longer open
+func (store *SQLStore) SelfTradeTx(ctx context.Context, arg SelfTradeTxParams) (SelfTradeTxResult, error) {
+	var result SelfTradeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		bid, err := q.GetBidForUpdate(ctx, arg.BidID)
+		if err != nil {
+			return err
+		}
+
+		ask, err := q.GetAskForUpdate(ctx, arg.AskID)
+		if err != nil {
+			return err
+		}
+
+		if !util.IsOpenStatus(bid.Status) || !util.IsOpenStatus(ask.Status) {
+			return sql.ErrNoRows
+		}
+
+		var cancelBid, cancelAsk bool
+		var decrement decimal.Decimal
+		switch arg.Mode {
+		case util.CANCEL_NEWEST:
+			cancelBid = arg.TakerSide == util.BID
+			cancelAsk = arg.TakerSide == util.ASK
+		case util.CANCEL_OLDEST:
+			cancelBid = arg.TakerSide == util.ASK
+			cancelAsk = arg.TakerSide == util.BID
+		case util.CANCEL_BOTH:
+			cancelBid, cancelAsk = true, true
+		case util.DECREMENT_AND_CANCEL:
+			decrement = decimal.Min(bid.RemainingAmount, ask.RemainingAmount)
+			cancelBid = bid.RemainingAmount.Equal(decrement) || bid.GroupID.Valid
+			cancelAsk = ask.RemainingAmount.Equal(decrement) || ask.GroupID.Valid
+		default:
+			return fmt.Errorf("unsupported self-trade prevention mode: %s", arg.Mode)
+		}
+
+		releases := map[int64]decimal.Decimal{}
+
+		bidTaken, err := preventSelfTrade(ctx, q, bidLeg(bid), bid.RemainingAmount, cancelBid, decrement, releases, &result.Canceled)
+		if err != nil {
+			return err
+		}
+
+		askTaken, err := preventSelfTrade(ctx, q, askLeg(ask), ask.RemainingAmount, cancelAsk, decrement, releases, &result.Canceled)
+		if err != nil {
+			return err
+		}
+
+		for _, accountID := range sortedAccountIDs(releases) {
+			if _, err := holdMoney(ctx, q, accountID, releases[accountID].Neg()); err != nil {
+				return err
+			}
+		}
+
+		result.Bid, err = q.GetBid(ctx, arg.BidID)
+		if err != nil {
+			return err
+		}
+
+		result.Ask, err = q.GetAsk(ctx, arg.AskID)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range []CreateOrderEventParams{
+			selfTradeEvent(util.BID, bid.ID, ask.ID, arg.Mode, bidTaken),
+			selfTradeEvent(util.ASK, ask.ID, bid.ID, arg.Mode, askTaken),
+		} {
+			orderEvent, err := q.CreateOrderEvent(ctx, event)
+			if err != nil {
+				return err
+			}
+			result.Events = append(result.Events, orderEvent)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// preventSelfTrade cancels an order or takes the decrement off its remaining amount and adds the funds to release
+// from its from account to releases. It returns the amount taken off the order
+func preventSelfTrade(ctx context.Context, q *Queries, leg groupLeg, remaining decimal.Decimal, cancel bool, decrement decimal.Decimal, releases map[int64]decimal.Decimal, canceled *OrderGroupLegs) (decimal.Decimal, error) {
+	if cancel {
+		if _, err := cancelLeg(ctx, q, leg, canceled); err != nil {
+			return decimal.Zero, err
+		}
+
+		release := leg.hold
+		if leg.groupID != 0 {
+			var err error
+			release, err = closeGroupLeg(ctx, q, leg, canceled)
+			if err != nil {
+				return decimal.Zero, err
+			}
+		}
+		releases[leg.fromAccountID] = releases[leg.fromAccountID].Add(release)
+		return remaining, nil
+	}
+
+	if decrement.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	if leg.side == util.BID {
+		bid, err := q.DecrementBid(ctx, DecrementBidParams{ID: leg.id, Amount: decrement})
+		if err != nil {
+			return decimal.Zero, err
+		}
+		releases[leg.fromAccountID] = releases[leg.fromAccountID].Add(bid.Price.Mul(decrement))
+	} else {
+		_, err := q.DecrementAsk(ctx, DecrementAskParams{ID: leg.id, Amount: decrement})
+		if err != nil {
+			return decimal.Zero, err
+		}
+		releases[leg.fromAccountID] = releases[leg.fromAccountID].Add(decrement)
+	}
+	return decrement, nil
+}
+
+func selfTradeEvent(side string, orderID int64, counterOrderID int64, mode string, amount decimal.Decimal) CreateOrderEventParams {
+	return CreateOrderEventParams{
+		Side:                side,
+		OrderID:             orderID,
+		Type:                util.SELF_TRADE_PREVENTED,
+		SelfTradePrevention: mode,
+		CounterOrderID:      sql.NullInt64{Int64: counterOrderID, Valid: true},
+		Amount:              amount,
+	}
+}
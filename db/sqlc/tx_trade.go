@@ -1,59 +1,167 @@
 package db
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"go-exchange/decimal"
+	"sort"
+)
 
 // TradeTxParams contains the input parameters of the trade transaction
 type TradeTxParams struct {
-	FirstFromAccountID  int64 `json:"first_from_account_id"`
-	FirstToAccountID    int64 `json:"first_to_account_id"`
-	FirstAmount         int64 `json:"first_amount"`
-	SecondFromAccountID int64 `json:"second_from_account_id"`
-	SecondToAccountID   int64 `json:"second_to_account_id"`
-	SecondAmount        int64 `json:"second_amount"`
+	FirstFromAccountID  int64           `json:"first_from_account_id"`
+	FirstToAccountID    int64           `json:"first_to_account_id"`
+	FirstAmount         decimal.Decimal `json:"first_amount"`
+	SecondFromAccountID int64           `json:"second_from_account_id"`
+	SecondToAccountID   int64           `json:"second_to_account_id"`
+	SecondAmount        decimal.Decimal `json:"second_amount"`
+	FirstReleased       decimal.Decimal `json:"first_released"`
+	SecondReleased      decimal.Decimal `json:"second_released"`
+	FirstFee            decimal.Decimal `json:"first_fee"`
+	FirstFeeAccountID   int64           `json:"first_fee_account_id"`
+	SecondFee           decimal.Decimal `json:"second_fee"`
+	SecondFeeAccountID  int64           `json:"second_fee_account_id"`
+
+	Pair         string              `json:"pair"`
+	Price        decimal.NullDecimal `json:"price"`
+	TakerSide    string              `json:"taker_side"`
+	MakerOrderID int64               `json:"maker_order_id"`
+	TakerOrderID int64               `json:"taker_order_id"`
 }
 
 // TradeTxResult is the result of the trade transaction
 type TradeTxResult struct {
-	Trade          Trade    `json:"trade"`
-	FirstTransfer  Transfer `json:"first_transfer"`
-	SecondTransfer Transfer `json:"second_transfer"`
+	Trade             Trade    `json:"trade"`
+	FirstTransfer     Transfer `json:"first_transfer"`
+	SecondTransfer    Transfer `json:"second_transfer"`
+	FirstFeeTransfer  Transfer `json:"first_fee_transfer"`
+	SecondFeeTransfer Transfer `json:"second_fee_transfer"`
 }
 
 // TradeTx performs a money trade in different currencies.
-// It creates the trade and two transfers within a database transaction
+// It releases the funds held by both orders, creates the trade and both transfers within a single database transaction.
+// The four accounts are locked in ID order first, so concurrent trades can't deadlock.
+// A fee is taken by the exchange from what each receiving account gets, moved to its fee account
 func (store *SQLStore) TradeTx(ctx context.Context, arg TradeTxParams) (TradeTxResult, error) {
 	var result TradeTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = trade(ctx, q, arg)
+		return err
+	})
 
-		result.Trade, err = q.CreateTrade(ctx, CreateTradeParams(arg))
-		if err != nil {
-			return err
-		}
+	return result, err
+}
+
+func trade(ctx context.Context, q *Queries, arg TradeTxParams) (result TradeTxResult, err error) {
+	accountIDs := []int64{
+		arg.FirstFromAccountID,
+		arg.FirstToAccountID,
+		arg.SecondFromAccountID,
+		arg.SecondToAccountID,
+	}
+	if arg.FirstFee.IsPositive() {
+		accountIDs = append(accountIDs, arg.FirstFeeAccountID)
+	}
+	if arg.SecondFee.IsPositive() {
+		accountIDs = append(accountIDs, arg.SecondFeeAccountID)
+	}
+
+	err = lockAccounts(ctx, q, accountIDs...)
+	if err != nil {
+		return
+	}
+
+	result.Trade, err = q.CreateTrade(ctx, CreateTradeParams{
+		FirstFromAccountID:  arg.FirstFromAccountID,
+		FirstToAccountID:    arg.FirstToAccountID,
+		FirstAmount:         arg.FirstAmount,
+		SecondFromAccountID: arg.SecondFromAccountID,
+		SecondToAccountID:   arg.SecondToAccountID,
+		SecondAmount:        arg.SecondAmount,
+		FirstFee:            arg.FirstFee,
+		SecondFee:           arg.SecondFee,
+		Pair:                sql.NullString{String: arg.Pair, Valid: arg.Pair != ""},
+		Price:               arg.Price,
+		TakerSide:           sql.NullString{String: arg.TakerSide, Valid: arg.TakerSide != ""},
+		MakerOrderID:        sql.NullInt64{Int64: arg.MakerOrderID, Valid: arg.MakerOrderID != 0},
+		TakerOrderID:        sql.NullInt64{Int64: arg.TakerOrderID, Valid: arg.TakerOrderID != 0},
+	})
+	if err != nil {
+		return
+	}
+
+	_, err = holdMoney(ctx, q, arg.FirstFromAccountID, arg.FirstReleased.Neg())
+	if err != nil {
+		return
+	}
+
+	_, err = holdMoney(ctx, q, arg.SecondFromAccountID, arg.SecondReleased.Neg())
+	if err != nil {
+		return
+	}
 
-		transferResult, err := store.TransferTx(ctx, TransferTxParams{
-			FromAccountID: arg.FirstFromAccountID,
-			ToAccountID: arg.FirstToAccountID,
-			Amount: arg.FirstAmount,
+	transferResult, err := transfer(ctx, q, TransferTxParams{
+		FromAccountID: arg.FirstFromAccountID,
+		ToAccountID: arg.FirstToAccountID,
+		Amount: arg.FirstAmount,
+	})
+	if err != nil {
+		return
+	}
+	result.FirstTransfer = transferResult.Transfer
+
+	transferResult, err = transfer(ctx, q, TransferTxParams{
+		FromAccountID: arg.SecondFromAccountID,
+		ToAccountID: arg.SecondToAccountID,
+		Amount: arg.SecondAmount,
+	})
+	if err != nil {
+		return
+	}
+	result.SecondTransfer = transferResult.Transfer
+
+	if arg.FirstFee.IsPositive() {
+		transferResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.FirstToAccountID,
+			ToAccountID:   arg.FirstFeeAccountID,
+			Amount:        arg.FirstFee,
 		})
 		if err != nil {
-			return err
+			return
 		}
-		result.FirstTransfer = transferResult.Transfer
+		result.FirstFeeTransfer = transferResult.Transfer
+	}
 
-		transferResult, err = store.TransferTx(ctx, TransferTxParams{
-			FromAccountID: arg.SecondFromAccountID,
-			ToAccountID: arg.SecondToAccountID,
-			Amount: arg.SecondAmount,
+	if arg.SecondFee.IsPositive() {
+		transferResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.SecondToAccountID,
+			ToAccountID:   arg.SecondFeeAccountID,
+			Amount:        arg.SecondFee,
 		})
 		if err != nil {
-			return err
+			return
 		}
-		result.SecondTransfer = transferResult.Transfer
+		result.SecondFeeTransfer = transferResult.Transfer
+	}
+	return
+}
 
-		return err
-	})
+// lockAccounts locks the rows of the accounts in ascending ID order until the end of the transaction
+func lockAccounts(ctx context.Context, q *Queries, accountIDs ...int64) error {
+	ids := make([]int64, len(accountIDs))
+	copy(ids, accountIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
 
-	return result, err
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+
+		if _, err := q.GetAccountForUpdate(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -12,7 +12,7 @@ import (
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (username, hashed_password, full_name, email) VALUES ($1, $2, $3, $4)
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, self_trade_prevention, role
 `
 
 type CreateUserParams struct {
@@ -37,6 +37,8 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.SelfTradePrevention,
+		&i.Role,
 	)
 	return i, err
 }
@@ -52,7 +54,7 @@ func (q *Queries) DeleteUser(ctx context.Context, username string) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at FROM users
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, self_trade_prevention, role FROM users
 WHERE username = $1 LIMIT 1
 `
 
@@ -66,6 +68,8 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.SelfTradePrevention,
+		&i.Role,
 	)
 	return i, err
 }
@@ -76,18 +80,20 @@ SET
   hashed_password = COALESCE($1, hashed_password),
   password_changed_at = COALESCE($2, password_changed_at),
   full_name = COALESCE($3, full_name),
-  email = COALESCE($4, email)
+  email = COALESCE($4, email),
+  self_trade_prevention = COALESCE($5, self_trade_prevention)
 WHERE
-  username = $5
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at
+  username = $6
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, self_trade_prevention, role
 `
 
 type UpdateUserParams struct {
-	HashedPassword    sql.NullString `json:"hashed_password"`
-	PasswordChangedAt sql.NullTime   `json:"password_changed_at"`
-	FullName          sql.NullString `json:"full_name"`
-	Email             sql.NullString `json:"email"`
-	Username          string         `json:"username"`
+	HashedPassword      sql.NullString `json:"hashed_password"`
+	PasswordChangedAt   sql.NullTime   `json:"password_changed_at"`
+	FullName            sql.NullString `json:"full_name"`
+	Email               sql.NullString `json:"email"`
+	SelfTradePrevention sql.NullString `json:"self_trade_prevention"`
+	Username            string         `json:"username"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
@@ -96,6 +102,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.PasswordChangedAt,
 		arg.FullName,
 		arg.Email,
+		arg.SelfTradePrevention,
 		arg.Username,
 	)
 	var i User
@@ -106,6 +113,8 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Email,
 		&i.PasswordChangedAt,
 		&i.CreatedAt,
+		&i.SelfTradePrevention,
+		&i.Role,
 	)
 	return i, err
 }
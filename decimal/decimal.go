@@ -0,0 +1,480 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an immutable arbitrary-precision decimal number: value * 10^exp.
+// It is always kept in a canonical form without trailing zeros, and zero is the zero value,
+// so equal numbers are also deeply equal whatever the scale they were computed or parsed with
+type Decimal struct {
+	value *big.Int
+	exp   int32
+}
+
+// Zero is the decimal 0
+var Zero = Decimal{}
+
+const (
+	// maxPlaces is the most fractional digits a parsed decimal may have, the decimals of the most precise currency
+	maxPlaces = 18
+	// maxIntegerDigits is the most digits a parsed decimal may have before the decimal point
+	maxIntegerDigits = 30
+	// maxLength is the longest string parsed, room for every digit plus a sign, a point and an exponent
+	maxLength = maxIntegerDigits + maxPlaces + 16
+)
+
+var (
+	ten = big.NewInt(10)
+
+	errInvalidDecimal = errors.New("invalid decimal")
+	errDivisionByZero = errors.New("decimal division by zero")
+)
+
+// New creates a decimal of value * 10^exp
+func New(value int64, exp int32) Decimal {
+	return canonical(big.NewInt(value), exp)
+}
+
+// NewFromInt creates a decimal of an integer
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromBigInt creates a decimal of value * 10^exp
+func NewFromBigInt(value *big.Int, exp int32) Decimal {
+	return canonical(new(big.Int).Set(value), exp)
+}
+
+// NewFromString parses a decimal in plain or scientific notation, like "-12.345" or "1.5e-8".
+// It only accepts up to maxPlaces fractional digits and maxIntegerDigits integer digits,
+// so no input can make a decimal too large to print
+func NewFromString(s string) (Decimal, error) {
+	if len(s) > maxLength {
+		return Zero, fmt.Errorf("%w: longer than %d characters", errInvalidDecimal, maxLength)
+	}
+
+	d, err := parse(s)
+	if err != nil {
+		return Zero, err
+	}
+
+	if d.exp < -maxPlaces || d.integerDigits() > maxIntegerDigits {
+		return Zero, fmt.Errorf("%w: %q is out of range", errInvalidDecimal, s)
+	}
+	return d, nil
+}
+
+// parse parses a decimal in plain or scientific notation whatever its size, for trusted input like numeric columns
+func parse(s string) (Decimal, error) {
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		mantissa = s[:i]
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+		}
+	}
+
+	digits := mantissa
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		fraction := mantissa[i+1:]
+		digits = mantissa[:i] + fraction
+		exp -= int64(len(fraction))
+	}
+
+	unsigned := strings.TrimLeft(digits, "+-")
+	if len(digits)-len(unsigned) > 1 || unsigned == "" || strings.ContainsAny(unsigned, "+-") || exp < -1<<31 || exp > 1<<31-1 {
+		return Zero, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+	}
+
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Zero, fmt.Errorf("%w: %q", errInvalidDecimal, s)
+	}
+	return canonical(value, int32(exp)), nil
+}
+
+// RequireFromString parses a decimal and panics if it is invalid, for constants known to be valid
+func RequireFromString(s string) Decimal {
+	d, err := NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// canonical strips the trailing zeros of value, it takes ownership of value
+func canonical(value *big.Int, exp int32) Decimal {
+	if value.Sign() == 0 {
+		return Zero
+	}
+
+	quotient, remainder := new(big.Int), new(big.Int)
+	for {
+		quotient.QuoRem(value, ten, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+		value, quotient = quotient, value
+		exp++
+	}
+	return Decimal{value: value, exp: exp}
+}
+
+// integerDigits returns the number of digits of d before the decimal point
+func (d Decimal) integerDigits() int64 {
+	return int64(len(new(big.Int).Abs(d.int()).String())) + int64(d.exp)
+}
+
+// int returns the unscaled value of the decimal
+func (d Decimal) int() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// pow10 returns 10^n
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(ten, big.NewInt(int64(n)), nil)
+}
+
+// align returns the unscaled values of both decimals at the lowest exponent of the two
+func align(d1 Decimal, d2 Decimal) (*big.Int, *big.Int, int32) {
+	v1, v2 := d1.int(), d2.int()
+	if d1.value == nil {
+		return v1, v2, d2.exp
+	}
+	if d2.value == nil {
+		return v1, v2, d1.exp
+	}
+
+	switch {
+	case d1.exp > d2.exp:
+		return new(big.Int).Mul(v1, pow10(d1.exp-d2.exp)), v2, d2.exp
+	case d2.exp > d1.exp:
+		return v1, new(big.Int).Mul(v2, pow10(d2.exp-d1.exp)), d1.exp
+	}
+	return v1, v2, d1.exp
+}
+
+// Add returns d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	v1, v2, exp := align(d, d2)
+	return canonical(new(big.Int).Add(v1, v2), exp)
+}
+
+// Sub returns d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	v1, v2, exp := align(d, d2)
+	return canonical(new(big.Int).Sub(v1, v2), exp)
+}
+
+// Mul returns d * d2, which is always exact
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return canonical(new(big.Int).Mul(d.int(), d2.int()), d.exp+d2.exp)
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return canonical(new(big.Int).Neg(d.int()), d.exp)
+}
+
+// Abs returns the absolute value of d
+func (d Decimal) Abs() Decimal {
+	return canonical(new(big.Int).Abs(d.int()), d.exp)
+}
+
+// RoundingMode tells which way a result that doesn't fit the requested places is rounded
+type RoundingMode int
+
+const (
+	// RoundDown rounds toward zero
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero
+	RoundUp
+	// RoundHalfEven rounds to the nearest value and ties to the even one
+	RoundHalfEven
+)
+
+// quo returns num / den rounded to an integer with the rounding mode
+func quo(num *big.Int, den *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// the sign of the exact result, the quotient may be zero
+	sign := int64(num.Sign() * den.Sign())
+
+	switch mode {
+	case RoundUp:
+		quotient.Add(quotient, big.NewInt(sign))
+	case RoundHalfEven:
+		twice := new(big.Int).Abs(remainder)
+		twice.Lsh(twice, 1)
+		cmp := twice.Cmp(new(big.Int).Abs(den))
+		if cmp > 0 || cmp == 0 && quotient.Bit(0) == 1 {
+			quotient.Add(quotient, big.NewInt(sign))
+		}
+	}
+	return quotient
+}
+
+// Div returns d / d2 with places decimal places, rounded with the rounding mode.
+// It panics if d2 is zero
+func (d Decimal) Div(d2 Decimal, places int32, mode RoundingMode) Decimal {
+	if d2.IsZero() {
+		panic(errDivisionByZero)
+	}
+
+	// d / d2 = (v1 / v2) * 10^(e1-e2), scaled to 10^-places
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(d2.int())
+	shift := d.exp - d2.exp + places
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return canonical(quo(num, den, mode), -places)
+}
+
+// Round returns d with at most places decimal places, rounded with the rounding mode
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if d.Places() <= places {
+		return d
+	}
+	return canonical(quo(d.int(), pow10(-d.exp-places), mode), -places)
+}
+
+// RoundToMultiple returns the multiple of step closest to d, rounded with the rounding mode.
+// A zero step leaves d unchanged
+func (d Decimal) RoundToMultiple(step Decimal, mode RoundingMode) Decimal {
+	if step.IsZero() {
+		return d
+	}
+	return d.Div(step, 0, mode).Mul(step)
+}
+
+// Mod returns the remainder of d / d2, with the sign of d. It panics if d2 is zero
+func (d Decimal) Mod(d2 Decimal) Decimal {
+	if d2.IsZero() {
+		panic(errDivisionByZero)
+	}
+
+	v1, v2, exp := align(d, d2)
+	return canonical(new(big.Int).Rem(v1, v2), exp)
+}
+
+// IsMultipleOf returns true if d is an integer multiple of step, every decimal is a multiple of zero
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	return step.IsZero() || d.Mod(step).IsZero()
+}
+
+// Places returns the number of decimal places of d
+func (d Decimal) Places() int32 {
+	if d.exp >= 0 {
+		return 0
+	}
+	return -d.exp
+}
+
+// Sign returns -1, 0 or 1 when d is negative, zero or positive
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns true if d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IsPositive returns true if d is greater than 0
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+// IsNegative returns true if d is less than 0
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+// Cmp returns -1, 0 or 1 when d is less than, equal to or greater than d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	v1, v2, _ := align(d, d2)
+	return v1.Cmp(v2)
+}
+
+// Equal returns true if d == d2
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// LessThan returns true if d < d2
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// LessThanOrEqual returns true if d <= d2
+func (d Decimal) LessThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) <= 0
+}
+
+// GreaterThan returns true if d > d2
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+// GreaterThanOrEqual returns true if d >= d2
+func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// Min returns the smallest of the decimals
+func Min(first Decimal, rest ...Decimal) Decimal {
+	result := first
+	for _, d := range rest {
+		if d.LessThan(result) {
+			result = d
+		}
+	}
+	return result
+}
+
+// Max returns the largest of the decimals
+func Max(first Decimal, rest ...Decimal) Decimal {
+	result := first
+	for _, d := range rest {
+		if d.GreaterThan(result) {
+			result = d
+		}
+	}
+	return result
+}
+
+// Float64 returns the closest float64 to d, for uses that don't need exact values
+func (d Decimal) Float64() float64 {
+	// scientific notation keeps the string as short as the unscaled value whatever the exponent
+	f, _ := strconv.ParseFloat(d.int().String()+"e"+strconv.Itoa(int(d.exp)), 64)
+	return f
+}
+
+// String returns d in plain notation, like "-12.345" or "1500"
+func (d Decimal) String() string {
+	if d.value == nil {
+		return "0"
+	}
+
+	digits := new(big.Int).Abs(d.value).String()
+	sign := ""
+	if d.value.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.exp >= 0 {
+		return sign + digits + strings.Repeat("0", int(d.exp))
+	}
+
+	places := int(-d.exp)
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+}
+
+// MarshalJSON encodes d as a JSON string, so clients never parse it into a float
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes d from a JSON string or number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	parsed, err := NewFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText encodes d in plain notation
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes d from plain or scientific notation
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := NewFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for numeric columns.
+// Values computed by the exchange, like fees, may have more places than a client can send, so they are not bounded
+func (d *Decimal) Scan(src interface{}) error {
+	var err error
+	switch src := src.(type) {
+	case []byte:
+		*d, err = parse(string(src))
+		return err
+	case string:
+		*d, err = parse(src)
+		return err
+	case int64:
+		*d = NewFromInt(src)
+		return nil
+	case float64:
+		*d, err = parse(strconv.FormatFloat(src, 'f', -1, 64))
+		return err
+	}
+	return fmt.Errorf("cannot scan %T into a decimal", src)
+}
+
+// Value implements the driver.Valuer interface for numeric columns
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// NullDecimal is a decimal that may be null
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool
+}
+
+// Scan implements the sql.Scanner interface for nullable numeric columns
+func (n *NullDecimal) Scan(src interface{}) error {
+	if src == nil {
+		n.Decimal, n.Valid = Zero, false
+		return nil
+	}
+
+	n.Valid = true
+	return n.Decimal.Scan(src)
+}
+
+// Value implements the driver.Valuer interface for nullable numeric columns
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
@@ -0,0 +1,178 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromString(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{
+		{"0", "0"},
+		{"-0.000", "0"},
+		{"12.3400", "12.34"},
+		{"-12.345", "-12.345"},
+		{"+7", "7"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"1500", "1500"},
+		{"1.5e-8", "0.000000015"},
+		{"2E3", "2000"},
+		{"123456789012345678901234567890.123456789012345678", "123456789012345678901234567890.123456789012345678"},
+	}
+
+	for _, tc := range testCases {
+		d, err := NewFromString(tc.input)
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.output, d.String(), tc.input)
+	}
+
+	for _, input := range []string{"", ".", "-", "+-1", "1-", "1.2.3", "abc", "1e", "NaN", "1 000"} {
+		_, err := NewFromString(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestNewFromStringRange(t *testing.T) {
+	for _, input := range []string{
+		"1e30", "1e-19", "0.0000000000000000001", "1234567890123456789012345678901",
+		"1e300000000", "1e-300000000", strings.Repeat("9", 1000),
+	} {
+		_, err := NewFromString(input)
+		require.Error(t, err, input)
+	}
+
+	for _, input := range []string{"9e29", "1e-18", "0.1000000000000000000000", "-999999999999999999999999999999"} {
+		_, err := NewFromString(input)
+		require.NoError(t, err, input)
+	}
+
+	// numeric columns are not bounded, exchange fees can have more places than a client can send
+	var d Decimal
+	require.NoError(t, d.Scan("0.0000000000000000000123"))
+	require.Equal(t, "0.0000000000000000000123", d.String())
+	require.Equal(t, 1.23e-20, d.Float64())
+}
+
+func TestCanonical(t *testing.T) {
+	// equal numbers are deeply equal however they were computed
+	require.Equal(t, NewFromInt(100), RequireFromString("100.00"))
+	require.Equal(t, New(25, -1), NewFromInt(5).Div(NewFromInt(2), 10, RoundDown))
+	require.Equal(t, Zero, NewFromInt(3).Sub(NewFromInt(3)))
+	require.Equal(t, NewFromInt(1), RequireFromString("0.2").Mul(NewFromInt(5)))
+}
+
+func TestArithmetic(t *testing.T) {
+	a := RequireFromString("1.25")
+	b := RequireFromString("0.005")
+
+	require.Equal(t, "1.255", a.Add(b).String())
+	require.Equal(t, "1.245", a.Sub(b).String())
+	require.Equal(t, "-1.245", b.Sub(a).String())
+	require.Equal(t, "0.00625", a.Mul(b).String())
+	require.Equal(t, "250", a.Div(b, 0, RoundDown).String())
+	require.Equal(t, "0.002", b.Mod(RequireFromString("0.003")).String())
+	require.Equal(t, "1.25", a.Neg().Abs().String())
+
+	// wei amounts times prices don't overflow
+	wei := RequireFromString("1000000000000000000000")
+	require.Equal(t, "3000000000000000000000000", wei.Mul(NewFromInt(3000)).String())
+
+	require.True(t, a.GreaterThan(b))
+	require.True(t, b.LessThanOrEqual(b))
+	require.True(t, a.Equal(RequireFromString("1.250")))
+	require.Equal(t, b, Min(a, b))
+	require.Equal(t, a, Max(b, a))
+	require.Equal(t, int32(3), b.Places())
+	require.Equal(t, int32(0), NewFromInt(1500).Places())
+
+	require.Panics(t, func() { a.Div(Zero, 2, RoundDown) })
+}
+
+func TestRound(t *testing.T) {
+	testCases := []struct {
+		input  string
+		places int32
+		mode   RoundingMode
+		output string
+	}{
+		{"1.2345", 2, RoundDown, "1.23"},
+		{"1.2345", 2, RoundUp, "1.24"},
+		{"-1.2345", 2, RoundDown, "-1.23"},
+		{"-1.2345", 2, RoundUp, "-1.24"},
+		{"1.235", 2, RoundHalfEven, "1.24"},
+		{"1.245", 2, RoundHalfEven, "1.24"},
+		{"1.2451", 2, RoundHalfEven, "1.25"},
+		{"0.001", 2, RoundDown, "0"},
+		{"0.001", 2, RoundUp, "0.01"},
+		{"1.2", 4, RoundUp, "1.2"},
+		{"1234", -2, RoundDown, "1200"},
+	}
+
+	for _, tc := range testCases {
+		d := RequireFromString(tc.input).Round(tc.places, tc.mode)
+		require.Equal(t, tc.output, d.String(), tc.input)
+	}
+
+	require.Equal(t, "0.333", NewFromInt(1).Div(NewFromInt(3), 3, RoundDown).String())
+	require.Equal(t, "0.667", NewFromInt(2).Div(NewFromInt(3), 3, RoundHalfEven).String())
+
+	step := RequireFromString("0.05")
+	require.Equal(t, "1.2", RequireFromString("1.23").RoundToMultiple(step, RoundDown).String())
+	require.Equal(t, "1.25", RequireFromString("1.23").RoundToMultiple(step, RoundUp).String())
+	require.Equal(t, "1.23", RequireFromString("1.23").RoundToMultiple(Zero, RoundUp).String())
+	require.True(t, RequireFromString("1.25").IsMultipleOf(step))
+	require.False(t, RequireFromString("1.26").IsMultipleOf(step))
+	require.True(t, RequireFromString("1.26").IsMultipleOf(Zero))
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Amount Decimal `json:"amount"`
+	}
+
+	data, err := json.Marshal(payload{Amount: RequireFromString("0.000000000000000001")})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"amount":"0.000000000000000001"}`, string(data))
+
+	var p payload
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"12.50"}`), &p))
+	require.Equal(t, RequireFromString("12.5"), p.Amount)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":3}`), &p))
+	require.Equal(t, NewFromInt(3), p.Amount)
+
+	require.Error(t, json.Unmarshal([]byte(`{"amount":"abc"}`), &p))
+}
+
+func TestSQL(t *testing.T) {
+	var d Decimal
+	require.NoError(t, d.Scan([]byte("42.4200")))
+	require.Equal(t, RequireFromString("42.42"), d)
+
+	require.NoError(t, d.Scan(int64(7)))
+	require.Equal(t, NewFromInt(7), d)
+	require.Error(t, d.Scan(true))
+
+	value, err := d.Value()
+	require.NoError(t, err)
+	require.Equal(t, driver.Value("7"), value)
+
+	var n NullDecimal
+	require.NoError(t, n.Scan(nil))
+	require.False(t, n.Valid)
+
+	value, err = n.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.NoError(t, n.Scan("1.5"))
+	require.True(t, n.Valid)
+	require.Equal(t, RequireFromString("1.5"), n.Decimal)
+}
@@ -8,13 +8,16 @@ CREATE TABLE "users" (
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01 00:00:00Z',
+  "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest',
+  "role" varchar NOT NULL DEFAULT 'trader',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
 CREATE TABLE "accounts" (
   "id" bigserial PRIMARY KEY,
   "owner" varchar NOT NULL,
-  "balance" bigint NOT NULL,
+  "balance" numeric NOT NULL,
+  "held" numeric NOT NULL DEFAULT 0,
   "currency" varchar NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
@@ -22,7 +25,7 @@ CREATE TABLE "accounts" (
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
-  "amount" bigint NOT NULL,
+  "amount" numeric NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -30,7 +33,7 @@ CREATE TABLE "transfers" (
   "id" bigserial PRIMARY KEY,
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
-  "amount" bigint NOT NULL,
+  "amount" numeric NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -38,11 +41,18 @@ CREATE TABLE "trades" (
   "id" bigserial PRIMARY KEY,
   "first_from_account_id" bigint NOT NULL,
   "first_to_account_id" bigint NOT NULL,
-  "first_amount" bigint NOT NULL,
+  "first_amount" numeric NOT NULL,
   "second_from_account_id" bigint NOT NULL,
   "second_to_account_id" bigint NOT NULL,
-  "second_amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "second_amount" numeric NOT NULL,
+  "first_fee" numeric NOT NULL DEFAULT 0,
+  "second_fee" numeric NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "pair" varchar,
+  "price" numeric,
+  "taker_side" varchar,
+  "maker_order_id" bigint,
+  "taker_order_id" bigint
 );
 
 CREATE TABLE "bids" (
@@ -50,9 +60,24 @@ CREATE TABLE "bids" (
   "pair" varchar NOT NULL,
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
-  "price" bigint NOT NULL,
-  "amount" bigint NOT NULL,
+  "price" numeric NOT NULL,
+  "amount" numeric NOT NULL,
   "status" varchar NOT NULL,
+  "filled_amount" numeric NOT NULL DEFAULT 0,
+  "remaining_amount" numeric NOT NULL DEFAULT 0,
+  "average_price" numeric NOT NULL DEFAULT 0,
+  "type" varchar NOT NULL DEFAULT 'limit',
+  "time_in_force" varchar NOT NULL DEFAULT 'GTC',
+  "expires_at" timestamptz,
+  "stop_price" numeric NOT NULL DEFAULT 0,
+  "post_only" boolean NOT NULL DEFAULT false,
+  "display_amount" numeric NOT NULL DEFAULT 0,
+  "hidden" boolean NOT NULL DEFAULT false,
+  "group_id" bigint,
+  "group_leg" varchar NOT NULL DEFAULT '',
+  "priority_at" timestamptz NOT NULL DEFAULT (now()),
+  "owner" varchar NOT NULL DEFAULT '',
+  "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -61,9 +86,51 @@ CREATE TABLE "asks" (
   "pair" varchar NOT NULL,
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
-  "price" bigint NOT NULL,
-  "amount" bigint NOT NULL,
+  "price" numeric NOT NULL,
+  "amount" numeric NOT NULL,
   "status" varchar NOT NULL,
+  "filled_amount" numeric NOT NULL DEFAULT 0,
+  "remaining_amount" numeric NOT NULL DEFAULT 0,
+  "average_price" numeric NOT NULL DEFAULT 0,
+  "type" varchar NOT NULL DEFAULT 'limit',
+  "time_in_force" varchar NOT NULL DEFAULT 'GTC',
+  "expires_at" timestamptz,
+  "stop_price" numeric NOT NULL DEFAULT 0,
+  "post_only" boolean NOT NULL DEFAULT false,
+  "display_amount" numeric NOT NULL DEFAULT 0,
+  "hidden" boolean NOT NULL DEFAULT false,
+  "group_id" bigint,
+  "group_leg" varchar NOT NULL DEFAULT '',
+  "priority_at" timestamptz NOT NULL DEFAULT (now()),
+  "owner" varchar NOT NULL DEFAULT '',
+  "self_trade_prevention" varchar NOT NULL DEFAULT 'cancel_newest',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fills" (
+  "id" bigserial PRIMARY KEY,
+  "trade_id" bigint NOT NULL,
+  "bid_id" bigint NOT NULL,
+  "ask_id" bigint NOT NULL,
+  "price" numeric NOT NULL,
+  "amount" numeric NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "order_groups" (
+  "id" bigserial PRIMARY KEY,
+  "type" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "order_events" (
+  "id" bigserial PRIMARY KEY,
+  "side" varchar NOT NULL,
+  "order_id" bigint NOT NULL,
+  "type" varchar NOT NULL,
+  "self_trade_prevention" varchar NOT NULL DEFAULT '',
+  "counter_order_id" bigint,
+  "amount" numeric NOT NULL DEFAULT 0,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
@@ -78,6 +145,74 @@ CREATE TABLE "sessions" (
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "dead_man_switches" (
+  "username" varchar PRIMARY KEY,
+  "timeout_seconds" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "dead_man_switch_events" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "timeout_seconds" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "canceled_orders" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_tiers" (
+  "id" bigserial PRIMARY KEY,
+  "pair" varchar NOT NULL,
+  "min_volume" numeric NOT NULL DEFAULT 0,
+  "maker_rate" bigint NOT NULL,
+  "taker_rate" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_accounts" (
+  "currency" varchar PRIMARY KEY,
+  "account_id" bigint UNIQUE NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "currencies" (
+  "code" varchar PRIMARY KEY,
+  "decimals" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pairs" (
+  "symbol" varchar PRIMARY KEY,
+  "base" varchar NOT NULL,
+  "quote" varchar NOT NULL,
+  "tick_size" numeric NOT NULL DEFAULT 1,
+  "lot_size" numeric NOT NULL DEFAULT 1,
+  "min_notional" numeric NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "candles" (
+  "pair" varchar NOT NULL,
+  "interval" varchar NOT NULL,
+  "open_time" timestamptz NOT NULL,
+  "open" numeric NOT NULL,
+  "high" numeric NOT NULL,
+  "low" numeric NOT NULL,
+  "close" numeric NOT NULL,
+  "volume" numeric NOT NULL,
+  "quote_volume" numeric NOT NULL,
+  "trade_count" bigint NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("pair", "interval", "open_time")
+);
+
 CREATE INDEX ON "accounts" ("owner");
 
 CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
@@ -98,6 +233,8 @@ CREATE INDEX ON "trades" ("second_from_account_id");
 
 CREATE INDEX ON "trades" ("second_to_account_id");
 
+CREATE INDEX ON "trades" ("pair", "created_at");
+
 CREATE INDEX ON "bids" ("pair");
 
 CREATE INDEX ON "bids" ("from_account_id");
@@ -108,6 +245,12 @@ CREATE INDEX ON "bids" ("from_account_id", "to_account_id");
 
 CREATE INDEX ON "bids" ("status");
 
+CREATE INDEX ON "bids" ("expires_at");
+
+CREATE INDEX ON "bids" ("group_id");
+
+CREATE INDEX ON "bids" ("owner", "pair");
+
 CREATE INDEX ON "asks" ("pair");
 
 CREATE INDEX ON "asks" ("from_account_id");
@@ -118,6 +261,36 @@ CREATE INDEX ON "asks" ("from_account_id", "to_account_id");
 
 CREATE INDEX ON "asks" ("status");
 
+CREATE INDEX ON "asks" ("expires_at");
+
+CREATE INDEX ON "asks" ("group_id");
+
+CREATE INDEX ON "asks" ("owner", "pair");
+
+CREATE INDEX ON "fills" ("trade_id");
+
+CREATE INDEX ON "fills" ("bid_id");
+
+CREATE INDEX ON "fills" ("ask_id");
+
+CREATE INDEX ON "fills" ("created_at");
+
+CREATE INDEX ON "fills" ("bid_id", "created_at");
+
+CREATE INDEX ON "fills" ("ask_id", "created_at");
+
+CREATE INDEX ON "order_events" ("side", "order_id");
+
+CREATE INDEX ON "dead_man_switches" ("expires_at");
+
+CREATE INDEX ON "dead_man_switch_events" ("username");
+
+CREATE UNIQUE INDEX ON "fee_tiers" ("pair", "min_volume");
+
+CREATE UNIQUE INDEX ON "pairs" ("base", "quote");
+
+COMMENT ON COLUMN "accounts"."held" IS 'funds reserved by open orders';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
 COMMENT ON COLUMN "transfers"."amount" IS 'it must be positive';
@@ -126,10 +299,124 @@ COMMENT ON COLUMN "trades"."first_amount" IS 'it must be positive';
 
 COMMENT ON COLUMN "trades"."second_amount" IS 'it must be positive';
 
+COMMENT ON COLUMN "trades"."first_fee" IS 'taken from the first amount by the exchange';
+
+COMMENT ON COLUMN "trades"."second_fee" IS 'taken from the second amount by the exchange';
+
+COMMENT ON COLUMN "trades"."pair" IS 'pair the trade was made on';
+
+COMMENT ON COLUMN "trades"."price" IS 'price of the fill, null for trades made outside the order book';
+
+COMMENT ON COLUMN "trades"."taker_side" IS 'side of the order that took liquidity, null for trades made outside the order book';
+
+COMMENT ON COLUMN "trades"."maker_order_id" IS 'bid or ask resting on the book, on the side opposite to the taker';
+
+COMMENT ON COLUMN "trades"."taker_order_id" IS 'bid or ask that took liquidity, on the taker side';
+
 COMMENT ON COLUMN "bids"."amount" IS 'it must be positive';
 
 COMMENT ON COLUMN "asks"."amount" IS 'it must be positive';
 
+COMMENT ON COLUMN "bids"."remaining_amount" IS 'amount - filled_amount';
+
+COMMENT ON COLUMN "bids"."average_price" IS 'average price of the fills rounded to the decimals of the quote currency';
+
+COMMENT ON COLUMN "asks"."remaining_amount" IS 'amount - filled_amount';
+
+COMMENT ON COLUMN "asks"."average_price" IS 'average price of the fills rounded to the decimals of the quote currency';
+
+COMMENT ON COLUMN "bids"."type" IS 'limit, market, stop_limit or stop_market';
+
+COMMENT ON COLUMN "bids"."time_in_force" IS 'GTC, IOC, FOK or GTD';
+
+COMMENT ON COLUMN "bids"."expires_at" IS 'only set for GTD orders';
+
+COMMENT ON COLUMN "bids"."stop_price" IS 'trigger price of stop orders';
+
+COMMENT ON COLUMN "bids"."post_only" IS 'canceled instead of taking liquidity';
+
+COMMENT ON COLUMN "bids"."display_amount" IS 'visible amount of iceberg orders, 0 shows the whole amount';
+
+COMMENT ON COLUMN "bids"."hidden" IS 'kept out of the public depth';
+
+COMMENT ON COLUMN "bids"."group_leg" IS 'entry, take_profit or stop_loss';
+
+COMMENT ON COLUMN "bids"."priority_at" IS 'time the order joined the queue of its price';
+
+COMMENT ON COLUMN "asks"."type" IS 'limit, market, stop_limit or stop_market';
+
+COMMENT ON COLUMN "asks"."time_in_force" IS 'GTC, IOC, FOK or GTD';
+
+COMMENT ON COLUMN "asks"."expires_at" IS 'only set for GTD orders';
+
+COMMENT ON COLUMN "asks"."stop_price" IS 'trigger price of stop orders';
+
+COMMENT ON COLUMN "asks"."post_only" IS 'canceled instead of taking liquidity';
+
+COMMENT ON COLUMN "asks"."display_amount" IS 'visible amount of iceberg orders, 0 shows the whole amount';
+
+COMMENT ON COLUMN "asks"."hidden" IS 'kept out of the public depth';
+
+COMMENT ON COLUMN "asks"."group_leg" IS 'entry, take_profit or stop_loss';
+
+COMMENT ON COLUMN "asks"."priority_at" IS 'time the order joined the queue of its price';
+
+COMMENT ON COLUMN "order_groups"."type" IS 'oco or bracket';
+
+COMMENT ON COLUMN "fills"."amount" IS 'it must be positive';
+
+COMMENT ON COLUMN "users"."self_trade_prevention" IS 'default self-trade prevention mode of new orders';
+
+COMMENT ON COLUMN "bids"."owner" IS 'owner of the from account';
+
+COMMENT ON COLUMN "bids"."self_trade_prevention" IS 'cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel';
+
+COMMENT ON COLUMN "asks"."owner" IS 'owner of the from account';
+
+COMMENT ON COLUMN "asks"."self_trade_prevention" IS 'cancel_newest, cancel_oldest, cancel_both or decrement_and_cancel';
+
+COMMENT ON COLUMN "order_events"."side" IS 'bid or ask';
+
+COMMENT ON COLUMN "order_events"."type" IS 'self_trade_prevented';
+
+COMMENT ON COLUMN "order_events"."counter_order_id" IS 'order of the other side';
+
+COMMENT ON COLUMN "order_events"."amount" IS 'amount taken off the order';
+
+COMMENT ON COLUMN "dead_man_switches"."expires_at" IS 'open orders are canceled if no heartbeat arrives before';
+
+COMMENT ON COLUMN "dead_man_switch_events"."action" IS 'armed, refreshed, disarmed or fired';
+
+COMMENT ON COLUMN "dead_man_switch_events"."canceled_orders" IS 'number of orders canceled when fired';
+
+COMMENT ON COLUMN "fee_tiers"."min_volume" IS 'trailing 30-day volume in the quote currency to reach the tier';
+
+COMMENT ON COLUMN "fee_tiers"."maker_rate" IS 'basis points';
+
+COMMENT ON COLUMN "fee_tiers"."taker_rate" IS 'basis points';
+
+COMMENT ON COLUMN "users"."role" IS 'trader or admin';
+
+COMMENT ON COLUMN "currencies"."decimals" IS 'decimal places of the smallest unit of an amount';
+
+COMMENT ON COLUMN "currencies"."status" IS 'active, paused or delisted';
+
+COMMENT ON COLUMN "pairs"."tick_size" IS 'prices must be a multiple of it';
+
+COMMENT ON COLUMN "pairs"."lot_size" IS 'amounts must be a multiple of it';
+
+COMMENT ON COLUMN "pairs"."min_notional" IS 'minimum price*amount of an order in the quote currency';
+
+COMMENT ON COLUMN "pairs"."status" IS 'active, paused or delisted';
+
+COMMENT ON COLUMN "candles"."interval" IS '1m, 5m, 15m, 1h, 4h or 1d';
+
+COMMENT ON COLUMN "candles"."open_time" IS 'start of the interval, aligned to the unix epoch';
+
+COMMENT ON COLUMN "candles"."volume" IS 'traded amount of the base currency';
+
+COMMENT ON COLUMN "candles"."quote_volume" IS 'traded amount of the quote currency';
+
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -154,4 +441,38 @@ ALTER TABLE "asks" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("i
 
 ALTER TABLE "asks" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "bids" ADD FOREIGN KEY ("group_id") REFERENCES "order_groups" ("id");
+
+ALTER TABLE "asks" ADD FOREIGN KEY ("group_id") REFERENCES "order_groups" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("trade_id") REFERENCES "trades" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("bid_id") REFERENCES "bids" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("ask_id") REFERENCES "asks" ("id");
+
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "dead_man_switches" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "dead_man_switch_events" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "fee_accounts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "pairs" ADD FOREIGN KEY ("base") REFERENCES "currencies" ("code");
+
+ALTER TABLE "pairs" ADD FOREIGN KEY ("quote") REFERENCES "currencies" ("code");
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("currency") REFERENCES "currencies" ("code");
+
+ALTER TABLE "bids" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "asks" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "fee_tiers" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "fee_accounts" ADD FOREIGN KEY ("currency") REFERENCES "currencies" ("code");
+
+ALTER TABLE "trades" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
+
+ALTER TABLE "candles" ADD FOREIGN KEY ("pair") REFERENCES "pairs" ("symbol");
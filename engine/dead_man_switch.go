@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// FireDeadManSwitches cancels every open order of the users whose dead man's switch expired before now
+// and removes their switches. A switch that fails to fire is logged and left armed without holding back the others.
+// It returns the number of fired switches, along with the errors of the ones that failed joined together
+func (engine *Engine) FireDeadManSwitches(ctx context.Context, now time.Time) (int, error) {
+	deadManSwitches, err := engine.store.ListExpiredDeadManSwitches(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list expired dead man's switches: %w", err)
+	}
+
+	fired := 0
+	var errs []error
+	for _, deadManSwitch := range deadManSwitches {
+		err := engine.fire(ctx, deadManSwitch)
+		if err != nil {
+			log.Error().Err(err).Str("username", deadManSwitch.Username).Msg("cannot fire dead man's switch")
+			errs = append(errs, err)
+			continue
+		}
+		fired++
+	}
+
+	return fired, errors.Join(errs...)
+}
+
+// RunDeadManSwitchSweeper fires expired dead man's switches every interval until the context is done
+func (engine *Engine) RunDeadManSwitchSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			fired, err := engine.FireDeadManSwitches(ctx, now)
+			if err != nil {
+				log.Error().Err(err).Msg("cannot fire dead man's switches")
+			}
+			if fired > 0 {
+				log.Info().Int("fired", fired).Msg("fired dead man's switches")
+			}
+		}
+	}
+}
+
+// fire cancels the open orders of the user of a dead man's switch before removing the switch,
+// so a failure leaves it armed and the next sweep retries
+func (engine *Engine) fire(ctx context.Context, deadManSwitch db.DeadManSwitch) error {
+	result, err := engine.CancelOrders(ctx, db.CancelOrdersTxParams{Owner: deadManSwitch.Username})
+	if err != nil {
+		return fmt.Errorf("cannot cancel orders of %s: %w", deadManSwitch.Username, err)
+	}
+
+	_, err = engine.store.FireDeadManSwitchTx(ctx, db.FireDeadManSwitchTxParams{
+		DeadManSwitch:  deadManSwitch,
+		CanceledOrders: int64(len(result.Bids) + len(result.Asks)),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot fire dead man's switch of %s: %w", deadManSwitch.Username, err)
+	}
+
+	return nil
+}
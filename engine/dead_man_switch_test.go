@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomDeadManSwitch(expiresAt time.Time) db.DeadManSwitch {
+	return db.DeadManSwitch{
+		Username:       util.RandomOwner(),
+		TimeoutSeconds: 30,
+		ExpiresAt:      expiresAt,
+	}
+}
+
+func TestFireDeadManSwitches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	deadManSwitch := randomDeadManSwitch(now.Add(-time.Second))
+	bid := randomBid(100, 10)
+	ask := randomAsk(110, 10)
+	otherBid := randomBid(90, 10)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListExpiredDeadManSwitches(gomock.Any(), gomock.Eq(now)).Times(1).
+		Return([]db.DeadManSwitch{deadManSwitch}, nil)
+
+	cancelArg := db.CancelOrdersTxParams{Owner: deadManSwitch.Username}
+	fireArg := db.FireDeadManSwitchTxParams{DeadManSwitch: deadManSwitch, CanceledOrders: 2}
+	gomock.InOrder(
+		store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(cancelArg)).Times(1).
+			Return(db.CancelOrdersTxResult{Bids: []db.Bid{bid}, Asks: []db.Ask{ask}}, nil),
+		store.EXPECT().FireDeadManSwitchTx(gomock.Any(), gomock.Eq(fireArg)).Times(1),
+	)
+
+	engine := newTestEngine(store, []db.Bid{bid, otherBid}, []db.Ask{ask})
+
+	fired, err := engine.FireDeadManSwitches(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, fired)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(otherBid))
+	require.Empty(t, book.Orders(util.ASK))
+}
+
+func TestFireDeadManSwitchesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	failing := randomDeadManSwitch(time.Now().Add(-time.Second))
+	deadManSwitch := randomDeadManSwitch(time.Now().Add(-time.Second))
+	bid := randomBid(100, 10)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListExpiredDeadManSwitches(gomock.Any(), gomock.Any()).Times(1).
+		Return([]db.DeadManSwitch{failing, deadManSwitch}, nil)
+	store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(db.CancelOrdersTxParams{Owner: failing.Username})).Times(1).
+		Return(db.CancelOrdersTxResult{}, sql.ErrConnDone)
+	// the switch stays armed so the next sweep retries
+	store.EXPECT().FireDeadManSwitchTx(gomock.Any(), gomock.Eq(db.FireDeadManSwitchTxParams{DeadManSwitch: failing})).Times(0)
+
+	// the failure doesn't hold back the other switches
+	store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(db.CancelOrdersTxParams{Owner: deadManSwitch.Username})).Times(1)
+	store.EXPECT().FireDeadManSwitchTx(gomock.Any(), gomock.Eq(db.FireDeadManSwitchTxParams{DeadManSwitch: deadManSwitch})).Times(1)
+
+	engine := newTestEngine(store, []db.Bid{bid}, nil)
+
+	fired, err := engine.FireDeadManSwitches(context.Background(), time.Now())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.ErrorContains(t, err, failing.Username)
+	require.Equal(t, 1, fired)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid))
+}
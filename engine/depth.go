@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/util"
+)
+
+// PriceLevel is the displayed amount of the orders resting at a price
+type PriceLevel struct {
+	Price      decimal.Decimal `json:"price"`
+	Amount     decimal.Decimal `json:"amount"`
+	OrderCount int64           `json:"order_count"`
+}
+
+// Depth is the level 2 view of an order book, best prices first.
+// Sequence is the sequence number of the last update published on the book channel of the pair,
+// the updates that follow it apply on top of the depth
+type Depth struct {
+	Pair     string       `json:"pair"`
+	Sequence uint64       `json:"sequence"`
+	Bids     []PriceLevel `json:"bids"`
+	Asks     []PriceLevel `json:"asks"`
+}
+
+// Depth returns up to limit price levels of each side of the order book of a pair, or all of them if limit is 0.
+// Hidden orders and the reserves of iceberg orders are left out
+func (engine *Engine) Depth(pair string, limit int) (Depth, error) {
+	book, err := engine.Book(pair)
+	if err != nil {
+		return Depth{}, err
+	}
+
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	depth := book.Depth(limit)
+	depth.Sequence = engine.feed.Sequence(feed.Topic(feed.BOOK, pair))
+	return depth, nil
+}
+
+// Depth returns up to limit price levels of each side of the book, or all of them if limit is 0.
+// The caller must hold the book lock
+func (book *OrderBook) Depth(limit int) Depth {
+	return Depth{
+		Pair: book.pair,
+		Bids: book.levels(util.BID, limit),
+		Asks: book.levels(util.ASK, limit),
+	}
+}
+
+// levels aggregates the displayed amounts of a side of the book by price, up to limit levels unless it is 0
+func (book *OrderBook) levels(side string, limit int) []PriceLevel {
+	levels := []PriceLevel{}
+	for _, order := range book.orders(side) {
+		displayed := order.Displayed()
+		if displayed.IsZero() {
+			continue
+		}
+
+		last := len(levels) - 1
+		if last >= 0 && levels[last].Price.Equal(order.Price) {
+			levels[last].Amount = levels[last].Amount.Add(displayed)
+			levels[last].OrderCount++
+			continue
+		}
+
+		if limit > 0 && len(levels) == limit {
+			break
+		}
+		levels = append(levels, PriceLevel{Price: order.Price, Amount: displayed, OrderCount: 1})
+	}
+	return levels
+}
@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBookDepth(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	bid1 := randomOrder(util.BID, 100)
+	bid2 := randomOrder(util.BID, 100)
+	bid3 := randomOrder(util.BID, 90)
+	bid4 := randomOrder(util.BID, 80)
+	iceberg := randomOrder(util.ASK, 110)
+	iceberg.Amount = decimal.NewFromInt(10)
+	iceberg.DisplayAmount = decimal.NewFromInt(4)
+	hidden := randomOrder(util.ASK, 110)
+	hidden.Hidden = true
+	onlyHidden := randomOrder(util.ASK, 120)
+	onlyHidden.Hidden = true
+	ask := randomOrder(util.ASK, 130)
+
+	for _, order := range []*Order{bid1, bid2, bid3, bid4, iceberg, hidden, onlyHidden, ask} {
+		book.Add(order)
+	}
+
+	depth := book.Depth(2)
+	require.Equal(t, util.BTC_USDT, depth.Pair)
+	require.Equal(t, []PriceLevel{
+		{Price: bid1.Price, Amount: bid1.Amount.Add(bid2.Amount), OrderCount: 2},
+		{Price: bid3.Price, Amount: bid3.Amount, OrderCount: 1},
+	}, depth.Bids)
+
+	// hidden orders and the reserve of the iceberg aren't shown
+	require.Equal(t, []PriceLevel{
+		{Price: iceberg.Price, Amount: decimal.NewFromInt(4), OrderCount: 1},
+		{Price: ask.Price, Amount: ask.Amount, OrderCount: 1},
+	}, depth.Asks)
+
+	require.Empty(t, NewOrderBook(util.ETH_BTC).Depth(10).Bids)
+}
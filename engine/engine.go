@@ -0,0 +1,580 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fill is a single execution between a bid and an ask
+type Fill struct {
+	TradeID int64           `json:"trade_id"`
+	BidID   int64           `json:"bid_id"`
+	AskID   int64           `json:"ask_id"`
+	Price   decimal.Decimal `json:"price"`
+	Amount  decimal.Decimal `json:"amount"`
+}
+
+// MatchResult is the result of placing an order on the engine
+type MatchResult struct {
+	Fills      []Fill          `json:"fills"`
+	SelfTrades int             `json:"self_trades"`
+	Remaining  decimal.Decimal `json:"remaining"`
+	Resting    bool            `json:"resting"`
+}
+
+// Engine matches bids against asks with price-time priority.
+// It keeps one order book per listed pair, settles every fill through the store
+// and publishes the depth changes, trades and tickers of its books on the feed
+type Engine struct {
+	store    db.Store
+	registry *registry.Registry
+	feed     *feed.Hub
+	mu       sync.Mutex
+	books    map[string]*OrderBook
+}
+
+// NewEngine creates a matching engine with empty order books for the pairs of the registry
+func NewEngine(store db.Store, registry *registry.Registry, hub *feed.Hub) *Engine {
+	return &Engine{
+		store:    store,
+		registry: registry,
+		feed:     hub,
+		books:    make(map[string]*OrderBook),
+	}
+}
+
+// Book returns the order book of a listed pair.
+// Paused and delisted pairs keep their books, so their orders can still be canceled
+func (engine *Engine) Book(pair string) (*OrderBook, error) {
+	if !engine.registry.IsListedPair(pair) {
+		return nil, fmt.Errorf("unsupported pair: %s", pair)
+	}
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	book, ok := engine.books[pair]
+	if !ok {
+		book = NewOrderBook(pair)
+		engine.books[pair] = book
+	}
+	return book, nil
+}
+
+// Load rebuilds the order books from the open bids and asks in the database.
+// The last trade price of every pair is restored first, so pending stop orders it already crossed are triggered.
+// Orders are replayed in the order they joined their price queues, so crossed orders left behind are matched
+// and pending stop orders are triggered by the trades of the replay
+func (engine *Engine) Load(ctx context.Context) error {
+	for _, pair := range engine.registry.Pairs() {
+		trades, err := engine.store.ListPairTrades(ctx, db.ListPairTradesParams{
+			Pair:  pair.Symbol,
+			Limit: 1,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot list the last trade of %s: %w", pair.Symbol, err)
+		}
+		if len(trades) == 0 {
+			continue
+		}
+
+		book, err := engine.Book(pair.Symbol)
+		if err != nil {
+			return err
+		}
+
+		book.mu.Lock()
+		book.lastPrice = trades[0].Price.Decimal
+		book.mu.Unlock()
+	}
+
+	type pending struct {
+		order      *Order
+		status     string
+		priorityAt time.Time
+	}
+
+	orders := []pending{}
+	for _, status := range []string{util.PENDING, util.ACTIVE, util.PARTIALLY_FILLED} {
+		bids, err := engine.store.ListBidsByStatus(ctx, status)
+		if err != nil {
+			return fmt.Errorf("cannot list %s bids: %w", status, err)
+		}
+		for _, bid := range bids {
+			orders = append(orders, pending{orderFromBid(bid), bid.Status, bid.PriorityAt})
+		}
+
+		asks, err := engine.store.ListAsksByStatus(ctx, status)
+		if err != nil {
+			return fmt.Errorf("cannot list %s asks: %w", status, err)
+		}
+		for _, ask := range asks {
+			orders = append(orders, pending{orderFromAsk(ask), ask.Status, ask.PriorityAt})
+		}
+	}
+
+	sort.SliceStable(orders, func(i, j int) bool {
+		return orders[i].priorityAt.Before(orders[j].priorityAt)
+	})
+
+	for _, p := range orders {
+		if _, err := engine.place(ctx, p.order, p.status == util.PENDING); err != nil {
+			return fmt.Errorf("cannot load %s %d: %w", p.order.Side, p.order.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// PlaceBid matches a new bid against the asks of its pair and rests the remaining amount on the book.
+// Pending stop bids are kept off the book until the last trade price rises to their stop price
+func (engine *Engine) PlaceBid(ctx context.Context, bid db.Bid) (MatchResult, error) {
+	return engine.place(ctx, orderFromBid(bid), bid.Status == util.PENDING)
+}
+
+// PlaceAsk matches a new ask against the bids of its pair and rests the remaining amount on the book.
+// Pending stop asks are kept off the book until the last trade price falls to their stop price
+func (engine *Engine) PlaceAsk(ctx context.Context, ask db.Ask) (MatchResult, error) {
+	return engine.place(ctx, orderFromAsk(ask), ask.Status == util.PENDING)
+}
+
+// CancelBid takes a bid off its order book and returns its remaining amount.
+// It returns false if the bid was not resting on the book
+func (engine *Engine) CancelBid(bid db.Bid) (decimal.Decimal, bool) {
+	return engine.cancel(bid.Pair, util.BID, bid.ID)
+}
+
+// CancelAsk takes an ask off its order book and returns its remaining amount.
+// It returns false if the ask was not resting on the book
+func (engine *Engine) CancelAsk(ask db.Ask) (decimal.Decimal, bool) {
+	return engine.cancel(ask.Pair, util.ASK, ask.ID)
+}
+
+// CancelOpenBid cancels an open bid in the store and takes it off its order book while holding the book lock,
+// so it can't trade in between. A bid the store fails to cancel stays on the book, along with its funds
+func (engine *Engine) CancelOpenBid(ctx context.Context, bid db.Bid) (db.CancelBidTxResult, error) {
+	book, err := engine.Book(bid.Pair)
+	if err != nil {
+		return db.CancelBidTxResult{}, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	result, err := engine.store.CancelBidTx(ctx, bid.ID)
+	if err != nil {
+		return result, err
+	}
+
+	book.drop(util.BID, bid.ID)
+	dropLegs(book, result.Canceled)
+	return result, nil
+}
+
+// CancelOpenAsk cancels an open ask in the store and takes it off its order book while holding the book lock,
+// so it can't trade in between. An ask the store fails to cancel stays on the book, along with its funds
+func (engine *Engine) CancelOpenAsk(ctx context.Context, ask db.Ask) (db.CancelAskTxResult, error) {
+	book, err := engine.Book(ask.Pair)
+	if err != nil {
+		return db.CancelAskTxResult{}, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	result, err := engine.store.CancelAskTx(ctx, ask.ID)
+	if err != nil {
+		return result, err
+	}
+
+	book.drop(util.ASK, ask.ID)
+	dropLegs(book, result.Canceled)
+	return result, nil
+}
+
+// AmendBid changes the price and amount of an open bid in the store and on its order book while holding the book lock.
+// Reducing the amount keeps the place of the bid in its queue. Changing the price or increasing the amount
+// queues the bid again behind the orders at its price, where it may trade right away
+func (engine *Engine) AmendBid(ctx context.Context, bid db.Bid, price decimal.Decimal, amount decimal.Decimal) (db.AmendBidTxResult, MatchResult, error) {
+	book, err := engine.Book(bid.Pair)
+	if err != nil {
+		return db.AmendBidTxResult{}, MatchResult{Fills: []Fill{}}, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	result, err := engine.store.AmendBidTx(ctx, db.AmendBidParams{
+		ID:     bid.ID,
+		Price:  price,
+		Amount: amount,
+	})
+	if err != nil {
+		return result, MatchResult{Fills: []Fill{}}, err
+	}
+
+	match, err := engine.amend(ctx, book, orderFromBid(result.Bid), result.Bid.Status == util.PENDING)
+	return result, match, err
+}
+
+// AmendAsk changes the price and amount of an open ask in the store and on its order book while holding the book lock.
+// Reducing the amount keeps the place of the ask in its queue. Changing the price or increasing the amount
+// queues the ask again behind the orders at its price, where it may trade right away
+func (engine *Engine) AmendAsk(ctx context.Context, ask db.Ask, price decimal.Decimal, amount decimal.Decimal) (db.AmendAskTxResult, MatchResult, error) {
+	book, err := engine.Book(ask.Pair)
+	if err != nil {
+		return db.AmendAskTxResult{}, MatchResult{Fills: []Fill{}}, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	result, err := engine.store.AmendAskTx(ctx, db.AmendAskParams{
+		ID:     ask.ID,
+		Price:  price,
+		Amount: amount,
+	})
+	if err != nil {
+		return result, MatchResult{Fills: []Fill{}}, err
+	}
+
+	match, err := engine.amend(ctx, book, orderFromAsk(result.Ask), result.Ask.Status == util.PENDING)
+	return result, match, err
+}
+
+// amend puts an amended order on the book. The caller must hold the book lock
+func (engine *Engine) amend(ctx context.Context, book *OrderBook, amended *Order, pending bool) (MatchResult, error) {
+	order, ok := book.find(amended.Side, amended.ID)
+	if ok && order.Price.Equal(amended.Price) && order.Amount.GreaterThanOrEqual(amended.Amount) {
+		resize(order, amended.Amount)
+		return MatchResult{Fills: []Fill{}, Remaining: order.Amount, Resting: true}, nil
+	}
+
+	book.drop(amended.Side, amended.ID)
+
+	result, err := engine.enter(ctx, book, amended, pending)
+	if err != nil {
+		return result, err
+	}
+	return result, engine.cascade(ctx, book)
+}
+
+// CancelOrders cancels the open orders of an owner that match the filters in the store and takes them off their order books.
+// The books are locked while the store cancels the orders, so none of them can trade in between
+func (engine *Engine) CancelOrders(ctx context.Context, arg db.CancelOrdersTxParams) (db.CancelOrdersTxResult, error) {
+	books, err := engine.lockBooks(arg.Pair)
+	if err != nil {
+		return db.CancelOrdersTxResult{}, err
+	}
+	defer func() {
+		for _, book := range books {
+			engine.release(book)
+		}
+	}()
+
+	result, err := engine.store.CancelOrdersTx(ctx, arg)
+	if err != nil {
+		return result, err
+	}
+
+	for _, bid := range result.Bids {
+		if book, ok := books[bid.Pair]; ok {
+			book.drop(util.BID, bid.ID)
+		} else {
+			engine.CancelBid(bid)
+		}
+	}
+	for _, ask := range result.Asks {
+		if book, ok := books[ask.Pair]; ok {
+			book.drop(util.ASK, ask.ID)
+		} else {
+			engine.CancelAsk(ask)
+		}
+	}
+	return result, nil
+}
+
+// lockBooks locks the order book of a pair, or every order book if the pair is empty, in pair order
+func (engine *Engine) lockBooks(pair string) (map[string]*OrderBook, error) {
+	books := map[string]*OrderBook{}
+	if pair != "" {
+		book, err := engine.Book(pair)
+		if err != nil {
+			return nil, err
+		}
+		books[pair] = book
+	} else {
+		engine.mu.Lock()
+		for pair, book := range engine.books {
+			books[pair] = book
+		}
+		engine.mu.Unlock()
+	}
+
+	pairs := make([]string, 0, len(books))
+	for pair := range books {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	for _, pair := range pairs {
+		books[pair].mu.Lock()
+	}
+	return books, nil
+}
+
+// CancelLegs takes the legs of order groups off their order books
+func (engine *Engine) CancelLegs(legs db.OrderGroupLegs) {
+	for _, bid := range legs.Bids {
+		engine.CancelBid(bid)
+	}
+	for _, ask := range legs.Asks {
+		engine.CancelAsk(ask)
+	}
+}
+
+func (engine *Engine) cancel(pair string, side string, id int64) (decimal.Decimal, bool) {
+	book, err := engine.Book(pair)
+	if err != nil {
+		return decimal.Zero, false
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	order, ok := book.drop(side, id)
+	if !ok {
+		return decimal.Zero, false
+	}
+	return order.Amount, true
+}
+
+func (engine *Engine) place(ctx context.Context, order *Order, pending bool) (MatchResult, error) {
+	book, err := engine.Book(order.Pair)
+	if err != nil {
+		return MatchResult{Fills: []Fill{}}, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	result, err := engine.enter(ctx, book, order, pending)
+	if err != nil {
+		return result, err
+	}
+
+	return result, engine.cascade(ctx, book)
+}
+
+// enter matches an order, a pending stop order is kept off the book unless the last trade price triggers it.
+// The caller must hold the book lock
+func (engine *Engine) enter(ctx context.Context, book *OrderBook, order *Order, pending bool) (MatchResult, error) {
+	result := MatchResult{Fills: []Fill{}}
+
+	if pending {
+		if book.lastPrice.IsZero() || !triggers(order, book.lastPrice) {
+			book.AddStop(order)
+			result.Remaining = order.Amount
+			result.Resting = true
+			return result, nil
+		}
+
+		if err := engine.trigger(ctx, order); err != nil {
+			result.Remaining = order.Amount
+			return result, err
+		}
+	}
+
+	return engine.match(ctx, book, order)
+}
+
+// match trades the order against the book and rests its remaining amount unless its time in force forbids it.
+// The caller must hold the book lock
+func (engine *Engine) match(ctx context.Context, book *OrderBook, order *Order) (MatchResult, error) {
+	result := MatchResult{Fills: []Fill{}}
+
+	opposite := util.ASK
+	if order.Side == util.ASK {
+		opposite = util.BID
+	}
+
+	// post only orders are canceled instead of taking liquidity
+	if order.PostOnly && book.Crosses(order) {
+		result.Remaining = order.Amount
+		return result, engine.cancelRemaining(ctx, book, order)
+	}
+
+	// fill or kill orders are canceled without any fill unless the book can fill them completely
+	if order.TimeInForce == util.FOK && !book.Fillable(order) {
+		result.Remaining = order.Amount
+		return result, engine.cancelRemaining(ctx, book, order)
+	}
+
+	for order.Amount.IsPositive() && book.Crosses(order) {
+		maker := book.Best(opposite)
+
+		if selfTrade(order, maker) {
+			canceled, err := engine.preventSelfTrade(ctx, book, order, maker)
+			if err != nil {
+				result.Remaining = order.Amount
+				return result, err
+			}
+			result.SelfTrades++
+
+			if canceled {
+				result.Remaining = order.Amount
+				return result, nil
+			}
+			continue
+		}
+
+		fill, err := engine.settle(ctx, book, order, maker)
+		if err != nil {
+			result.Remaining = order.Amount
+			return result, err
+		}
+		result.Fills = append(result.Fills, fill)
+		book.lastPrice = fill.Price
+		book.stats.record(time.Now(), fill.Price, fill.Amount)
+
+		order.Amount = order.Amount.Sub(fill.Amount)
+		book.Reduce(maker, fill.Amount)
+	}
+
+	result.Remaining = order.Amount
+	if order.Amount.IsZero() {
+		return result, nil
+	}
+
+	// market, immediate or cancel and fill or kill orders never rest on the book
+	if order.Type == util.MARKET || order.TimeInForce == util.IOC || order.TimeInForce == util.FOK {
+		return result, engine.cancelRemaining(ctx, book, order)
+	}
+
+	book.Add(order)
+	result.Resting = true
+	return result, nil
+}
+
+// cancelRemaining cancels an order that can't rest on the book and releases the funds held for its remaining amount.
+// The caller must hold the book lock
+func (engine *Engine) cancelRemaining(ctx context.Context, book *OrderBook, order *Order) error {
+	var canceled db.OrderGroupLegs
+	if order.Side == util.BID {
+		result, err := engine.store.CancelBidTx(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("cannot cancel %s %d: %w", order.Side, order.ID, err)
+		}
+		canceled = result.Canceled
+	} else {
+		result, err := engine.store.CancelAskTx(ctx, order.ID)
+		if err != nil {
+			return fmt.Errorf("cannot cancel %s %d: %w", order.Side, order.ID, err)
+		}
+		canceled = result.Canceled
+	}
+
+	dropLegs(book, canceled)
+	return nil
+}
+
+// settle executes a fill between the taker and the visible amount of the maker at the maker price.
+// The store moves the funds held by both orders and updates their filled amounts.
+// The legs of order groups it cancels are dropped from the book and the legs it activates are queued
+func (engine *Engine) settle(ctx context.Context, book *OrderBook, taker *Order, maker *Order) (Fill, error) {
+	bid, ask := taker, maker
+	if taker.Side == util.ASK {
+		bid, ask = maker, taker
+	}
+
+	amount := decimal.Min(taker.Amount, maker.Visible())
+	price := maker.Price
+
+	result, err := engine.store.FillTx(ctx, db.FillTxParams{
+		BidID:     bid.ID,
+		AskID:     ask.ID,
+		Price:     price,
+		Amount:    amount,
+		TakerSide: taker.Side,
+	})
+	if err != nil {
+		return Fill{}, fmt.Errorf("cannot settle bid %d against ask %d: %w", bid.ID, ask.ID, err)
+	}
+
+	book.trades = append(book.trades, NewMarketTrade(result.Trade))
+
+	dropLegs(book, result.Canceled)
+	for _, bid := range result.Activated.Bids {
+		book.activated = append(book.activated, orderFromBid(bid))
+	}
+	for _, ask := range result.Activated.Asks {
+		book.activated = append(book.activated, orderFromAsk(ask))
+	}
+
+	fill := Fill{
+		TradeID: result.Trade.ID,
+		BidID:   bid.ID,
+		AskID:   ask.ID,
+		Price:   price,
+		Amount:  amount,
+	}
+	return fill, nil
+}
+
+// dropLegs takes the canceled legs of order groups off the book
+func dropLegs(book *OrderBook, legs db.OrderGroupLegs) {
+	for _, bid := range legs.Bids {
+		book.drop(util.BID, bid.ID)
+	}
+	for _, ask := range legs.Asks {
+		book.drop(util.ASK, ask.ID)
+	}
+}
+
+func orderFromBid(bid db.Bid) *Order {
+	return &Order{
+		ID:                  bid.ID,
+		Pair:                bid.Pair,
+		Side:                util.BID,
+		Type:                bid.Type,
+		TimeInForce:         bid.TimeInForce,
+		FromAccountID:       bid.FromAccountID,
+		ToAccountID:         bid.ToAccountID,
+		Price:               bid.Price,
+		Amount:              bid.RemainingAmount,
+		StopPrice:           bid.StopPrice,
+		PostOnly:            bid.PostOnly,
+		DisplayAmount:       bid.DisplayAmount,
+		Hidden:              bid.Hidden,
+		Owner:               bid.Owner,
+		SelfTradePrevention: bid.SelfTradePrevention,
+	}
+}
+
+func orderFromAsk(ask db.Ask) *Order {
+	return &Order{
+		ID:                  ask.ID,
+		Pair:                ask.Pair,
+		Side:                util.ASK,
+		Type:                ask.Type,
+		TimeInForce:         ask.TimeInForce,
+		FromAccountID:       ask.FromAccountID,
+		ToAccountID:         ask.ToAccountID,
+		Price:               ask.Price,
+		Amount:              ask.RemainingAmount,
+		StopPrice:           ask.StopPrice,
+		PostOnly:            ask.PostOnly,
+		DisplayAmount:       ask.DisplayAmount,
+		Hidden:              ask.Hidden,
+		Owner:               ask.Owner,
+		SelfTradePrevention: ask.SelfTradePrevention,
+	}
+}
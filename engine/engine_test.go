@@ -0,0 +1,655 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomBid(price int64, amount int64) db.Bid {
+	lastOrderID++
+	return db.Bid{
+		ID:              lastOrderID,
+		Pair:            util.BTC_USDT,
+		FromAccountID:   util.RandomInt(1, 1000),
+		ToAccountID:     util.RandomInt(1, 1000),
+		Price:           decimal.NewFromInt(price),
+		Amount:          decimal.NewFromInt(amount),
+		Status:          util.ACTIVE,
+		Type:            util.LIMIT,
+		TimeInForce:     util.GTC,
+		RemainingAmount: decimal.NewFromInt(amount),
+		CreatedAt:       time.Now(),
+		PriorityAt:      time.Now(),
+	}
+}
+
+func randomAsk(price int64, amount int64) db.Ask {
+	lastOrderID++
+	return db.Ask{
+		ID:              lastOrderID,
+		Pair:            util.BTC_USDT,
+		FromAccountID:   util.RandomInt(1, 1000),
+		ToAccountID:     util.RandomInt(1, 1000),
+		Price:           decimal.NewFromInt(price),
+		Amount:          decimal.NewFromInt(amount),
+		Status:          util.ACTIVE,
+		Type:            util.LIMIT,
+		TimeInForce:     util.GTC,
+		RemainingAmount: decimal.NewFromInt(amount),
+		CreatedAt:       time.Now(),
+		PriorityAt:      time.Now(),
+	}
+}
+
+func expectFill(store *mockdb.MockStore, takerSide string, bid db.Bid, ask db.Ask, price decimal.Decimal, amount decimal.Decimal) *gomock.Call {
+	arg := db.FillTxParams{
+		BidID:     bid.ID,
+		AskID:     ask.ID,
+		Price:     price,
+		Amount:    amount,
+		TakerSide: takerSide,
+	}
+
+	return store.EXPECT().FillTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.FillTxResult{}, nil)
+}
+
+func newTestRegistry() *registry.Registry {
+	registry := registry.NewRegistry()
+	for _, code := range []string{util.BTC, util.ETH, util.USDT} {
+		registry.SetCurrency(db.Currency{Code: code, Status: util.ACTIVE})
+	}
+	for _, symbol := range []string{util.BTC_USDT, util.ETH_USDT, util.ETH_BTC} {
+		base, quote := util.CurrenciesFromPair(symbol)
+		registry.SetPair(db.Pair{Symbol: symbol, Base: base, Quote: quote, TickSize: decimal.NewFromInt(1), LotSize: decimal.NewFromInt(1), Status: util.ACTIVE})
+	}
+	return registry
+}
+
+func newTestEngine(store db.Store, bids []db.Bid, asks []db.Ask) *Engine {
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+
+	book, _ := engine.Book(util.BTC_USDT)
+	for _, bid := range bids {
+		book.Add(orderFromBid(bid))
+	}
+	for _, ask := range asks {
+		book.Add(orderFromAsk(ask))
+	}
+
+	return engine
+}
+
+func TestPlaceOrder(t *testing.T) {
+	bid1 := randomBid(100, 5)
+	bid2 := randomBid(110, 5)
+	bid3 := randomBid(110, 5)
+	ask1 := randomAsk(100, 10)
+	ask2 := randomAsk(100, 4)
+
+	iocBid := randomBid(110, 5)
+	iocBid.TimeInForce = util.IOC
+	fokBid := randomBid(110, 5)
+	fokBid.TimeInForce = util.FOK
+	postOnlyBid := randomBid(110, 5)
+	postOnlyBid.PostOnly = true
+	icebergAsk := randomAsk(100, 10)
+	icebergAsk.DisplayAmount = decimal.NewFromInt(3)
+	otherAsk := randomAsk(100, 10)
+
+	testCases := []struct {
+		name          string
+		bids          []db.Bid
+		asks          []db.Ask
+		buildStubs    func(store *mockdb.MockStore)
+		place         func(engine *Engine) (MatchResult, error)
+		checkResponse func(t *testing.T, book *OrderBook, result MatchResult, err error)
+	}{
+		{
+			name: "NoCross",
+			asks: []db.Ask{randomAsk(110, 10)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), bid1)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, result.Fills)
+				require.Equal(t, bid1.Amount, result.Remaining)
+				require.True(t, result.Resting)
+				require.Len(t, book.Orders(util.BID), 1)
+				require.Len(t, book.Orders(util.ASK), 1)
+			},
+		},
+		{
+			name: "FillAtMakerPrice",
+			asks: []db.Ask{ask1},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectFill(store, util.BID, bid2, ask1, ask1.Price, bid2.Amount)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), bid2)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 1)
+				require.Equal(t, ask1.Price, result.Fills[0].Price)
+				require.Equal(t, bid2.Amount, result.Fills[0].Amount)
+				require.Zero(t, result.Remaining)
+
+				require.Empty(t, book.Orders(util.BID))
+				require.Equal(t, ask1.Amount.Sub(bid2.Amount), book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "SweepInPriority",
+			bids: []db.Bid{bid1, bid2, bid3},
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					expectFill(store, util.ASK, bid2, ask1, bid2.Price, bid2.Amount),
+					expectFill(store, util.ASK, bid3, ask1, bid3.Price, bid3.Amount),
+				)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceAsk(context.Background(), ask1)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 2)
+				require.Equal(t, bid2.ID, result.Fills[0].BidID)
+				require.Equal(t, bid3.ID, result.Fills[1].BidID)
+				require.Equal(t, bid2.Price, result.Fills[0].Price)
+				require.Zero(t, result.Remaining)
+
+				resting := book.Orders(util.BID)
+				require.Len(t, resting, 1)
+				require.Equal(t, bid1.ID, resting[0].ID)
+				require.Empty(t, book.Orders(util.ASK))
+			},
+		},
+		{
+			name: "PartialFillRests",
+			asks: []db.Ask{ask2},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectFill(store, util.BID, bid2, ask2, ask2.Price, ask2.Amount)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), bid2)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 1)
+				require.Equal(t, bid2.Amount.Sub(ask2.Amount), result.Remaining)
+
+				require.Empty(t, book.Orders(util.ASK))
+				require.Equal(t, bid2.ID, book.Best(util.BID).ID)
+				require.Equal(t, bid2.Amount.Sub(ask2.Amount), book.Best(util.BID).Amount)
+			},
+		},
+		{
+			name: "ImmediateOrCancel",
+			asks: []db.Ask{ask2},
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					expectFill(store, util.BID, iocBid, ask2, ask2.Price, ask2.Amount),
+					store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(iocBid.ID)).Times(1),
+				)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), iocBid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 1)
+				require.Equal(t, iocBid.Amount.Sub(ask2.Amount), result.Remaining)
+				require.False(t, result.Resting)
+				require.Empty(t, book.Orders(util.BID))
+				require.Empty(t, book.Orders(util.ASK))
+			},
+		},
+		{
+			name: "FillOrKill",
+			asks: []db.Ask{ask1},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectFill(store, util.BID, fokBid, ask1, ask1.Price, fokBid.Amount)
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), fokBid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 1)
+				require.Zero(t, result.Remaining)
+				require.Equal(t, ask1.Amount.Sub(fokBid.Amount), book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "FillOrKillNotFillable",
+			asks: []db.Ask{ask2},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(fokBid.ID)).Times(1)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), fokBid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, result.Fills)
+				require.Equal(t, fokBid.Amount, result.Remaining)
+				require.False(t, result.Resting)
+				require.Empty(t, book.Orders(util.BID))
+				require.Equal(t, ask2.Amount, book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "PostOnlyRejected",
+			asks: []db.Ask{ask1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(postOnlyBid.ID)).Times(1)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), postOnlyBid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, result.Fills)
+				require.False(t, result.Resting)
+				require.Empty(t, book.Orders(util.BID))
+				require.Equal(t, ask1.Amount, book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "PostOnlyRests",
+			asks: []db.Ask{randomAsk(120, 10)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelBidTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), postOnlyBid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.True(t, result.Resting)
+				require.Equal(t, postOnlyBid.ID, book.Best(util.BID).ID)
+			},
+		},
+		{
+			name: "IcebergRefill",
+			asks: []db.Ask{icebergAsk, otherAsk},
+			buildStubs: func(store *mockdb.MockStore) {
+				// the iceberg only shows 3, then its refill goes behind the other ask
+				gomock.InOrder(
+					expectFill(store, util.BID, bid2, icebergAsk, icebergAsk.Price, icebergAsk.DisplayAmount),
+					expectFill(store, util.BID, bid2, otherAsk, otherAsk.Price, bid2.Amount.Sub(icebergAsk.DisplayAmount)),
+				)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), bid2)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.NoError(t, err)
+				require.Len(t, result.Fills, 2)
+				require.Zero(t, result.Remaining)
+
+				resting := book.Orders(util.ASK)
+				require.Len(t, resting, 2)
+				require.Equal(t, otherAsk.ID, resting[0].ID)
+				require.Equal(t, icebergAsk.ID, resting[1].ID)
+				require.Equal(t, icebergAsk.Amount.Sub(icebergAsk.DisplayAmount), resting[1].Amount)
+				require.Equal(t, icebergAsk.DisplayAmount, resting[1].Visible())
+			},
+		},
+		{
+			name: "SettlementError",
+			asks: []db.Ask{ask1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(1).Return(db.FillTxResult{}, sql.ErrConnDone)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				return engine.PlaceBid(context.Background(), bid2)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.Empty(t, result.Fills)
+				require.Equal(t, ask1.Amount, book.Best(util.ASK).Amount)
+			},
+		},
+		{
+			name: "UnsupportedPair",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			place: func(engine *Engine) (MatchResult, error) {
+				bid := randomBid(100, 10)
+				bid.Pair = "XXX/YYY"
+				return engine.PlaceBid(context.Background(), bid)
+			},
+			checkResponse: func(t *testing.T, book *OrderBook, result MatchResult, err error) {
+				require.Error(t, err)
+				require.Empty(t, book.Orders(util.BID))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			engine := newTestEngine(store, tc.bids, tc.asks)
+			result, err := tc.place(engine)
+
+			book, _ := engine.Book(util.BTC_USDT)
+			tc.checkResponse(t, book, result, err)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bid := randomBid(100, 10)
+	ask := randomAsk(90, 4)
+	ask.PriorityAt = bid.PriorityAt.Add(time.Second)
+	otherAsk := randomAsk(120, 10)
+	otherAsk.Status = util.PARTIALLY_FILLED
+	otherAsk.FilledAmount = decimal.NewFromInt(6)
+	otherAsk.RemainingAmount = decimal.NewFromInt(4)
+	stopAsk := randomAsk(95, 2)
+	stopAsk.Type = util.STOP_LIMIT
+	stopAsk.Status = util.PENDING
+	stopAsk.StopPrice = decimal.NewFromInt(100)
+	stopAsk.PriorityAt = bid.PriorityAt.Add(-time.Second)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).AnyTimes().Return([]db.Trade{}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Eq(util.PENDING)).Times(1).Return([]db.Bid{}, nil)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Eq(util.PENDING)).Times(1).Return([]db.Ask{stopAsk}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Eq(util.ACTIVE)).Times(1).Return([]db.Bid{bid}, nil)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Eq(util.ACTIVE)).Times(1).Return([]db.Ask{ask}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Eq(util.PARTIALLY_FILLED)).Times(1).Return([]db.Bid{}, nil)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Eq(util.PARTIALLY_FILLED)).Times(1).Return([]db.Ask{otherAsk}, nil)
+
+	// the crossed ask is newer, so it trades at the bid price and its trade triggers the stop ask
+	gomock.InOrder(
+		expectFill(store, util.ASK, bid, ask, bid.Price, ask.Amount),
+		store.EXPECT().TriggerAsk(gomock.Any(), gomock.Eq(stopAsk.ID)).Times(1),
+		expectFill(store, util.ASK, bid, stopAsk, bid.Price, stopAsk.Amount),
+	)
+
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+	err := engine.Load(context.Background())
+	require.NoError(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Equal(t, bid.Amount.Sub(ask.Amount).Sub(stopAsk.Amount), book.Best(util.BID).Amount)
+	require.Equal(t, otherAsk.ID, book.Best(util.ASK).ID)
+	require.Equal(t, otherAsk.RemainingAmount, book.Best(util.ASK).Amount)
+	require.Empty(t, book.Stops())
+	require.Equal(t, bid.Price, book.LastPrice())
+}
+
+func TestLoadLastPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the last trade before the restart crossed the stop price of the ask but not the one of the bid
+	stopAsk := randomAsk(95, 2)
+	stopAsk.Type = util.STOP_LIMIT
+	stopAsk.Status = util.PENDING
+	stopAsk.StopPrice = decimal.NewFromInt(95)
+	stopBid := randomBid(110, 2)
+	stopBid.Type = util.STOP_LIMIT
+	stopBid.Status = util.PENDING
+	stopBid.StopPrice = decimal.NewFromInt(110)
+	lastTrade := db.Trade{Pair: sql.NullString{String: util.BTC_USDT, Valid: true}, Price: decimal.NullDecimal{Decimal: decimal.NewFromInt(90), Valid: true}}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListPairTrades(gomock.Any(), gomock.Eq(db.ListPairTradesParams{Pair: util.BTC_USDT, Limit: 1})).Times(1).Return([]db.Trade{lastTrade}, nil)
+	store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).Times(2).Return([]db.Trade{}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Eq(util.PENDING)).Times(1).Return([]db.Bid{stopBid}, nil)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Eq(util.PENDING)).Times(1).Return([]db.Ask{stopAsk}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Any()).Times(2).Return([]db.Bid{}, nil)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Any()).Times(2).Return([]db.Ask{}, nil)
+	store.EXPECT().TriggerAsk(gomock.Any(), gomock.Eq(stopAsk.ID)).Times(1)
+	store.EXPECT().TriggerBid(gomock.Any(), gomock.Any()).Times(0)
+
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+	err := engine.Load(context.Background())
+	require.NoError(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(90), book.LastPrice())
+	require.Equal(t, stopAsk.ID, book.Best(util.ASK).ID)
+	require.Len(t, book.Stops(), 1)
+	require.Equal(t, stopBid.ID, book.Stops()[0].ID)
+}
+
+func TestLoadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListPairTrades(gomock.Any(), gomock.Any()).AnyTimes().Return([]db.Trade{}, nil)
+	store.EXPECT().ListBidsByStatus(gomock.Any(), gomock.Any()).Times(1).Return([]db.Bid{}, sql.ErrConnDone)
+	store.EXPECT().ListAsksByStatus(gomock.Any(), gomock.Any()).Times(0)
+
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+	err := engine.Load(context.Background())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+}
+
+func TestCancelOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bid := randomBid(100, 10)
+	ask := randomAsk(110, 10)
+
+	engine := newTestEngine(mockdb.NewMockStore(ctrl), []db.Bid{bid}, []db.Ask{ask})
+
+	remaining, ok := engine.CancelBid(bid)
+	require.True(t, ok)
+	require.Equal(t, bid.Amount, remaining)
+
+	_, ok = engine.CancelBid(bid)
+	require.False(t, ok)
+
+	remaining, ok = engine.CancelAsk(ask)
+	require.True(t, ok)
+	require.Equal(t, ask.Amount, remaining)
+
+	_, ok = engine.CancelAsk(ask)
+	require.False(t, ok)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Orders(util.BID))
+	require.Empty(t, book.Orders(util.ASK))
+}
+
+func TestCancelOpenOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bid := randomBid(100, 10)
+	takeProfit := randomAsk(120, 10)
+	stopLoss := randomAsk(110, 10)
+	otherAsk := randomAsk(130, 10)
+
+	store := mockdb.NewMockStore(ctrl)
+	gomock.InOrder(
+		store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(db.CancelBidTxResult{}, sql.ErrConnDone),
+		store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(db.CancelBidTxResult{Bid: bid}, nil),
+	)
+	store.EXPECT().CancelAskTx(gomock.Any(), gomock.Eq(takeProfit.ID)).Times(1).
+		Return(db.CancelAskTxResult{Ask: takeProfit, Canceled: db.OrderGroupLegs{Asks: []db.Ask{stopLoss}}}, nil)
+
+	engine := newTestEngine(store, []db.Bid{bid}, []db.Ask{takeProfit, stopLoss, otherAsk})
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+
+	// a bid the store fails to cancel keeps its place on the book
+	_, err = engine.CancelOpenBid(context.Background(), bid)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid))
+
+	result, err := engine.CancelOpenBid(context.Background(), bid)
+	require.NoError(t, err)
+	require.Equal(t, bid.ID, result.Bid.ID)
+	require.Empty(t, book.Orders(util.BID))
+
+	// the legs of its order group canceled with an ask are taken off the book too
+	_, err = engine.CancelOpenAsk(context.Background(), takeProfit)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(otherAsk))
+}
+
+func TestAmendOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid1 := randomBid(100, 5)
+	bid2 := randomBid(100, 5)
+	ask := randomAsk(110, 2)
+	engine := newTestEngine(store, []db.Bid{bid1, bid2}, []db.Ask{ask})
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+
+	amend := func(bid db.Bid, price decimal.Decimal, amount decimal.Decimal) db.Bid {
+		bid.Price = price
+		bid.Amount = amount
+		bid.RemainingAmount = amount
+		store.EXPECT().AmendBidTx(gomock.Any(), gomock.Eq(db.AmendBidParams{ID: bid.ID, Price: price, Amount: amount})).Times(1).
+			Return(db.AmendBidTxResult{Bid: bid}, nil)
+		return bid
+	}
+
+	// reducing the amount keeps the place of the bid in its queue
+	amend(bid1, bid1.Price, decimal.NewFromInt(3))
+	_, match, err := engine.AmendBid(context.Background(), bid1, bid1.Price, decimal.NewFromInt(3))
+	require.NoError(t, err)
+	require.True(t, match.Resting)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid1), orderFromBid(bid2))
+	require.Equal(t, decimal.NewFromInt(3), book.Best(util.BID).Amount)
+
+	// increasing it queues the bid again
+	amend(bid1, bid1.Price, decimal.NewFromInt(6))
+	_, _, err = engine.AmendBid(context.Background(), bid1, bid1.Price, decimal.NewFromInt(6))
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid2), orderFromBid(bid1))
+
+	// a new price can trade right away
+	amended := amend(bid2, decimal.NewFromInt(110), bid2.Amount)
+	expectFill(store, util.BID, amended, ask, ask.Price, ask.Amount)
+
+	_, match, err = engine.AmendBid(context.Background(), bid2, decimal.NewFromInt(110), bid2.Amount)
+	require.NoError(t, err)
+	require.Len(t, match.Fills, 1)
+	require.Equal(t, bid2.Amount.Sub(ask.Amount), match.Remaining)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid2), orderFromBid(bid1))
+	require.Empty(t, book.Orders(util.ASK))
+}
+
+func TestAmendOrderError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	ask := randomAsk(110, 5)
+	engine := newTestEngine(store, nil, []db.Ask{ask})
+
+	// the ask was closed in the meantime, so the book is left as is
+	store.EXPECT().AmendAskTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AmendAskTxResult{}, sql.ErrNoRows)
+
+	_, _, err := engine.AmendAsk(context.Background(), ask, decimal.NewFromInt(100), decimal.NewFromInt(5))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Equal(t, ask.Price, book.Best(util.ASK).Price)
+}
+
+func TestCancelOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid := randomBid(100, 10)
+	ask := randomAsk(110, 10)
+	otherAsk := randomAsk(120, 10)
+	stopBid := randomStopBid(util.STOP_LIMIT, 130, 120, 2)
+	ethAsk := randomAsk(50, 5)
+	ethAsk.Pair = util.ETH_USDT
+
+	engine := newTestEngine(store, []db.Bid{bid}, []db.Ask{ask, otherAsk})
+	_, err := engine.PlaceBid(context.Background(), stopBid)
+	require.NoError(t, err)
+
+	arg := db.CancelOrdersTxParams{Owner: util.RandomOwner()}
+	store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+		Return(db.CancelOrdersTxResult{Bids: []db.Bid{bid, stopBid}, Asks: []db.Ask{ask, ethAsk}}, nil)
+
+	// the ask of the other pair was never placed, so it is skipped
+	result, err := engine.CancelOrders(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, result.Bids, 2)
+	require.Len(t, result.Asks, 2)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Orders(util.BID))
+	require.Empty(t, book.Stops())
+	requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(otherAsk))
+}
+
+func TestCancelOrdersError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid := randomBid(100, 10)
+	engine := newTestEngine(store, []db.Bid{bid}, nil)
+
+	store.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CancelOrdersTxResult{}, sql.ErrConnDone)
+
+	_, err := engine.CancelOrders(context.Background(), db.CancelOrdersTxParams{Owner: util.RandomOwner(), Pair: util.BTC_USDT})
+	require.ErrorIs(t, err, sql.ErrConnDone)
+
+	_, err = engine.CancelOrders(context.Background(), db.CancelOrdersTxParams{Owner: util.RandomOwner(), Pair: "invalid"})
+	require.Error(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid))
+}
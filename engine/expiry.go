@@ -0,0 +1,106 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExpireOrders takes the good till date orders that expired before now off their order books
+// and releases the funds held for their remaining amount. An order that fails to expire is logged and left open
+// without holding back the others. It returns the number of expired orders, along with the errors of the ones that failed joined together
+func (engine *Engine) ExpireOrders(ctx context.Context, now time.Time) (int, error) {
+	bids, err := engine.store.ListExpiredBids(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list expired bids: %w", err)
+	}
+
+	asks, err := engine.store.ListExpiredAsks(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("cannot list expired asks: %w", err)
+	}
+
+	orders := make([]*Order, 0, len(bids)+len(asks))
+	for _, bid := range bids {
+		orders = append(orders, orderFromBid(bid))
+	}
+	for _, ask := range asks {
+		orders = append(orders, orderFromAsk(ask))
+	}
+
+	expired := 0
+	var errs []error
+	for _, order := range orders {
+		ok, err := engine.expire(ctx, order)
+		if err != nil {
+			log.Error().Err(err).Str("side", order.Side).Int64("id", order.ID).Msg("cannot expire order")
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// RunExpirySweeper expires orders every interval until the context is done
+func (engine *Engine) RunExpirySweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			expired, err := engine.ExpireOrders(ctx, now)
+			if err != nil {
+				log.Error().Err(err).Msg("cannot expire orders")
+			}
+			if expired > 0 {
+				log.Info().Int("expired", expired).Msg("expired orders")
+			}
+		}
+	}
+}
+
+// expire takes an order off its book and expires it in the store while holding the book lock,
+// so it can't be matched in between. Orders closed in the meantime are skipped
+func (engine *Engine) expire(ctx context.Context, order *Order) (bool, error) {
+	book, err := engine.Book(order.Pair)
+	if err != nil {
+		return false, err
+	}
+
+	book.mu.Lock()
+	defer engine.release(book)
+
+	var canceled db.OrderGroupLegs
+	if order.Side == util.BID {
+		var result db.CancelBidTxResult
+		result, err = engine.store.ExpireBidTx(ctx, order.ID)
+		canceled = result.Canceled
+	} else {
+		var result db.CancelAskTxResult
+		result, err = engine.store.ExpireAskTx(ctx, order.ID)
+		canceled = result.Canceled
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot expire %s %d: %w", order.Side, order.ID, err)
+	}
+
+	book.drop(order.Side, order.ID)
+	dropLegs(book, canceled)
+	return true, nil
+}
@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	bid := randomBid(100, 10)
+	bid.TimeInForce = util.GTD
+	bid.ExpiresAt = sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+	ask := randomAsk(110, 10)
+	ask.TimeInForce = util.GTD
+	ask.ExpiresAt = sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+	otherBid := randomBid(90, 10)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListExpiredBids(gomock.Any(), gomock.Eq(now)).Times(1).Return([]db.Bid{bid}, nil)
+	store.EXPECT().ListExpiredAsks(gomock.Any(), gomock.Eq(now)).Times(1).Return([]db.Ask{ask}, nil)
+	store.EXPECT().ExpireBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1)
+	// the ask was closed after it was listed
+	store.EXPECT().ExpireAskTx(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(db.CancelAskTxResult{}, sql.ErrNoRows)
+
+	engine := newTestEngine(store, []db.Bid{bid, otherBid}, []db.Ask{ask})
+
+	expired, err := engine.ExpireOrders(context.Background(), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, expired)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(otherBid))
+	requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(ask))
+}
+
+func TestExpireOrdersError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bid := randomBid(100, 10)
+	ask := randomAsk(110, 10)
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListExpiredBids(gomock.Any(), gomock.Any()).Times(1).Return([]db.Bid{bid}, nil)
+	store.EXPECT().ListExpiredAsks(gomock.Any(), gomock.Any()).Times(1).Return([]db.Ask{ask}, nil)
+	store.EXPECT().ExpireBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(db.CancelBidTxResult{}, sql.ErrConnDone)
+	// the failed bid doesn't hold back the ask
+	store.EXPECT().ExpireAskTx(gomock.Any(), gomock.Eq(ask.ID)).Times(1)
+
+	engine := newTestEngine(store, []db.Bid{bid}, []db.Ask{ask})
+
+	expired, err := engine.ExpireOrders(context.Background(), time.Now())
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Equal(t, 1, expired)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.BID), orderFromBid(bid))
+	require.Empty(t, book.Orders(util.ASK))
+}
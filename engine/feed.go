@@ -0,0 +1,115 @@
+package engine
+
+import (
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/util"
+	"time"
+)
+
+// BookUpdate is a change of the depth of an order book.
+// Each level holds the new displayed amount at its price, a zero amount means the level was removed
+type BookUpdate struct {
+	Pair string       `json:"pair"`
+	Bids []PriceLevel `json:"bids"`
+	Asks []PriceLevel `json:"asks"`
+}
+
+// MarketTrade is a trade of the public tape, without the accounts and orders involved
+type MarketTrade struct {
+	ID          int64           `json:"id"`
+	Pair        string          `json:"pair"`
+	Price       decimal.Decimal `json:"price"`
+	Amount      decimal.Decimal `json:"amount"`
+	QuoteAmount decimal.Decimal `json:"quote_amount"`
+	TakerSide   string          `json:"taker_side"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// NewMarketTrade reads a trade of the order book, where the bid pays the first amount in the quote currency
+// and the ask the second amount in the base currency
+func NewMarketTrade(trade db.Trade) MarketTrade {
+	return MarketTrade{
+		ID:          trade.ID,
+		Pair:        trade.Pair.String,
+		Price:       trade.Price.Decimal,
+		Amount:      trade.SecondAmount,
+		QuoteAmount: trade.FirstAmount,
+		TakerSide:   trade.TakerSide.String,
+		CreatedAt:   trade.CreatedAt,
+	}
+}
+
+// Feed returns the hub the engine publishes the market data of its order books on
+func (engine *Engine) Feed() *feed.Hub {
+	return engine.feed
+}
+
+// release publishes what changed on a book while it was locked and unlocks it,
+// so the sequence of the book channel always matches the state of the book seen under its lock
+func (engine *Engine) release(book *OrderBook) {
+	defer book.mu.Unlock()
+
+	if update, ok := book.update(); ok {
+		engine.feed.Publish(feed.Event{Channel: feed.BOOK, Key: book.pair, Data: update})
+	}
+
+	if len(book.trades) == 0 {
+		return
+	}
+	for _, trade := range book.trades {
+		engine.feed.Publish(feed.Event{Channel: feed.TRADES, Key: book.pair, Data: trade})
+	}
+	book.trades = book.trades[:0]
+
+	engine.feed.Publish(feed.Event{Channel: feed.TICKER, Key: book.pair, Data: book.Ticker(time.Now())})
+}
+
+// update returns the levels that changed since the last published update of the book and remembers the new ones.
+// The caller must hold the book lock
+func (book *OrderBook) update() (BookUpdate, bool) {
+	bids := book.levels(util.BID, 0)
+	asks := book.levels(util.ASK, 0)
+
+	update := BookUpdate{
+		Pair: book.pair,
+		Bids: diffLevels(util.BID, book.publishedBids, bids),
+		Asks: diffLevels(util.ASK, book.publishedAsks, asks),
+	}
+	book.publishedBids = bids
+	book.publishedAsks = asks
+
+	return update, len(update.Bids) > 0 || len(update.Asks) > 0
+}
+
+// diffLevels returns the levels of a side that were added, changed or removed, best prices first.
+// Both lists of levels must be sorted best prices first
+func diffLevels(side string, old []PriceLevel, new []PriceLevel) []PriceLevel {
+	better := func(a decimal.Decimal, b decimal.Decimal) bool {
+		if side == util.BID {
+			return a.GreaterThan(b)
+		}
+		return a.LessThan(b)
+	}
+
+	changes := []PriceLevel{}
+	i, j := 0, 0
+	for i < len(old) || j < len(new) {
+		switch {
+		case j == len(new) || i < len(old) && better(old[i].Price, new[j].Price):
+			changes = append(changes, PriceLevel{Price: old[i].Price})
+			i++
+		case i == len(old) || better(new[j].Price, old[i].Price):
+			changes = append(changes, new[j])
+			j++
+		default:
+			if !old[i].Amount.Equal(new[j].Amount) || old[i].OrderCount != new[j].OrderCount {
+				changes = append(changes, new[j])
+			}
+			i++
+			j++
+		}
+	}
+	return changes
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLevels(t *testing.T) {
+	level := func(price int64, amount int64, count int64) PriceLevel {
+		return PriceLevel{Price: decimal.NewFromInt(price), Amount: decimal.NewFromInt(amount), OrderCount: count}
+	}
+
+	old := []PriceLevel{level(100, 5, 2), level(90, 1, 1), level(80, 3, 1)}
+	new := []PriceLevel{level(110, 2, 1), level(100, 5, 2), level(80, 4, 2), level(70, 1, 1)}
+
+	require.Equal(t, []PriceLevel{
+		level(110, 2, 1),
+		{Price: decimal.NewFromInt(90)},
+		level(80, 4, 2),
+		level(70, 1, 1),
+	}, diffLevels(util.BID, old, new))
+
+	// asks are sorted from the lowest price
+	require.Equal(t, []PriceLevel{
+		level(100, 1, 1),
+		{Price: decimal.NewFromInt(110)},
+	}, diffLevels(util.ASK, []PriceLevel{level(110, 1, 1)}, []PriceLevel{level(100, 1, 1)}))
+
+	require.Empty(t, diffLevels(util.BID, old, old))
+	require.Empty(t, diffLevels(util.ASK, nil, nil))
+}
+
+func TestPublishBookUpdates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ask := randomAsk(100, 10)
+	bid := randomBid(100, 4)
+	restingBid := randomBid(90, 2)
+
+	trade := db.Trade{
+		ID:           util.RandomInt(1, 1000),
+		FirstAmount:  decimal.NewFromInt(400),
+		SecondAmount: decimal.NewFromInt(4),
+		Pair:         sql.NullString{String: util.BTC_USDT, Valid: true},
+		Price:        decimal.NullDecimal{Decimal: decimal.NewFromInt(100), Valid: true},
+		TakerSide:    sql.NullString{String: util.BID, Valid: true},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	expectFill(store, util.BID, bid, ask, decimal.NewFromInt(100), decimal.NewFromInt(4)).Return(db.FillTxResult{Trade: trade}, nil)
+
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+	_, err := engine.PlaceAsk(context.Background(), ask)
+	require.NoError(t, err)
+
+	depth, err := engine.Depth(util.BTC_USDT, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), depth.Sequence)
+
+	books := engine.Feed().Subscribe(feed.Topic(feed.BOOK, util.BTC_USDT), 10)
+	trades := engine.Feed().Subscribe(feed.Topic(feed.TRADES, util.BTC_USDT), 10)
+	tickers := engine.Feed().Subscribe(feed.Topic(feed.TICKER, util.BTC_USDT), 10)
+	defer books.Close()
+	defer trades.Close()
+	defer tickers.Close()
+
+	_, err = engine.PlaceBid(context.Background(), bid)
+	require.NoError(t, err)
+
+	event := <-books.Events()
+	require.Equal(t, uint64(2), event.Sequence)
+	require.Equal(t, BookUpdate{
+		Pair: util.BTC_USDT,
+		Bids: []PriceLevel{},
+		Asks: []PriceLevel{{Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(6), OrderCount: 1}},
+	}, event.Data)
+
+	event = <-trades.Events()
+	require.Equal(t, uint64(1), event.Sequence)
+	require.Equal(t, NewMarketTrade(trade), event.Data)
+
+	event = <-tickers.Events()
+	require.Equal(t, uint64(1), event.Sequence)
+	ticker := event.Data.(Ticker)
+	require.Equal(t, decimal.NewFromInt(100), ticker.LastPrice)
+	require.Equal(t, decimal.NewFromInt(4), ticker.Volume)
+
+	// a resting order only changes the book
+	_, err = engine.PlaceBid(context.Background(), restingBid)
+	require.NoError(t, err)
+
+	event = <-books.Events()
+	require.Equal(t, uint64(3), event.Sequence)
+	require.Equal(t, BookUpdate{
+		Pair: util.BTC_USDT,
+		Bids: []PriceLevel{{Price: decimal.NewFromInt(90), Amount: decimal.NewFromInt(2), OrderCount: 1}},
+		Asks: []PriceLevel{},
+	}, event.Data)
+
+	_, ok := engine.CancelBid(restingBid)
+	require.True(t, ok)
+
+	event = <-books.Events()
+	require.Equal(t, uint64(4), event.Sequence)
+	require.Equal(t, []PriceLevel{{Price: decimal.NewFromInt(90)}}, event.Data.(BookUpdate).Bids)
+
+	// nothing changed, so nothing is published
+	_, ok = engine.CancelBid(restingBid)
+	require.False(t, ok)
+	require.Empty(t, books.Events())
+	require.Empty(t, trades.Events())
+	require.Empty(t, tickers.Events())
+
+	depth, err = engine.Depth(util.BTC_USDT, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), depth.Sequence)
+}
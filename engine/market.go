@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"errors"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// ErrNoLiquidity is returned when a market order has no resting orders to trade against
+var ErrNoLiquidity = errors.New("no liquidity")
+
+// MarketQuote is the limit a market order is placed with.
+// Price is the worst price the order can reach and Amount is how much it can fill
+type MarketQuote struct {
+	Price  decimal.Decimal `json:"price"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// QuoteMarketBid walks the asks of a pair to quote a market bid for amount that can spend funds of the quote currency.
+// maxSlippage bounds the price in basis points above the best ask, 0 means no bound
+func (engine *Engine) QuoteMarketBid(pair string, amount decimal.Decimal, funds decimal.Decimal, maxSlippage int64) (MarketQuote, error) {
+	return engine.quote(pair, util.BID, amount, funds, maxSlippage)
+}
+
+// QuoteMarketAsk walks the bids of a pair to quote a market ask for amount that can sell funds of the base currency.
+// maxSlippage bounds the price in basis points below the best bid, 0 means no bound
+func (engine *Engine) QuoteMarketAsk(pair string, amount decimal.Decimal, funds decimal.Decimal, maxSlippage int64) (MarketQuote, error) {
+	return engine.quote(pair, util.ASK, amount, funds, maxSlippage)
+}
+
+func (engine *Engine) quote(pair string, side string, amount decimal.Decimal, funds decimal.Decimal, maxSlippage int64) (MarketQuote, error) {
+	var quote MarketQuote
+
+	book, err := engine.Book(pair)
+	if err != nil {
+		return quote, err
+	}
+	listed, _ := engine.registry.Pair(pair)
+
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	opposite := util.ASK
+	if side == util.ASK {
+		opposite = util.BID
+	}
+
+	orders := book.orders(opposite)
+	if len(orders) == 0 {
+		return quote, ErrNoLiquidity
+	}
+
+	limit := slippageLimit(side, orders[0].Price, maxSlippage)
+
+	var depth decimal.Decimal
+	for _, order := range orders {
+		if depth.GreaterThanOrEqual(amount) {
+			break
+		}
+		if maxSlippage > 0 && (side == util.BID && order.Price.GreaterThan(limit) || side == util.ASK && order.Price.LessThan(limit)) {
+			break
+		}
+		depth = depth.Add(order.Amount)
+
+		// the order holds its worst price for the whole amount, so a bid can afford less at every level.
+		// What it can afford is rounded down to the lot size of the pair
+		affordable := funds
+		if side == util.BID {
+			affordable = funds.Div(order.Price, listed.LotSize.Places(), decimal.RoundDown).RoundToMultiple(listed.LotSize, decimal.RoundDown)
+		}
+
+		filled := decimal.Min(depth, amount, affordable)
+		if filled.LessThanOrEqual(quote.Amount) {
+			break
+		}
+		quote = MarketQuote{
+			Price:  order.Price,
+			Amount: filled,
+		}
+	}
+
+	if quote.Amount.IsZero() {
+		return quote, db.ErrInsufficientFunds
+	}
+	return quote, nil
+}
+
+// slippageLimit returns the price maxSlippage basis points above price for bids and below it for asks
+func slippageLimit(side string, price decimal.Decimal, maxSlippage int64) decimal.Decimal {
+	if side == util.BID {
+		return price.Mul(decimal.New(10000+maxSlippage, -4))
+	}
+	return price.Mul(decimal.New(10000-maxSlippage, -4))
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"context"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteMarketBid(t *testing.T) {
+	asks := []db.Ask{randomAsk(100, 2), randomAsk(105, 3), randomAsk(130, 10)}
+	engine := newTestEngine(nil, nil, asks)
+
+	testCases := []struct {
+		name        string
+		amount      int64
+		funds       int64
+		maxSlippage int64
+		quote       MarketQuote
+		err         error
+	}{
+		{name: "BestLevel", amount: 2, funds: 10000, quote: MarketQuote{Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(2)}},
+		{name: "SweepLevels", amount: 6, funds: 10000, quote: MarketQuote{Price: decimal.NewFromInt(130), Amount: decimal.NewFromInt(6)}},
+		{name: "MaxSlippage", amount: 6, funds: 10000, maxSlippage: 1000, quote: MarketQuote{Price: decimal.NewFromInt(105), Amount: decimal.NewFromInt(5)}},
+		{name: "LimitedByFunds", amount: 6, funds: 525, quote: MarketQuote{Price: decimal.NewFromInt(105), Amount: decimal.NewFromInt(5)}},
+		{name: "RoundedDownToLot", amount: 6, funds: 520, quote: MarketQuote{Price: decimal.NewFromInt(105), Amount: decimal.NewFromInt(4)}},
+		{name: "OnlyBestAffordable", amount: 6, funds: 210, quote: MarketQuote{Price: decimal.NewFromInt(100), Amount: decimal.NewFromInt(2)}},
+		{name: "InsufficientFunds", amount: 6, funds: 99, err: db.ErrInsufficientFunds},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			quote, err := engine.QuoteMarketBid(util.BTC_USDT, decimal.NewFromInt(tc.amount), decimal.NewFromInt(tc.funds), tc.maxSlippage)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.quote, quote)
+		})
+	}
+}
+
+func TestQuoteMarketAsk(t *testing.T) {
+	bids := []db.Bid{randomBid(100, 2), randomBid(95, 3), randomBid(70, 10)}
+	engine := newTestEngine(nil, bids, nil)
+
+	quote, err := engine.QuoteMarketAsk(util.BTC_USDT, decimal.NewFromInt(4), decimal.NewFromInt(10), 0)
+	require.NoError(t, err)
+	require.Equal(t, MarketQuote{Price: decimal.NewFromInt(95), Amount: decimal.NewFromInt(4)}, quote)
+
+	quote, err = engine.QuoteMarketAsk(util.BTC_USDT, decimal.NewFromInt(20), decimal.NewFromInt(8), 0)
+	require.NoError(t, err)
+	require.Equal(t, MarketQuote{Price: decimal.NewFromInt(70), Amount: decimal.NewFromInt(8)}, quote)
+
+	quote, err = engine.QuoteMarketAsk(util.BTC_USDT, decimal.NewFromInt(20), decimal.NewFromInt(8), 1000)
+	require.NoError(t, err)
+	require.Equal(t, MarketQuote{Price: decimal.NewFromInt(95), Amount: decimal.NewFromInt(5)}, quote)
+
+	_, err = engine.QuoteMarketAsk(util.ETH_BTC, decimal.NewFromInt(20), decimal.NewFromInt(8), 0)
+	require.ErrorIs(t, err, ErrNoLiquidity)
+}
+
+func TestPlaceMarketOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ask := randomAsk(100, 2)
+	bid := randomBid(100, 5)
+	bid.Type = util.MARKET
+
+	store := mockdb.NewMockStore(ctrl)
+	expectFill(store, util.BID, bid, ask, ask.Price, ask.Amount)
+	store.EXPECT().CancelBidTx(gomock.Any(), gomock.Eq(bid.ID)).Times(1)
+
+	engine := newTestEngine(store, nil, []db.Ask{ask})
+	result, err := engine.PlaceBid(context.Background(), bid)
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+	require.Equal(t, bid.Amount.Sub(ask.Amount), result.Remaining)
+
+	// the remaining amount of a market order never rests on the book
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Orders(util.BID))
+	require.Empty(t, book.Orders(util.ASK))
+}
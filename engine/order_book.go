@@ -0,0 +1,314 @@
+package engine
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"sort"
+	"sync"
+)
+
+// Order is an order resting on an order book
+type Order struct {
+	ID                  int64           `json:"id"`
+	Pair                string          `json:"pair"`
+	Side                string          `json:"side"`
+	Type                string          `json:"type"`
+	TimeInForce         string          `json:"time_in_force"`
+	FromAccountID       int64           `json:"from_account_id"`
+	ToAccountID         int64           `json:"to_account_id"`
+	Price               decimal.Decimal `json:"price"`
+	Amount              decimal.Decimal `json:"amount"`
+	StopPrice           decimal.Decimal `json:"stop_price"`
+	PostOnly            bool            `json:"post_only"`
+	DisplayAmount       decimal.Decimal `json:"display_amount"`
+	Hidden              bool            `json:"hidden"`
+	Owner               string          `json:"owner"`
+	SelfTradePrevention string          `json:"self_trade_prevention"`
+	visible             decimal.Decimal
+	sequence            uint64
+}
+
+// Visible returns the amount of a resting order that can trade before it must be refilled.
+// Iceberg orders show at most their display amount at a time
+func (order *Order) Visible() decimal.Decimal {
+	if order.DisplayAmount.IsZero() {
+		return order.Amount
+	}
+	return order.visible
+}
+
+// Displayed returns the amount of a resting order that can be shown in a public depth view
+func (order *Order) Displayed() decimal.Decimal {
+	if order.Hidden {
+		return decimal.Zero
+	}
+	return order.Visible()
+}
+
+// OrderBook keeps the resting orders of a pair sorted by price-time priority.
+// Stop orders are kept off the book until the last trade price reaches their stop price
+type OrderBook struct {
+	mu            sync.Mutex
+	pair          string
+	bids          []*Order
+	asks          []*Order
+	stops         []*Order
+	activated     []*Order
+	lastPrice     decimal.Decimal
+	stats         tradeStats
+	sequence      uint64
+	publishedBids []PriceLevel
+	publishedAsks []PriceLevel
+	trades        []MarketTrade
+}
+
+// NewOrderBook creates an empty order book for the pair
+func NewOrderBook(pair string) *OrderBook {
+	return &OrderBook{
+		pair:  pair,
+		bids:  []*Order{},
+		asks:  []*Order{},
+		stops: []*Order{},
+	}
+}
+
+// Pair returns the pair of the order book
+func (book *OrderBook) Pair() string {
+	return book.pair
+}
+
+// Add puts an order on the book behind every order with the same price.
+// Iceberg orders show a new display amount from their hidden reserve
+func (book *OrderBook) Add(order *Order) {
+	book.sequence++
+	order.sequence = book.sequence
+
+	if order.DisplayAmount.IsPositive() {
+		order.visible = decimal.Min(order.DisplayAmount, order.Amount)
+	}
+
+	if order.Side == util.BID {
+		// bids are sorted from the highest to the lowest price
+		i := sort.Search(len(book.bids), func(i int) bool {
+			return book.bids[i].Price.LessThan(order.Price)
+		})
+		book.bids = insert(book.bids, i, order)
+		return
+	}
+
+	// asks are sorted from the lowest to the highest price
+	i := sort.Search(len(book.asks), func(i int) bool {
+		return book.asks[i].Price.GreaterThan(order.Price)
+	})
+	book.asks = insert(book.asks, i, order)
+}
+
+// Remove takes an order off the book and returns it
+func (book *OrderBook) Remove(side string, id int64) (*Order, bool) {
+	orders := book.orders(side)
+
+	for i, order := range orders {
+		if order.ID == id {
+			orders = append(orders[:i], orders[i+1:]...)
+			book.setOrders(side, orders)
+			return order, true
+		}
+	}
+
+	return nil, false
+}
+
+// Reduce takes a traded amount off a resting order and removes the order once it is filled.
+// An iceberg order whose visible amount is used up is refilled behind every order with the same price
+func (book *OrderBook) Reduce(order *Order, amount decimal.Decimal) {
+	order.Amount = order.Amount.Sub(amount)
+	if order.DisplayAmount.IsPositive() {
+		order.visible = order.visible.Sub(amount)
+	}
+
+	if order.Amount.IsZero() {
+		book.Remove(order.Side, order.ID)
+		return
+	}
+
+	if order.Visible().IsZero() {
+		book.Remove(order.Side, order.ID)
+		book.Add(order)
+	}
+}
+
+// resize changes the remaining amount of an order without changing its place in the queue
+func resize(order *Order, amount decimal.Decimal) {
+	order.Amount = amount
+	if order.visible.GreaterThan(order.Amount) {
+		order.visible = order.Amount
+	}
+}
+
+// Best returns the order with the highest priority on a side of the book
+func (book *OrderBook) Best(side string) *Order {
+	orders := book.orders(side)
+	if len(orders) == 0 {
+		return nil
+	}
+	return orders[0]
+}
+
+// Orders returns the orders on a side of the book in priority order
+func (book *OrderBook) Orders(side string) []*Order {
+	orders := book.orders(side)
+	result := make([]*Order, len(orders))
+	copy(result, orders)
+	return result
+}
+
+// Crosses returns true if the order can trade against the best opposite order
+func (book *OrderBook) Crosses(order *Order) bool {
+	if order.Side == util.BID {
+		best := book.Best(util.ASK)
+		return best != nil && best.Price.LessThanOrEqual(order.Price)
+	}
+
+	best := book.Best(util.BID)
+	return best != nil && best.Price.GreaterThanOrEqual(order.Price)
+}
+
+// LastPrice returns the price of the last trade on the book, 0 if nothing traded yet
+func (book *OrderBook) LastPrice() decimal.Decimal {
+	return book.lastPrice
+}
+
+// AddStop keeps a stop order off the book until it is triggered
+func (book *OrderBook) AddStop(order *Order) {
+	book.sequence++
+	order.sequence = book.sequence
+	book.stops = append(book.stops, order)
+}
+
+// RemoveStop takes a stop order that wasn't triggered yet off the book and returns it
+func (book *OrderBook) RemoveStop(side string, id int64) (*Order, bool) {
+	for i, order := range book.stops {
+		if order.Side == side && order.ID == id {
+			book.stops = append(book.stops[:i], book.stops[i+1:]...)
+			return order, true
+		}
+	}
+
+	return nil, false
+}
+
+// Stops returns the stop orders that weren't triggered yet in the order they were added
+func (book *OrderBook) Stops() []*Order {
+	result := make([]*Order, len(book.stops))
+	copy(result, book.stops)
+	return result
+}
+
+// TriggerStops takes the stop orders triggered by the last trade price off the book and returns them.
+// Bids trigger when the price rises to their stop price and asks when it falls to it
+func (book *OrderBook) TriggerStops() []*Order {
+	if book.lastPrice.IsZero() {
+		return nil
+	}
+
+	triggered := []*Order{}
+	waiting := book.stops[:0]
+	for _, order := range book.stops {
+		if triggers(order, book.lastPrice) {
+			triggered = append(triggered, order)
+		} else {
+			waiting = append(waiting, order)
+		}
+	}
+	book.stops = waiting
+
+	return triggered
+}
+
+// triggers returns true if a trade at price triggers the stop order
+func triggers(order *Order, price decimal.Decimal) bool {
+	if order.Side == util.BID {
+		return price.GreaterThanOrEqual(order.StopPrice)
+	}
+	return price.LessThanOrEqual(order.StopPrice)
+}
+
+// Fillable returns true if the opposite orders crossed by the order can fill its whole amount.
+// Orders of its owner are left out since they never trade with it
+func (book *OrderBook) Fillable(order *Order) bool {
+	opposite := util.ASK
+	if order.Side == util.ASK {
+		opposite = util.BID
+	}
+
+	var amount decimal.Decimal
+	for _, maker := range book.orders(opposite) {
+		if order.Side == util.BID && maker.Price.GreaterThan(order.Price) || order.Side == util.ASK && maker.Price.LessThan(order.Price) {
+			break
+		}
+		if selfTrade(order, maker) {
+			continue
+		}
+		amount = amount.Add(maker.Amount)
+		if amount.GreaterThanOrEqual(order.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns an order resting on the book or waiting as a stop order
+func (book *OrderBook) find(side string, id int64) (*Order, bool) {
+	for _, order := range book.orders(side) {
+		if order.ID == id {
+			return order, true
+		}
+	}
+
+	for _, order := range book.stops {
+		if order.Side == side && order.ID == id {
+			return order, true
+		}
+	}
+	return nil, false
+}
+
+// drop takes an order off the book wherever it waits: resting, as a stop or as an activated leg
+func (book *OrderBook) drop(side string, id int64) (*Order, bool) {
+	if order, ok := book.Remove(side, id); ok {
+		return order, true
+	}
+	if order, ok := book.RemoveStop(side, id); ok {
+		return order, true
+	}
+
+	for i, order := range book.activated {
+		if order.Side == side && order.ID == id {
+			book.activated = append(book.activated[:i], book.activated[i+1:]...)
+			return order, true
+		}
+	}
+	return nil, false
+}
+
+func (book *OrderBook) orders(side string) []*Order {
+	if side == util.BID {
+		return book.bids
+	}
+	return book.asks
+}
+
+func (book *OrderBook) setOrders(side string, orders []*Order) {
+	if side == util.BID {
+		book.bids = orders
+		return
+	}
+	book.asks = orders
+}
+
+func insert(orders []*Order, i int, order *Order) []*Order {
+	orders = append(orders, nil)
+	copy(orders[i+1:], orders[i:])
+	orders[i] = order
+	return orders
+}
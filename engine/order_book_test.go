@@ -0,0 +1,187 @@
+package engine
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var lastOrderID int64
+
+func randomOrder(side string, price int64) *Order {
+	lastOrderID++
+	return &Order{
+		ID:            lastOrderID,
+		Pair:          util.BTC_USDT,
+		Side:          side,
+		FromAccountID: util.RandomInt(1, 1000),
+		ToAccountID:   util.RandomInt(1, 1000),
+		Price:         decimal.NewFromInt(price),
+		Amount:        decimal.NewFromInt(util.RandomInt(1, 100)),
+	}
+}
+
+func requireOrderIDs(t *testing.T, orders []*Order, expected ...*Order) {
+	require.Len(t, orders, len(expected))
+	for i, order := range orders {
+		require.Equal(t, expected[i].ID, order.ID)
+	}
+}
+
+func TestOrderBookPriority(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	bid1 := randomOrder(util.BID, 100)
+	bid2 := randomOrder(util.BID, 110)
+	bid3 := randomOrder(util.BID, 100)
+	book.Add(bid1)
+	book.Add(bid2)
+	book.Add(bid3)
+
+	ask1 := randomOrder(util.ASK, 130)
+	ask2 := randomOrder(util.ASK, 120)
+	ask3 := randomOrder(util.ASK, 130)
+	book.Add(ask1)
+	book.Add(ask2)
+	book.Add(ask3)
+
+	// better price first, then the oldest order within the same price
+	requireOrderIDs(t, book.Orders(util.BID), bid2, bid1, bid3)
+	requireOrderIDs(t, book.Orders(util.ASK), ask2, ask1, ask3)
+
+	require.Equal(t, bid2, book.Best(util.BID))
+	require.Equal(t, ask2, book.Best(util.ASK))
+}
+
+func TestOrderBookRemove(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	bid1 := randomOrder(util.BID, 100)
+	bid2 := randomOrder(util.BID, 100)
+	book.Add(bid1)
+	book.Add(bid2)
+
+	order, ok := book.Remove(util.BID, bid1.ID)
+	require.True(t, ok)
+	require.Equal(t, bid1, order)
+	requireOrderIDs(t, book.Orders(util.BID), bid2)
+
+	_, ok = book.Remove(util.BID, bid1.ID)
+	require.False(t, ok)
+
+	_, ok = book.Remove(util.ASK, bid2.ID)
+	require.False(t, ok)
+
+	require.Nil(t, book.Best(util.ASK))
+}
+
+func TestOrderBookCrosses(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+	require.False(t, book.Crosses(randomOrder(util.BID, 100)))
+	require.False(t, book.Crosses(randomOrder(util.ASK, 100)))
+
+	book.Add(randomOrder(util.BID, 100))
+	book.Add(randomOrder(util.ASK, 110))
+
+	require.True(t, book.Crosses(randomOrder(util.BID, 110)))
+	require.True(t, book.Crosses(randomOrder(util.BID, 120)))
+	require.False(t, book.Crosses(randomOrder(util.BID, 105)))
+
+	require.True(t, book.Crosses(randomOrder(util.ASK, 100)))
+	require.True(t, book.Crosses(randomOrder(util.ASK, 90)))
+	require.False(t, book.Crosses(randomOrder(util.ASK, 105)))
+}
+
+func TestOrderBookFillable(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	ask1 := randomOrder(util.ASK, 100)
+	ask2 := randomOrder(util.ASK, 110)
+	book.Add(ask1)
+	book.Add(ask2)
+
+	bid := randomOrder(util.BID, 100)
+	bid.Amount = ask1.Amount
+	require.True(t, book.Fillable(bid))
+
+	bid.Amount = ask1.Amount.Add(decimal.NewFromInt(1))
+	require.False(t, book.Fillable(bid))
+
+	bid.Price = decimal.NewFromInt(110)
+	require.True(t, book.Fillable(bid))
+
+	bid.Amount = ask1.Amount.Add(ask2.Amount).Add(decimal.NewFromInt(1))
+	require.False(t, book.Fillable(bid))
+}
+
+func TestOrderBookStops(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	stopBid := randomOrder(util.BID, 130)
+	stopBid.StopPrice = decimal.NewFromInt(120)
+	stopAsk1 := randomOrder(util.ASK, 80)
+	stopAsk1.StopPrice = decimal.NewFromInt(90)
+	stopAsk2 := randomOrder(util.ASK, 90)
+	stopAsk2.StopPrice = decimal.NewFromInt(100)
+	book.AddStop(stopBid)
+	book.AddStop(stopAsk1)
+	book.AddStop(stopAsk2)
+
+	// nothing triggers before the first trade
+	require.Empty(t, book.TriggerStops())
+
+	book.lastPrice = decimal.NewFromInt(100)
+	requireOrderIDs(t, book.TriggerStops(), stopAsk2)
+	requireOrderIDs(t, book.Stops(), stopBid, stopAsk1)
+
+	book.lastPrice = decimal.NewFromInt(120)
+	requireOrderIDs(t, book.TriggerStops(), stopBid)
+
+	_, ok := book.RemoveStop(util.BID, stopAsk1.ID)
+	require.False(t, ok)
+
+	order, ok := book.RemoveStop(util.ASK, stopAsk1.ID)
+	require.True(t, ok)
+	require.Equal(t, stopAsk1, order)
+	require.Empty(t, book.Stops())
+}
+
+func TestOrderBookIceberg(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+
+	iceberg := randomOrder(util.ASK, 100)
+	iceberg.Amount = decimal.NewFromInt(10)
+	iceberg.DisplayAmount = decimal.NewFromInt(4)
+	other := randomOrder(util.ASK, 100)
+	hidden := randomOrder(util.ASK, 110)
+	hidden.Hidden = true
+	book.Add(iceberg)
+	book.Add(other)
+	book.Add(hidden)
+
+	require.Equal(t, decimal.NewFromInt(4), iceberg.Visible())
+	require.Equal(t, decimal.NewFromInt(4), iceberg.Displayed())
+	require.Equal(t, hidden.Amount, hidden.Visible())
+	require.Zero(t, hidden.Displayed())
+
+	// a partial trade keeps the time priority
+	book.Reduce(iceberg, decimal.NewFromInt(3))
+	require.Equal(t, decimal.NewFromInt(7), iceberg.Amount)
+	require.Equal(t, decimal.NewFromInt(1), iceberg.Visible())
+	requireOrderIDs(t, book.Orders(util.ASK), iceberg, other, hidden)
+
+	// the refill goes behind the other order with the same price
+	book.Reduce(iceberg, decimal.NewFromInt(1))
+	require.Equal(t, decimal.NewFromInt(6), iceberg.Amount)
+	require.Equal(t, decimal.NewFromInt(4), iceberg.Visible())
+	requireOrderIDs(t, book.Orders(util.ASK), other, iceberg, hidden)
+
+	// the last refill only shows what is left
+	book.Reduce(iceberg, decimal.NewFromInt(4))
+	require.Equal(t, decimal.NewFromInt(2), iceberg.Visible())
+
+	book.Reduce(iceberg, decimal.NewFromInt(2))
+	requireOrderIDs(t, book.Orders(util.ASK), other, hidden)
+}
@@ -0,0 +1,162 @@
+package engine
+
+import (
+	"context"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// OrderGroup describes a new order group before its legs are laid out.
+// The legs of an OCO are on the side of the group, a bracket enters on the side of the group
+// and its take profit and stop loss exit on the opposite side with the accounts swapped
+type OrderGroup struct {
+	Type                string
+	Pair                string
+	Side                string
+	FromAccountID       int64
+	ToAccountID         int64
+	Amount              decimal.Decimal
+	Price               decimal.Decimal
+	TakeProfitPrice     decimal.Decimal
+	StopPrice           decimal.Decimal
+	StopLimitPrice      decimal.Decimal
+	MaxSlippage         int64
+	SelfTradePrevention string
+}
+
+// orderLeg describes a leg of an order group before it is created as a bid or an ask
+type orderLeg struct {
+	side          string
+	leg           string
+	orderType     string
+	status        string
+	timeInForce   string
+	price         decimal.Decimal
+	stopPrice     decimal.Decimal
+	fromAccountID int64
+	toAccountID   int64
+}
+
+// Params lays out the legs of the order group on the pair
+func (group OrderGroup) Params(pair db.Pair) db.CreateOrderGroupTxParams {
+	exitSide, fromAccountID, toAccountID := group.Side, group.FromAccountID, group.ToAccountID
+	status := util.ACTIVE
+	legs := []orderLeg{}
+
+	if group.Type == util.BRACKET {
+		legs = append(legs, orderLeg{
+			side:          group.Side,
+			leg:           util.ENTRY_LEG,
+			orderType:     util.LIMIT,
+			status:        util.ACTIVE,
+			timeInForce:   util.GTC,
+			price:         group.Price,
+			fromAccountID: group.FromAccountID,
+			toAccountID:   group.ToAccountID,
+		})
+
+		// the take profit and stop loss sell what the entry bought, or buy back what it sold
+		exitSide, fromAccountID, toAccountID = util.OppositeSide(group.Side), group.ToAccountID, group.FromAccountID
+		status = util.INACTIVE
+	}
+
+	legs = append(legs, orderLeg{
+		side:          exitSide,
+		leg:           util.TAKE_PROFIT_LEG,
+		orderType:     util.LIMIT,
+		status:        status,
+		timeInForce:   util.GTC,
+		price:         group.TakeProfitPrice,
+		fromAccountID: fromAccountID,
+		toAccountID:   toAccountID,
+	})
+
+	stopLoss := orderLeg{
+		side:          exitSide,
+		leg:           util.STOP_LOSS_LEG,
+		orderType:     util.STOP_LIMIT,
+		status:        status,
+		timeInForce:   util.GTC,
+		price:         group.StopLimitPrice,
+		stopPrice:     group.StopPrice,
+		fromAccountID: fromAccountID,
+		toAccountID:   toAccountID,
+	}
+	if group.StopLimitPrice.IsZero() {
+		stopLoss.orderType = util.STOP_MARKET
+		stopLoss.timeInForce = util.IOC
+		stopLoss.price = StopMarketPrice(exitSide, group.StopPrice, group.MaxSlippage, pair.TickSize)
+	}
+	if status == util.ACTIVE {
+		stopLoss.status = util.PENDING
+	}
+	legs = append(legs, stopLoss)
+
+	arg := db.CreateOrderGroupTxParams{Type: group.Type}
+	for _, leg := range legs {
+		if leg.side == util.BID {
+			arg.Bids = append(arg.Bids, db.CreateBidParams{
+				Pair:                group.Pair,
+				FromAccountID:       leg.fromAccountID,
+				ToAccountID:         leg.toAccountID,
+				Price:               leg.price,
+				Amount:              group.Amount,
+				Status:              leg.status,
+				Type:                leg.orderType,
+				TimeInForce:         leg.timeInForce,
+				StopPrice:           leg.stopPrice,
+				GroupLeg:            leg.leg,
+				SelfTradePrevention: group.SelfTradePrevention,
+			})
+			continue
+		}
+
+		arg.Asks = append(arg.Asks, db.CreateAskParams{
+			Pair:                group.Pair,
+			FromAccountID:       leg.fromAccountID,
+			ToAccountID:         leg.toAccountID,
+			Price:               leg.price,
+			Amount:              group.Amount,
+			Status:              leg.status,
+			Type:                leg.orderType,
+			TimeInForce:         leg.timeInForce,
+			StopPrice:           leg.stopPrice,
+			GroupLeg:            leg.leg,
+			SelfTradePrevention: group.SelfTradePrevention,
+		})
+	}
+	return arg
+}
+
+// PlaceOrderGroup sends the active legs of a new order group on the side to the matching engine.
+// The take profit of an OCO goes first and the stop loss only follows if the take profit rested without trading,
+// since its first fill cancels the stop loss. The legs of a bracket are placed by the engine once its entry fills
+func (engine *Engine) PlaceOrderGroup(ctx context.Context, side string, legs db.OrderGroupLegs) error {
+	var err error
+	var match MatchResult
+
+	if side == util.BID {
+		for _, bid := range legs.Bids {
+			if bid.Status == util.INACTIVE || len(match.Fills) > 0 {
+				break
+			}
+			match, err = engine.PlaceBid(ctx, bid)
+			if err != nil || !match.Resting {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, ask := range legs.Asks {
+		if ask.Status == util.INACTIVE || len(match.Fills) > 0 {
+			break
+		}
+		match, err = engine.PlaceAsk(ctx, ask)
+		if err != nil || !match.Resting {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// randomBracket returns the entry bid of a bracket with its take profit and stop loss asks as they are once activated
+func randomBracket(amount int64) (db.Bid, db.Ask, db.Ask) {
+	groupID := sql.NullInt64{Int64: util.RandomInt(1, 1000), Valid: true}
+
+	entry := randomBid(100, amount)
+	entry.GroupID = groupID
+	entry.GroupLeg = util.ENTRY_LEG
+
+	takeProfit := randomAsk(120, amount)
+	takeProfit.GroupID = groupID
+	takeProfit.GroupLeg = util.TAKE_PROFIT_LEG
+
+	stopLoss := randomStopAsk(util.STOP_LIMIT, 85, 90, amount)
+	stopLoss.GroupID = groupID
+	stopLoss.GroupLeg = util.STOP_LOSS_LEG
+
+	return entry, takeProfit, stopLoss
+}
+
+func TestPlaceBracket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	entry, takeProfit, stopLoss := randomBracket(2)
+	ask := randomAsk(100, 2)
+	engine := newTestEngine(store, nil, []db.Ask{ask})
+
+	// the filled entry activates the take profit, which rests on the book, and the stop loss, which waits for its trigger
+	expectFill(store, util.BID, entry, ask, ask.Price, entry.Amount).
+		Return(db.FillTxResult{Activated: db.OrderGroupLegs{Asks: []db.Ask{takeProfit, stopLoss}}}, nil)
+
+	result, err := engine.PlaceBid(context.Background(), entry)
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+	require.Zero(t, result.Remaining)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(takeProfit))
+	requireOrderIDs(t, book.Stops(), orderFromAsk(stopLoss))
+
+	// filling the take profit cancels the stop loss
+	bid := randomBid(120, 2)
+	expectFill(store, util.BID, bid, takeProfit, takeProfit.Price, bid.Amount).
+		Return(db.FillTxResult{Canceled: db.OrderGroupLegs{Asks: []db.Ask{stopLoss}}}, nil)
+
+	_, err = engine.PlaceBid(context.Background(), bid)
+	require.NoError(t, err)
+	require.Empty(t, book.Orders(util.ASK))
+	require.Empty(t, book.Stops())
+}
+
+func TestPlaceBracketLegFilledOnActivation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	entry, takeProfit, stopLoss := randomBracket(2)
+	ask := randomAsk(100, 2)
+	bid := randomBid(130, 2)
+	engine := newTestEngine(store, []db.Bid{bid}, []db.Ask{ask})
+
+	// the take profit trades right away, so the stop loss is canceled before it enters the book
+	gomock.InOrder(
+		expectFill(store, util.BID, entry, ask, ask.Price, entry.Amount).
+			Return(db.FillTxResult{Activated: db.OrderGroupLegs{Asks: []db.Ask{takeProfit, stopLoss}}}, nil),
+		expectFill(store, util.ASK, bid, takeProfit, bid.Price, takeProfit.Amount).
+			Return(db.FillTxResult{Canceled: db.OrderGroupLegs{Asks: []db.Ask{stopLoss}}}, nil),
+	)
+	store.EXPECT().TriggerAsk(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := engine.PlaceBid(context.Background(), entry)
+	require.NoError(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Orders(util.BID))
+	require.Empty(t, book.Orders(util.ASK))
+	require.Empty(t, book.Stops())
+	require.Equal(t, bid.Price, book.LastPrice())
+}
+
+func TestCancelRemainingOrderGroupLeg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	_, takeProfit, stopLoss := randomBracket(2)
+	takeProfit.TimeInForce = util.IOC
+	engine := newTestEngine(store, nil, nil)
+
+	_, err := engine.PlaceAsk(context.Background(), stopLoss)
+	require.NoError(t, err)
+
+	// canceling the remaining amount of the take profit also cancels the stop loss
+	store.EXPECT().CancelAskTx(gomock.Any(), gomock.Eq(takeProfit.ID)).Times(1).
+		Return(db.CancelAskTxResult{Canceled: db.OrderGroupLegs{Asks: []db.Ask{stopLoss}}}, nil)
+
+	_, err = engine.PlaceAsk(context.Background(), takeProfit)
+	require.NoError(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Stops())
+}
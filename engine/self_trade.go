@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+)
+
+// selfTrade returns true if the taker would trade against a maker of the same owner
+func selfTrade(taker *Order, maker *Order) bool {
+	return taker.Owner != "" && taker.Owner == maker.Owner
+}
+
+// preventSelfTrade applies the self-trade prevention mode of the taker instead of matching it against the maker.
+// The store cancels or decrements the orders, the maker is taken off the book or shrunk accordingly
+// and the taker keeps matching with what is left of it. It returns true if the taker was canceled.
+// The caller must hold the book lock
+func (engine *Engine) preventSelfTrade(ctx context.Context, book *OrderBook, taker *Order, maker *Order) (bool, error) {
+	bid, ask := taker, maker
+	if taker.Side == util.ASK {
+		bid, ask = maker, taker
+	}
+
+	result, err := engine.store.SelfTradeTx(ctx, db.SelfTradeTxParams{
+		BidID:     bid.ID,
+		AskID:     ask.ID,
+		TakerSide: taker.Side,
+		Mode:      taker.SelfTradePrevention,
+	})
+	if err != nil {
+		return false, fmt.Errorf("cannot prevent self-trade of bid %d and ask %d: %w", bid.ID, ask.ID, err)
+	}
+
+	dropLegs(book, result.Canceled)
+
+	bidOpen, askOpen := util.IsOpenStatus(result.Bid.Status), util.IsOpenStatus(result.Ask.Status)
+	if bidOpen {
+		resize(bid, result.Bid.RemainingAmount)
+	}
+	if askOpen {
+		resize(ask, result.Ask.RemainingAmount)
+	}
+
+	if taker.Side == util.BID {
+		return !bidOpen, nil
+	}
+	return !askOpen, nil
+}
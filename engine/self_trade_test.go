@@ -0,0 +1,256 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func expectSelfTrade(store *mockdb.MockStore, bid db.Bid, ask db.Ask, takerSide string, mode string, result db.SelfTradeTxResult) *gomock.Call {
+	arg := db.SelfTradeTxParams{
+		BidID:     bid.ID,
+		AskID:     ask.ID,
+		TakerSide: takerSide,
+		Mode:      mode,
+	}
+
+	return store.EXPECT().SelfTradeTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+}
+
+func canceledBid(bid db.Bid) db.Bid {
+	bid.Status = util.CANCELED
+	return bid
+}
+
+func canceledAsk(ask db.Ask) db.Ask {
+	ask.Status = util.CANCELED
+	return ask
+}
+
+func TestSelfTradePrevention(t *testing.T) {
+	owner := util.RandomOwner()
+
+	ownAsk := randomAsk(100, 5)
+	ownAsk.Owner = owner
+	otherAsk := randomAsk(110, 5)
+	otherAsk.Owner = util.RandomOwner()
+
+	testCases := []struct {
+		name        string
+		mode        string
+		amount      int64
+		buildStubs  func(store *mockdb.MockStore, bid db.Bid)
+		checkResult func(result MatchResult)
+		checkBook   func(book *OrderBook)
+	}{
+		{
+			name:   "CancelNewest",
+			mode:   util.CANCEL_NEWEST,
+			amount: 8,
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				expectSelfTrade(store, bid, ownAsk, util.BID, util.CANCEL_NEWEST, db.SelfTradeTxResult{
+					Bid:      canceledBid(bid),
+					Ask:      ownAsk,
+					Canceled: db.OrderGroupLegs{Bids: []db.Bid{canceledBid(bid)}},
+				})
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResult: func(result MatchResult) {
+				require.Empty(t, result.Fills)
+				require.Equal(t, 1, result.SelfTrades)
+				require.Equal(t, decimal.NewFromInt(8), result.Remaining)
+				require.False(t, result.Resting)
+			},
+			checkBook: func(book *OrderBook) {
+				require.Empty(t, book.Orders(util.BID))
+				requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(ownAsk), orderFromAsk(otherAsk))
+			},
+		},
+		{
+			name:   "CancelOldest",
+			mode:   util.CANCEL_OLDEST,
+			amount: 8,
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				gomock.InOrder(
+					expectSelfTrade(store, bid, ownAsk, util.BID, util.CANCEL_OLDEST, db.SelfTradeTxResult{
+						Bid:      bid,
+						Ask:      canceledAsk(ownAsk),
+						Canceled: db.OrderGroupLegs{Asks: []db.Ask{canceledAsk(ownAsk)}},
+					}),
+					expectFill(store, util.BID, bid, otherAsk, decimal.NewFromInt(110), decimal.NewFromInt(5)),
+				)
+			},
+			checkResult: func(result MatchResult) {
+				require.Len(t, result.Fills, 1)
+				require.Equal(t, 1, result.SelfTrades)
+				require.Equal(t, decimal.NewFromInt(3), result.Remaining)
+				require.True(t, result.Resting)
+			},
+			checkBook: func(book *OrderBook) {
+				require.Len(t, book.Orders(util.BID), 1)
+				require.Empty(t, book.Orders(util.ASK))
+			},
+		},
+		{
+			name:   "CancelBoth",
+			mode:   util.CANCEL_BOTH,
+			amount: 8,
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				expectSelfTrade(store, bid, ownAsk, util.BID, util.CANCEL_BOTH, db.SelfTradeTxResult{
+					Bid:      canceledBid(bid),
+					Ask:      canceledAsk(ownAsk),
+					Canceled: db.OrderGroupLegs{Bids: []db.Bid{canceledBid(bid)}, Asks: []db.Ask{canceledAsk(ownAsk)}},
+				})
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResult: func(result MatchResult) {
+				require.Empty(t, result.Fills)
+				require.Equal(t, 1, result.SelfTrades)
+				require.False(t, result.Resting)
+			},
+			checkBook: func(book *OrderBook) {
+				require.Empty(t, book.Orders(util.BID))
+				requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(otherAsk))
+			},
+		},
+		{
+			name:   "DecrementTaker",
+			mode:   util.DECREMENT_AND_CANCEL,
+			amount: 8,
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				decremented := bid
+				decremented.Amount = decimal.NewFromInt(3)
+				decremented.RemainingAmount = decimal.NewFromInt(3)
+
+				gomock.InOrder(
+					expectSelfTrade(store, bid, ownAsk, util.BID, util.DECREMENT_AND_CANCEL, db.SelfTradeTxResult{
+						Bid:      decremented,
+						Ask:      canceledAsk(ownAsk),
+						Canceled: db.OrderGroupLegs{Asks: []db.Ask{canceledAsk(ownAsk)}},
+					}),
+					expectFill(store, util.BID, bid, otherAsk, decimal.NewFromInt(110), decimal.NewFromInt(3)),
+				)
+			},
+			checkResult: func(result MatchResult) {
+				require.Len(t, result.Fills, 1)
+				require.Equal(t, 1, result.SelfTrades)
+				require.Zero(t, result.Remaining)
+				require.False(t, result.Resting)
+			},
+			checkBook: func(book *OrderBook) {
+				require.Empty(t, book.Orders(util.BID))
+				require.Len(t, book.Orders(util.ASK), 1)
+				require.Equal(t, decimal.NewFromInt(2), book.Orders(util.ASK)[0].Amount)
+			},
+		},
+		{
+			name:   "DecrementMaker",
+			mode:   util.DECREMENT_AND_CANCEL,
+			amount: 2,
+			buildStubs: func(store *mockdb.MockStore, bid db.Bid) {
+				decremented := ownAsk
+				decremented.Amount = decimal.NewFromInt(3)
+				decremented.RemainingAmount = decimal.NewFromInt(3)
+
+				expectSelfTrade(store, bid, ownAsk, util.BID, util.DECREMENT_AND_CANCEL, db.SelfTradeTxResult{
+					Bid:      canceledBid(bid),
+					Ask:      decremented,
+					Canceled: db.OrderGroupLegs{Bids: []db.Bid{canceledBid(bid)}},
+				})
+				store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResult: func(result MatchResult) {
+				require.Empty(t, result.Fills)
+				require.Equal(t, 1, result.SelfTrades)
+				require.False(t, result.Resting)
+			},
+			checkBook: func(book *OrderBook) {
+				require.Empty(t, book.Orders(util.BID))
+				requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(ownAsk), orderFromAsk(otherAsk))
+				require.Equal(t, decimal.NewFromInt(3), book.Orders(util.ASK)[0].Amount)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bid := randomBid(120, tc.amount)
+			bid.Owner = owner
+			bid.SelfTradePrevention = tc.mode
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store, bid)
+
+			engine := newTestEngine(store, nil, []db.Ask{ownAsk, otherAsk})
+
+			result, err := engine.PlaceBid(context.Background(), bid)
+			require.NoError(t, err)
+			tc.checkResult(result)
+
+			book, err := engine.Book(util.BTC_USDT)
+			require.NoError(t, err)
+			tc.checkBook(book)
+		})
+	}
+}
+
+func TestSelfTradePreventionFillOrKill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := util.RandomOwner()
+	ownBid := randomBid(100, 5)
+	ownBid.Owner = owner
+	ask := randomAsk(100, 5)
+	ask.Owner = owner
+	ask.TimeInForce = util.FOK
+
+	// the own bid can't fill the ask, so it is killed without preventing anything
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().SelfTradeTx(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().CancelAskTx(gomock.Any(), gomock.Eq(ask.ID)).Times(1)
+
+	engine := newTestEngine(store, []db.Bid{ownBid}, nil)
+
+	result, err := engine.PlaceAsk(context.Background(), ask)
+	require.NoError(t, err)
+	require.Zero(t, result.SelfTrades)
+	require.False(t, result.Resting)
+}
+
+func TestSelfTradePreventionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := util.RandomOwner()
+	ownAsk := randomAsk(100, 5)
+	ownAsk.Owner = owner
+	bid := randomBid(100, 5)
+	bid.Owner = owner
+	bid.SelfTradePrevention = util.CANCEL_NEWEST
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().SelfTradeTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SelfTradeTxResult{}, sql.ErrConnDone)
+
+	engine := newTestEngine(store, nil, []db.Ask{ownAsk})
+
+	result, err := engine.PlaceBid(context.Background(), bid)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.Equal(t, decimal.NewFromInt(5), result.Remaining)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Orders(util.ASK), orderFromAsk(ownAsk))
+}
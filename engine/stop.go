@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/util"
+)
+
+// StopMarketPrice returns the worst price a stop market order can trade at once it is triggered.
+// maxSlippage bounds the price in basis points above the stop price for bids and below it for asks,
+// and the price is rounded to the tick size of the pair without going past that bound
+func StopMarketPrice(side string, stopPrice decimal.Decimal, maxSlippage int64, tickSize decimal.Decimal) decimal.Decimal {
+	price := slippageLimit(side, stopPrice, maxSlippage)
+	if side == util.BID {
+		return price.RoundToMultiple(tickSize, decimal.RoundDown)
+	}
+	return price.RoundToMultiple(tickSize, decimal.RoundUp)
+}
+
+// cascade enters the orders released by the trades on the book as new orders:
+// the activated legs of order groups and the stop orders triggered by the last trade price.
+// Their trades can release more orders, so it repeats until nothing is released.
+// The caller must hold the book lock
+func (engine *Engine) cascade(ctx context.Context, book *OrderBook) error {
+	for {
+		if len(book.activated) > 0 {
+			order := book.activated[0]
+			book.activated = book.activated[1:]
+
+			if _, err := engine.enter(ctx, book, order, util.IsStopOrderType(order.Type)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		stops := book.TriggerStops()
+		if len(stops) == 0 {
+			return nil
+		}
+
+		for i, stop := range stops {
+			err := engine.trigger(ctx, stop)
+			if errors.Is(err, sql.ErrNoRows) {
+				// the stop order was closed in the meantime
+				continue
+			}
+			if err == nil {
+				_, err = engine.match(ctx, book, stop)
+			}
+			if err != nil {
+				// keep the stop orders that didn't enter matching for the next trade
+				for _, waiting := range stops[i+1:] {
+					book.AddStop(waiting)
+				}
+				return err
+			}
+		}
+	}
+}
+
+// trigger activates a pending stop order in the store before it enters matching
+func (engine *Engine) trigger(ctx context.Context, order *Order) error {
+	var err error
+	if order.Side == util.BID {
+		_, err = engine.store.TriggerBid(ctx, order.ID)
+	} else {
+		_, err = engine.store.TriggerAsk(ctx, order.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot trigger %s %d: %w", order.Side, order.ID, err)
+	}
+	return nil
+}
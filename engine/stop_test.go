@@ -0,0 +1,173 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomStopBid(orderType string, price int64, stopPrice int64, amount int64) db.Bid {
+	bid := randomBid(price, amount)
+	bid.Type = orderType
+	bid.Status = util.PENDING
+	bid.StopPrice = decimal.NewFromInt(stopPrice)
+	if orderType == util.STOP_MARKET {
+		bid.TimeInForce = util.IOC
+	}
+	return bid
+}
+
+func randomStopAsk(orderType string, price int64, stopPrice int64, amount int64) db.Ask {
+	ask := randomAsk(price, amount)
+	ask.Type = orderType
+	ask.Status = util.PENDING
+	ask.StopPrice = decimal.NewFromInt(stopPrice)
+	if orderType == util.STOP_MARKET {
+		ask.TimeInForce = util.IOC
+	}
+	return ask
+}
+
+func TestStopMarketPrice(t *testing.T) {
+	stopPrice, tickSize := decimal.NewFromInt(100), decimal.NewFromInt(1)
+	require.Equal(t, decimal.NewFromInt(110), StopMarketPrice(util.BID, stopPrice, 1000, tickSize))
+	require.Equal(t, decimal.NewFromInt(90), StopMarketPrice(util.ASK, stopPrice, 1000, tickSize))
+	require.Equal(t, stopPrice, StopMarketPrice(util.BID, stopPrice, 0, tickSize))
+	require.Equal(t, stopPrice, StopMarketPrice(util.ASK, stopPrice, 0, tickSize))
+
+	// the price is rounded to the tick size without going past the slippage
+	stopPrice, tickSize = decimal.RequireFromString("101.3"), decimal.RequireFromString("0.1")
+	require.Equal(t, decimal.RequireFromString("101.5"), StopMarketPrice(util.BID, stopPrice, 25, tickSize))
+	require.Equal(t, decimal.RequireFromString("101.1"), StopMarketPrice(util.ASK, stopPrice, 25, tickSize))
+}
+
+func TestPlaceStopOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid := randomBid(100, 10)
+	stopAsk := randomStopAsk(util.STOP_LIMIT, 90, 100, 4)
+	stopBid := randomStopBid(util.STOP_LIMIT, 130, 120, 2)
+
+	engine := newTestEngine(store, []db.Bid{bid}, nil)
+
+	// nothing traded yet, so both stop orders wait off the book
+	result, err := engine.PlaceAsk(context.Background(), stopAsk)
+	require.NoError(t, err)
+	require.Empty(t, result.Fills)
+	require.True(t, result.Resting)
+
+	result, err = engine.PlaceBid(context.Background(), stopBid)
+	require.NoError(t, err)
+	require.True(t, result.Resting)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	requireOrderIDs(t, book.Stops(), orderFromAsk(stopAsk), orderFromBid(stopBid))
+	requireOrderIDs(t, book.Orders(util.ASK))
+
+	// a trade at the stop price triggers the stop ask, which then trades against the rest of the bid
+	ask := randomAsk(100, 1)
+	gomock.InOrder(
+		expectFill(store, util.ASK, bid, ask, bid.Price, ask.Amount),
+		store.EXPECT().TriggerAsk(gomock.Any(), gomock.Eq(stopAsk.ID)).Times(1),
+		expectFill(store, util.ASK, bid, stopAsk, bid.Price, stopAsk.Amount),
+	)
+	store.EXPECT().TriggerBid(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err = engine.PlaceAsk(context.Background(), ask)
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+	require.Zero(t, result.Remaining)
+
+	requireOrderIDs(t, book.Stops(), orderFromBid(stopBid))
+	require.Equal(t, bid.Amount.Sub(ask.Amount).Sub(stopAsk.Amount), book.Best(util.BID).Amount)
+	require.Equal(t, bid.Price, book.LastPrice())
+}
+
+func TestPlaceTriggeredStopOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid := randomBid(100, 10)
+	ask := randomAsk(110, 10)
+	engine := newTestEngine(store, []db.Bid{bid}, []db.Ask{ask})
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	book.lastPrice = decimal.NewFromInt(105)
+
+	// the last trade is already above the stop price, so the stop market bid is triggered right away
+	stopBid := randomStopBid(util.STOP_MARKET, 110, 100, 4)
+	gomock.InOrder(
+		store.EXPECT().TriggerBid(gomock.Any(), gomock.Eq(stopBid.ID)).Times(1),
+		expectFill(store, util.BID, stopBid, ask, ask.Price, stopBid.Amount),
+	)
+
+	result, err := engine.PlaceBid(context.Background(), stopBid)
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+	require.Zero(t, result.Remaining)
+	require.Empty(t, book.Stops())
+	require.Equal(t, ask.Price, book.LastPrice())
+}
+
+func TestTriggerClosedStopOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	bid := randomBid(100, 10)
+	stopAsk := randomStopAsk(util.STOP_LIMIT, 90, 100, 4)
+	engine := newTestEngine(store, []db.Bid{bid}, nil)
+
+	_, err := engine.PlaceAsk(context.Background(), stopAsk)
+	require.NoError(t, err)
+
+	// the stop ask was closed after it was placed, so it must not trade
+	ask := randomAsk(100, 1)
+	expectFill(store, util.ASK, bid, ask, bid.Price, ask.Amount)
+	store.EXPECT().TriggerAsk(gomock.Any(), gomock.Eq(stopAsk.ID)).Times(1).Return(db.Ask{}, sql.ErrNoRows)
+
+	_, err = engine.PlaceAsk(context.Background(), ask)
+	require.NoError(t, err)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Stops())
+	require.Equal(t, bid.Amount.Sub(ask.Amount), book.Best(util.BID).Amount)
+}
+
+func TestCancelStopOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stopBid := randomStopBid(util.STOP_LIMIT, 130, 120, 2)
+	engine := newTestEngine(mockdb.NewMockStore(ctrl), nil, nil)
+
+	_, err := engine.PlaceBid(context.Background(), stopBid)
+	require.NoError(t, err)
+
+	remaining, ok := engine.CancelBid(stopBid)
+	require.True(t, ok)
+	require.Equal(t, stopBid.Amount, remaining)
+
+	_, ok = engine.CancelBid(stopBid)
+	require.False(t, ok)
+
+	book, err := engine.Book(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Empty(t, book.Stops())
+}
@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"time"
+)
+
+// tickerWindow is the rolling window the ticker statistics are computed over
+const tickerWindow = 24 * time.Hour
+
+// Ticker is the 24-hour trading summary of a pair
+type Ticker struct {
+	Pair          string          `json:"pair"`
+	LastPrice     decimal.Decimal `json:"last_price"`
+	BestBid       decimal.Decimal `json:"best_bid"`
+	BestAsk       decimal.Decimal `json:"best_ask"`
+	Open          decimal.Decimal `json:"open"`
+	High          decimal.Decimal `json:"high"`
+	Low           decimal.Decimal `json:"low"`
+	Volume        decimal.Decimal `json:"volume"`
+	QuoteVolume   decimal.Decimal `json:"quote_volume"`
+	PriceChange   decimal.Decimal `json:"price_change"`
+	ChangePercent decimal.Decimal `json:"change_percent"`
+	TradeCount    int64           `json:"trade_count"`
+}
+
+// minuteStats aggregates the trades of a pair executed within the same minute
+type minuteStats struct {
+	openTime    time.Time
+	open        decimal.Decimal
+	high        decimal.Decimal
+	low         decimal.Decimal
+	close       decimal.Decimal
+	volume      decimal.Decimal
+	quoteVolume decimal.Decimal
+	tradeCount  int64
+}
+
+// tradeStats keeps one bucket per minute of the rolling window, oldest first,
+// so a ticker never has to scan the trades themselves
+type tradeStats struct {
+	minutes []minuteStats
+}
+
+// record adds a trade executed at a time to the bucket of its minute
+func (stats *tradeStats) record(at time.Time, price decimal.Decimal, amount decimal.Decimal) {
+	openTime := at.Truncate(time.Minute)
+	value := price.Mul(amount)
+
+	last := len(stats.minutes) - 1
+	if last >= 0 && !stats.minutes[last].openTime.Before(openTime) {
+		minute := &stats.minutes[last]
+		minute.high = decimal.Max(minute.high, price)
+		minute.low = decimal.Min(minute.low, price)
+		minute.close = price
+		minute.volume = minute.volume.Add(amount)
+		minute.quoteVolume = minute.quoteVolume.Add(value)
+		minute.tradeCount++
+		return
+	}
+
+	stats.minutes = append(stats.minutes, minuteStats{
+		openTime:    openTime,
+		open:        price,
+		high:        price,
+		low:         price,
+		close:       price,
+		volume:      amount,
+		quoteVolume: value,
+		tradeCount:  1,
+	})
+}
+
+// prune drops the buckets that left the rolling window ending at now
+func (stats *tradeStats) prune(now time.Time) {
+	start := now.Add(-tickerWindow)
+
+	i := 0
+	for i < len(stats.minutes) && !stats.minutes[i].openTime.After(start) {
+		i++
+	}
+	stats.minutes = stats.minutes[i:]
+}
+
+// Ticker returns the 24-hour trading summary of a listed pair
+func (engine *Engine) Ticker(pair string) (Ticker, error) {
+	book, err := engine.Book(pair)
+	if err != nil {
+		return Ticker{}, err
+	}
+
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	return book.Ticker(time.Now()), nil
+}
+
+// Tickers returns the 24-hour trading summaries of every pair of the registry
+func (engine *Engine) Tickers() []Ticker {
+	pairs := engine.registry.Pairs()
+
+	tickers := make([]Ticker, 0, len(pairs))
+	for _, pair := range pairs {
+		ticker, err := engine.Ticker(pair.Symbol)
+		if err != nil {
+			continue
+		}
+		tickers = append(tickers, ticker)
+	}
+	return tickers
+}
+
+// LoadTickers rebuilds the 24-hour trading statistics of the pairs of the registry from their 1-minute candles.
+// It must run before Load, whose replay records the trades it makes
+func (engine *Engine) LoadTickers(ctx context.Context) error {
+	now := time.Now()
+	limit := int32(tickerWindow / time.Minute)
+
+	for _, pair := range engine.registry.Pairs() {
+		candles, err := engine.store.ListCandles(ctx, db.ListCandlesParams{
+			Pair:     pair.Symbol,
+			Interval: util.ONE_MINUTE,
+			FromTime: now.Add(-tickerWindow),
+			ToTime:   now,
+			Limit:    limit,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot list candles of %s: %w", pair.Symbol, err)
+		}
+
+		book, err := engine.Book(pair.Symbol)
+		if err != nil {
+			return err
+		}
+
+		book.mu.Lock()
+		book.stats.minutes = make([]minuteStats, 0, len(candles))
+		for _, candle := range candles {
+			book.stats.minutes = append(book.stats.minutes, minuteStats{
+				openTime:    candle.OpenTime,
+				open:        candle.Open,
+				high:        candle.High,
+				low:         candle.Low,
+				close:       candle.Close,
+				volume:      candle.Volume,
+				quoteVolume: candle.QuoteVolume,
+				tradeCount:  candle.TradeCount,
+			})
+		}
+		book.mu.Unlock()
+	}
+
+	return nil
+}
+
+// Ticker returns the trading summary of the book over the rolling window ending at now.
+// Best bid and ask are the best displayed prices, and the last price outlives the window.
+// The caller must hold the book lock
+func (book *OrderBook) Ticker(now time.Time) Ticker {
+	book.stats.prune(now)
+
+	ticker := Ticker{
+		Pair:      book.pair,
+		LastPrice: book.lastPrice,
+	}
+
+	if levels := book.levels(util.BID, 1); len(levels) > 0 {
+		ticker.BestBid = levels[0].Price
+	}
+	if levels := book.levels(util.ASK, 1); len(levels) > 0 {
+		ticker.BestAsk = levels[0].Price
+	}
+
+	minutes := book.stats.minutes
+	if len(minutes) == 0 {
+		return ticker
+	}
+
+	ticker.Open = minutes[0].open
+	ticker.High = minutes[0].high
+	ticker.Low = minutes[0].low
+	for _, minute := range minutes {
+		ticker.High = decimal.Max(ticker.High, minute.high)
+		ticker.Low = decimal.Min(ticker.Low, minute.low)
+		ticker.Volume = ticker.Volume.Add(minute.volume)
+		ticker.QuoteVolume = ticker.QuoteVolume.Add(minute.quoteVolume)
+		ticker.TradeCount += minute.tradeCount
+	}
+
+	if ticker.LastPrice.IsZero() {
+		ticker.LastPrice = minutes[len(minutes)-1].close
+	}
+
+	ticker.PriceChange = ticker.LastPrice.Sub(ticker.Open)
+	ticker.ChangePercent = ticker.PriceChange.Mul(decimal.NewFromInt(100)).Div(ticker.Open, 2, decimal.RoundHalfEven)
+	return ticker
+}
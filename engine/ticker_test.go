@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"context"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/feed"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBookTicker(t *testing.T) {
+	book := NewOrderBook(util.BTC_USDT)
+	now := time.Now()
+
+	ticker := book.Ticker(now)
+	require.Equal(t, Ticker{Pair: util.BTC_USDT}, ticker)
+
+	// the first trade leaves the window, the others fall in two different minutes
+	book.stats.record(now.Add(-25*time.Hour), decimal.NewFromInt(50), decimal.NewFromInt(9))
+	book.stats.record(now.Add(-2*time.Hour), decimal.NewFromInt(100), decimal.NewFromInt(2))
+	book.stats.record(now.Add(-2*time.Hour), decimal.NewFromInt(130), decimal.NewFromInt(1))
+	book.stats.record(now.Add(-time.Hour), decimal.NewFromInt(90), decimal.NewFromInt(3))
+	book.stats.record(now.Add(-time.Hour), decimal.NewFromInt(110), decimal.NewFromInt(1))
+
+	bid := randomOrder(util.BID, 105)
+	hidden := randomOrder(util.ASK, 106)
+	hidden.Hidden = true
+	ask := randomOrder(util.ASK, 108)
+	for _, order := range []*Order{bid, hidden, ask} {
+		book.Add(order)
+	}
+
+	ticker = book.Ticker(now)
+	require.Equal(t, Ticker{
+		Pair:          util.BTC_USDT,
+		LastPrice:     decimal.NewFromInt(110),
+		BestBid:       decimal.NewFromInt(105),
+		BestAsk:       decimal.NewFromInt(108),
+		Open:          decimal.NewFromInt(100),
+		High:          decimal.NewFromInt(130),
+		Low:           decimal.NewFromInt(90),
+		Volume:        decimal.NewFromInt(7),
+		QuoteVolume:   decimal.NewFromInt(200 + 130 + 270 + 110),
+		PriceChange:   decimal.NewFromInt(10),
+		ChangePercent: decimal.NewFromInt(10),
+		TradeCount:    4,
+	}, ticker)
+	require.Len(t, book.stats.minutes, 2)
+
+	// the last price of the book is kept after every trade left the window
+	book.lastPrice = decimal.NewFromInt(110)
+	ticker = book.Ticker(now.Add(tickerWindow))
+	require.Equal(t, decimal.NewFromInt(110), ticker.LastPrice)
+	require.Zero(t, ticker.TradeCount)
+	require.True(t, ticker.Volume.IsZero())
+	require.Empty(t, book.stats.minutes)
+}
+
+func TestTickerRefreshedOnTrade(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ask := randomAsk(100, 10)
+	bid := randomBid(100, 4)
+
+	store := mockdb.NewMockStore(ctrl)
+	expectFill(store, util.BID, bid, ask, decimal.NewFromInt(100), decimal.NewFromInt(4))
+
+	engine := newTestEngine(store, nil, []db.Ask{ask})
+
+	_, err := engine.PlaceBid(context.Background(), bid)
+	require.NoError(t, err)
+
+	ticker, err := engine.Ticker(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(100), ticker.LastPrice)
+	require.Equal(t, decimal.NewFromInt(4), ticker.Volume)
+	require.Equal(t, decimal.NewFromInt(400), ticker.QuoteVolume)
+	require.Equal(t, int64(1), ticker.TradeCount)
+	require.True(t, ticker.BestBid.IsZero())
+	require.Equal(t, decimal.NewFromInt(100), ticker.BestAsk)
+
+	_, err = engine.Ticker("XYZ/USDT")
+	require.Error(t, err)
+
+	tickers := engine.Tickers()
+	require.Len(t, tickers, 3)
+	require.Equal(t, util.BTC_USDT, tickers[0].Pair)
+}
+
+func TestLoadTickers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	candles := []db.Candle{
+		{
+			Pair:        util.BTC_USDT,
+			Interval:    util.ONE_MINUTE,
+			OpenTime:    now.Add(-3 * time.Hour).Truncate(time.Minute),
+			Open:        decimal.NewFromInt(100),
+			High:        decimal.NewFromInt(120),
+			Low:         decimal.NewFromInt(95),
+			Close:       decimal.NewFromInt(110),
+			Volume:      decimal.NewFromInt(5),
+			QuoteVolume: decimal.NewFromInt(520),
+			TradeCount:  3,
+		},
+		{
+			Pair:        util.BTC_USDT,
+			Interval:    util.ONE_MINUTE,
+			OpenTime:    now.Add(-time.Hour).Truncate(time.Minute),
+			Open:        decimal.NewFromInt(110),
+			High:        decimal.NewFromInt(115),
+			Low:         decimal.NewFromInt(105),
+			Close:       decimal.NewFromInt(105),
+			Volume:      decimal.NewFromInt(2),
+			QuoteVolume: decimal.NewFromInt(220),
+			TradeCount:  2,
+		},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListCandles(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+		func(ctx context.Context, arg db.ListCandlesParams) ([]db.Candle, error) {
+			require.Equal(t, util.ONE_MINUTE, arg.Interval)
+			require.Equal(t, arg.ToTime.Add(-tickerWindow), arg.FromTime)
+			if arg.Pair == util.BTC_USDT {
+				return candles, nil
+			}
+			return []db.Candle{}, nil
+		})
+
+	engine := NewEngine(store, newTestRegistry(), feed.NewHub())
+	err := engine.LoadTickers(context.Background())
+	require.NoError(t, err)
+
+	ticker, err := engine.Ticker(util.BTC_USDT)
+	require.NoError(t, err)
+	require.Equal(t, decimal.NewFromInt(105), ticker.LastPrice)
+	require.Equal(t, decimal.NewFromInt(100), ticker.Open)
+	require.Equal(t, decimal.NewFromInt(120), ticker.High)
+	require.Equal(t, decimal.NewFromInt(95), ticker.Low)
+	require.Equal(t, decimal.NewFromInt(7), ticker.Volume)
+	require.Equal(t, decimal.NewFromInt(740), ticker.QuoteVolume)
+	require.Equal(t, decimal.NewFromInt(5), ticker.ChangePercent)
+	require.Equal(t, int64(5), ticker.TradeCount)
+}
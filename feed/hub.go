@@ -0,0 +1,183 @@
+package feed
+
+import (
+	"sync"
+)
+
+// Channels of the feed. Market data channels are keyed by pair and private channels by username
+const (
+	BOOK     = "book"
+	TRADES   = "trades"
+	TICKER   = "ticker"
+	ORDERS   = "orders"
+	FILLS    = "fills"
+	BALANCES = "balances"
+)
+
+// Types of the events of a channel
+const (
+	SNAPSHOT = "snapshot"
+	UPDATE   = "update"
+)
+
+// Event is a message published on a topic of the hub.
+// Sequence increases by one with every update of a topic, so a subscriber that sees a gap missed an update
+// and must resync from a snapshot
+type Event struct {
+	Channel  string      `json:"channel"`
+	Key      string      `json:"key"`
+	Type     string      `json:"type"`
+	Sequence uint64      `json:"sequence"`
+	Data     interface{} `json:"data"`
+}
+
+// Topic returns the topic of a channel for a pair or a username
+func Topic(channel string, key string) string {
+	return channel + ":" + key
+}
+
+// IsSupportedChannel returns true if the channel is supported
+func IsSupportedChannel(channel string) bool {
+	switch channel {
+	case BOOK, TRADES, TICKER, ORDERS, FILLS, BALANCES:
+		return true
+	}
+	return false
+}
+
+// IsPrivateChannel returns true if the channel only carries the events of the authenticated user
+func IsPrivateChannel(channel string) bool {
+	switch channel {
+	case ORDERS, FILLS, BALANCES:
+		return true
+	}
+	return false
+}
+
+// Hub fans the events published on a topic out to its subscribers.
+// Publishing never blocks: a subscriber whose buffer is full is dropped and its events channel closed
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*Subscription]struct{}
+	sequences   map[string]uint64
+}
+
+// NewHub creates a hub without subscribers
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[string]map[*Subscription]struct{}),
+		sequences:   make(map[string]uint64),
+	}
+}
+
+// Subscription receives the events published on a topic until it is closed or dropped
+type Subscription struct {
+	hub     *Hub
+	topic   string
+	events  chan Event
+	dropped bool
+}
+
+// Subscribe registers a subscription to a topic that buffers up to buffer events
+func (hub *Hub) Subscribe(topic string, buffer int) *Subscription {
+	sub := &Subscription{
+		hub:    hub,
+		topic:  topic,
+		events: make(chan Event, buffer),
+	}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	subs, ok := hub.subscribers[topic]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		hub.subscribers[topic] = subs
+	}
+	subs[sub] = struct{}{}
+	return sub
+}
+
+// Topic returns the topic of the subscription
+func (sub *Subscription) Topic() string {
+	return sub.topic
+}
+
+// Events returns the channel the events of the subscription are delivered on.
+// It is closed once the subscription is closed or dropped
+func (sub *Subscription) Events() <-chan Event {
+	return sub.events
+}
+
+// Dropped returns true if the subscription was closed because it fell behind.
+// It must only be called once the events channel is closed
+func (sub *Subscription) Dropped() bool {
+	sub.hub.mu.RLock()
+	defer sub.hub.mu.RUnlock()
+
+	return sub.dropped
+}
+
+// Close unsubscribes from the topic and closes the events channel
+func (sub *Subscription) Close() {
+	sub.hub.mu.Lock()
+	defer sub.hub.mu.Unlock()
+
+	sub.hub.remove(sub)
+}
+
+// Publish stamps an event with the next sequence number of its topic and delivers it to the subscribers of the topic.
+// Events are delivered in sequence order, since the hub is locked while one is published
+func (hub *Hub) Publish(event Event) {
+	topic := Topic(event.Channel, event.Key)
+	if event.Type == "" {
+		event.Type = UPDATE
+	}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	hub.sequences[topic]++
+	event.Sequence = hub.sequences[topic]
+
+	for sub := range hub.subscribers[topic] {
+		select {
+		case sub.events <- event:
+		default:
+			hub.remove(sub)
+			sub.dropped = true
+		}
+	}
+}
+
+// Sequence returns the sequence number of the last event published on a topic.
+// A snapshot taken while no event of the topic can be published is consistent with it
+func (hub *Hub) Sequence(topic string) uint64 {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	return hub.sequences[topic]
+}
+
+// HasSubscribers returns true if a topic has at least one subscriber
+func (hub *Hub) HasSubscribers(topic string) bool {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+
+	return len(hub.subscribers[topic]) > 0
+}
+
+// remove unregisters a subscription and closes its events channel unless it was already removed.
+// The caller must hold the write lock, so no event is sent on the closed channel
+func (hub *Hub) remove(sub *Subscription) {
+	subs := hub.subscribers[sub.topic]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(hub.subscribers, sub.topic)
+	}
+	close(sub.events)
+}
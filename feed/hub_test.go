@@ -0,0 +1,83 @@
+package feed
+
+import (
+	"go-exchange/util"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubPublish(t *testing.T) {
+	hub := NewHub()
+	topic := Topic(BOOK, util.BTC_USDT)
+
+	// events are sequenced even without subscribers, so a later snapshot carries the right sequence
+	hub.Publish(Event{Channel: BOOK, Key: util.BTC_USDT, Data: 1})
+	require.Equal(t, uint64(1), hub.Sequence(topic))
+	require.False(t, hub.HasSubscribers(topic))
+
+	sub := hub.Subscribe(topic, 10)
+	other := hub.Subscribe(Topic(BOOK, util.ETH_USDT), 10)
+	require.True(t, hub.HasSubscribers(topic))
+
+	hub.Publish(Event{Channel: BOOK, Key: util.BTC_USDT, Data: 2})
+	hub.Publish(Event{Channel: BOOK, Key: util.BTC_USDT, Data: 3})
+
+	for i, data := range []int{2, 3} {
+		event := <-sub.Events()
+		require.Equal(t, BOOK, event.Channel)
+		require.Equal(t, util.BTC_USDT, event.Key)
+		require.Equal(t, UPDATE, event.Type)
+		require.Equal(t, uint64(i+2), event.Sequence)
+		require.Equal(t, data, event.Data)
+	}
+	require.Empty(t, other.Events())
+
+	sub.Close()
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.False(t, sub.Dropped())
+	require.False(t, hub.HasSubscribers(topic))
+
+	// closing twice is harmless
+	sub.Close()
+	other.Close()
+}
+
+func TestHubDropsSlowSubscriber(t *testing.T) {
+	hub := NewHub()
+	username := util.RandomOwner()
+	topic := Topic(ORDERS, username)
+
+	slow := hub.Subscribe(topic, 1)
+	fast := hub.Subscribe(topic, 10)
+
+	hub.Publish(Event{Channel: ORDERS, Key: username})
+	hub.Publish(Event{Channel: ORDERS, Key: username})
+
+	event, ok := <-slow.Events()
+	require.True(t, ok)
+	require.Equal(t, uint64(1), event.Sequence)
+	_, ok = <-slow.Events()
+	require.False(t, ok)
+	require.True(t, slow.Dropped())
+
+	require.Len(t, fast.Events(), 2)
+	require.True(t, hub.HasSubscribers(topic))
+
+	// the dropped subscription is already closed
+	slow.Close()
+	fast.Close()
+}
+
+func TestChannels(t *testing.T) {
+	for _, channel := range []string{BOOK, TRADES, TICKER} {
+		require.True(t, IsSupportedChannel(channel))
+		require.False(t, IsPrivateChannel(channel))
+	}
+	for _, channel := range []string{ORDERS, FILLS, BALANCES} {
+		require.True(t, IsSupportedChannel(channel))
+		require.True(t, IsPrivateChannel(channel))
+	}
+	require.False(t, IsSupportedChannel("candles"))
+}
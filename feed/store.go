@@ -0,0 +1,360 @@
+package feed
+
+import (
+	"context"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Order is the state of an order published on the orders channel of its owner after every change
+type Order struct {
+	ID              int64           `json:"id"`
+	Pair            string          `json:"pair"`
+	Side            string          `json:"side"`
+	Type            string          `json:"type"`
+	TimeInForce     string          `json:"time_in_force"`
+	Status          string          `json:"status"`
+	Price           decimal.Decimal `json:"price"`
+	StopPrice       decimal.Decimal `json:"stop_price"`
+	Amount          decimal.Decimal `json:"amount"`
+	FilledAmount    decimal.Decimal `json:"filled_amount"`
+	RemainingAmount decimal.Decimal `json:"remaining_amount"`
+	AveragePrice    decimal.Decimal `json:"average_price"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// Fill is an execution of an order published on the fills channel of its owner
+type Fill struct {
+	TradeID   int64           `json:"trade_id"`
+	OrderID   int64           `json:"order_id"`
+	Pair      string          `json:"pair"`
+	Side      string          `json:"side"`
+	Price     decimal.Decimal `json:"price"`
+	Amount    decimal.Decimal `json:"amount"`
+	Fee       decimal.Decimal `json:"fee"`
+	Taker     bool            `json:"taker"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// NewOrderFromBid returns the published state of a bid
+func NewOrderFromBid(bid db.Bid) Order {
+	return Order{
+		ID:              bid.ID,
+		Pair:            bid.Pair,
+		Side:            util.BID,
+		Type:            bid.Type,
+		TimeInForce:     bid.TimeInForce,
+		Status:          bid.Status,
+		Price:           bid.Price,
+		StopPrice:       bid.StopPrice,
+		Amount:          bid.Amount,
+		FilledAmount:    bid.FilledAmount,
+		RemainingAmount: bid.RemainingAmount,
+		AveragePrice:    bid.AveragePrice,
+		CreatedAt:       bid.CreatedAt,
+	}
+}
+
+// NewOrderFromAsk returns the published state of an ask
+func NewOrderFromAsk(ask db.Ask) Order {
+	return Order{
+		ID:              ask.ID,
+		Pair:            ask.Pair,
+		Side:            util.ASK,
+		Type:            ask.Type,
+		TimeInForce:     ask.TimeInForce,
+		Status:          ask.Status,
+		Price:           ask.Price,
+		StopPrice:       ask.StopPrice,
+		Amount:          ask.Amount,
+		FilledAmount:    ask.FilledAmount,
+		RemainingAmount: ask.RemainingAmount,
+		AveragePrice:    ask.AveragePrice,
+		CreatedAt:       ask.CreatedAt,
+	}
+}
+
+// publishingStore publishes the order, fill and balance changes of the transactions it commits
+type publishingStore struct {
+	db.Store
+	hub *Hub
+}
+
+// NewStore wraps a store so every transaction that changes orders or accounts publishes the new states
+// on the private channels of their owners once it is committed
+func NewStore(store db.Store, hub *Hub) db.Store {
+	return &publishingStore{
+		Store: store,
+		hub:   hub,
+	}
+}
+
+func (store *publishingStore) TransferTx(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+	result, err := store.Store.TransferTx(ctx, arg)
+	if err == nil {
+		store.publishAccount(result.FromAccount)
+		store.publishAccount(result.ToAccount)
+	}
+	return result, err
+}
+
+func (store *publishingStore) TradeTx(ctx context.Context, arg db.TradeTxParams) (db.TradeTxResult, error) {
+	result, err := store.Store.TradeTx(ctx, arg)
+	if err == nil {
+		store.publishAccounts(ctx, result.Trade.FirstFromAccountID, result.Trade.FirstToAccountID,
+			result.Trade.SecondFromAccountID, result.Trade.SecondToAccountID)
+	}
+	return result, err
+}
+
+func (store *publishingStore) FillTx(ctx context.Context, arg db.FillTxParams) (db.FillTxResult, error) {
+	result, err := store.Store.FillTx(ctx, arg)
+	if err != nil {
+		return result, err
+	}
+
+	// the bid receives the second amount of the trade in the base currency and the ask the first one in the quote currency
+	store.publish(FILLS, result.Bid.Owner, Fill{
+		TradeID:   result.Trade.ID,
+		OrderID:   result.Bid.ID,
+		Pair:      result.Bid.Pair,
+		Side:      util.BID,
+		Price:     result.Fill.Price,
+		Amount:    result.Fill.Amount,
+		Fee:       result.Trade.SecondFee,
+		Taker:     arg.TakerSide == util.BID,
+		CreatedAt: result.Fill.CreatedAt,
+	})
+	store.publish(FILLS, result.Ask.Owner, Fill{
+		TradeID:   result.Trade.ID,
+		OrderID:   result.Ask.ID,
+		Pair:      result.Ask.Pair,
+		Side:      util.ASK,
+		Price:     result.Fill.Price,
+		Amount:    result.Fill.Amount,
+		Fee:       result.Trade.FirstFee,
+		Taker:     arg.TakerSide == util.ASK,
+		CreatedAt: result.Fill.CreatedAt,
+	})
+
+	store.publishBid(ctx, result.Bid, result.Bid.ToAccountID)
+	store.publishAsk(ctx, result.Ask, result.Ask.ToAccountID)
+	store.publishLegs(ctx, result.Canceled)
+	store.publishLegs(ctx, result.Activated)
+	return result, nil
+}
+
+func (store *publishingStore) CreateBidTx(ctx context.Context, arg db.CreateBidParams) (db.CreateBidTxResult, error) {
+	result, err := store.Store.CreateBidTx(ctx, arg)
+	if err == nil {
+		store.publish(ORDERS, result.Bid.Owner, NewOrderFromBid(result.Bid))
+		store.publishAccount(result.FromAccount)
+	}
+	return result, err
+}
+
+func (store *publishingStore) AmendBidTx(ctx context.Context, arg db.AmendBidParams) (db.AmendBidTxResult, error) {
+	result, err := store.Store.AmendBidTx(ctx, arg)
+	if err == nil {
+		store.publish(ORDERS, result.Bid.Owner, NewOrderFromBid(result.Bid))
+		store.publishAccount(result.FromAccount)
+	}
+	return result, err
+}
+
+func (store *publishingStore) CancelBidTx(ctx context.Context, id int64) (db.CancelBidTxResult, error) {
+	result, err := store.Store.CancelBidTx(ctx, id)
+	if err == nil {
+		store.publishCanceledBid(ctx, result)
+	}
+	return result, err
+}
+
+func (store *publishingStore) ExpireBidTx(ctx context.Context, id int64) (db.CancelBidTxResult, error) {
+	result, err := store.Store.ExpireBidTx(ctx, id)
+	if err == nil {
+		store.publishCanceledBid(ctx, result)
+	}
+	return result, err
+}
+
+func (store *publishingStore) TriggerBid(ctx context.Context, id int64) (db.Bid, error) {
+	bid, err := store.Store.TriggerBid(ctx, id)
+	if err == nil {
+		store.publishBid(ctx, bid)
+	}
+	return bid, err
+}
+
+func (store *publishingStore) CreateAskTx(ctx context.Context, arg db.CreateAskParams) (db.CreateAskTxResult, error) {
+	result, err := store.Store.CreateAskTx(ctx, arg)
+	if err == nil {
+		store.publish(ORDERS, result.Ask.Owner, NewOrderFromAsk(result.Ask))
+		store.publishAccount(result.FromAccount)
+	}
+	return result, err
+}
+
+func (store *publishingStore) AmendAskTx(ctx context.Context, arg db.AmendAskParams) (db.AmendAskTxResult, error) {
+	result, err := store.Store.AmendAskTx(ctx, arg)
+	if err == nil {
+		store.publish(ORDERS, result.Ask.Owner, NewOrderFromAsk(result.Ask))
+		store.publishAccount(result.FromAccount)
+	}
+	return result, err
+}
+
+func (store *publishingStore) CancelAskTx(ctx context.Context, id int64) (db.CancelAskTxResult, error) {
+	result, err := store.Store.CancelAskTx(ctx, id)
+	if err == nil {
+		store.publishCanceledAsk(ctx, result)
+	}
+	return result, err
+}
+
+func (store *publishingStore) ExpireAskTx(ctx context.Context, id int64) (db.CancelAskTxResult, error) {
+	result, err := store.Store.ExpireAskTx(ctx, id)
+	if err == nil {
+		store.publishCanceledAsk(ctx, result)
+	}
+	return result, err
+}
+
+func (store *publishingStore) TriggerAsk(ctx context.Context, id int64) (db.Ask, error) {
+	ask, err := store.Store.TriggerAsk(ctx, id)
+	if err == nil {
+		store.publishAsk(ctx, ask)
+	}
+	return ask, err
+}
+
+func (store *publishingStore) CancelOrdersTx(ctx context.Context, arg db.CancelOrdersTxParams) (db.CancelOrdersTxResult, error) {
+	result, err := store.Store.CancelOrdersTx(ctx, arg)
+	if err == nil {
+		store.publishLegs(ctx, db.OrderGroupLegs{Bids: result.Bids, Asks: result.Asks})
+	}
+	return result, err
+}
+
+func (store *publishingStore) SelfTradeTx(ctx context.Context, arg db.SelfTradeTxParams) (db.SelfTradeTxResult, error) {
+	result, err := store.Store.SelfTradeTx(ctx, arg)
+	if err == nil {
+		store.publishBid(ctx, result.Bid)
+		store.publishAsk(ctx, result.Ask)
+		store.publishLegs(ctx, result.Canceled)
+	}
+	return result, err
+}
+
+func (store *publishingStore) CreateOrderGroupTx(ctx context.Context, arg db.CreateOrderGroupTxParams) (db.CreateOrderGroupTxResult, error) {
+	result, err := store.Store.CreateOrderGroupTx(ctx, arg)
+	if err == nil {
+		store.publishLegs(ctx, result.Legs)
+	}
+	return result, err
+}
+
+func (store *publishingStore) CancelOrderGroupTx(ctx context.Context, id int64) (db.CancelOrderGroupTxResult, error) {
+	result, err := store.Store.CancelOrderGroupTx(ctx, id)
+	if err == nil {
+		store.publishLegs(ctx, result.Canceled)
+	}
+	return result, err
+}
+
+// publishCanceledBid publishes a canceled or expired bid, the funds it released and the legs canceled with it
+func (store *publishingStore) publishCanceledBid(ctx context.Context, result db.CancelBidTxResult) {
+	store.publish(ORDERS, result.Bid.Owner, NewOrderFromBid(result.Bid))
+	store.publishAccount(result.FromAccount)
+	store.publishLegs(ctx, result.Canceled)
+}
+
+// publishCanceledAsk publishes a canceled or expired ask, the funds it released and the legs canceled with it
+func (store *publishingStore) publishCanceledAsk(ctx context.Context, result db.CancelAskTxResult) {
+	store.publish(ORDERS, result.Ask.Owner, NewOrderFromAsk(result.Ask))
+	store.publishAccount(result.FromAccount)
+	store.publishLegs(ctx, result.Canceled)
+}
+
+// publishBid publishes a bid and the balances of its from account and of the other accounts it changed
+func (store *publishingStore) publishBid(ctx context.Context, bid db.Bid, accountIDs ...int64) {
+	store.publish(ORDERS, bid.Owner, NewOrderFromBid(bid))
+	store.publishBalances(ctx, bid.Owner, append([]int64{bid.FromAccountID}, accountIDs...)...)
+}
+
+// publishAsk publishes an ask and the balances of its from account and of the other accounts it changed
+func (store *publishingStore) publishAsk(ctx context.Context, ask db.Ask, accountIDs ...int64) {
+	store.publish(ORDERS, ask.Owner, NewOrderFromAsk(ask))
+	store.publishBalances(ctx, ask.Owner, append([]int64{ask.FromAccountID}, accountIDs...)...)
+}
+
+// publishLegs publishes orders that changed together and the balances of their from accounts
+func (store *publishingStore) publishLegs(ctx context.Context, legs db.OrderGroupLegs) {
+	accountIDs := map[string][]int64{}
+	for _, bid := range legs.Bids {
+		store.publish(ORDERS, bid.Owner, NewOrderFromBid(bid))
+		accountIDs[bid.Owner] = append(accountIDs[bid.Owner], bid.FromAccountID)
+	}
+	for _, ask := range legs.Asks {
+		store.publish(ORDERS, ask.Owner, NewOrderFromAsk(ask))
+		accountIDs[ask.Owner] = append(accountIDs[ask.Owner], ask.FromAccountID)
+	}
+
+	for owner, ids := range accountIDs {
+		store.publishBalances(ctx, owner, ids...)
+	}
+}
+
+// publishBalances reads the accounts of an owner and publishes their balances.
+// Nothing is read unless the owner subscribed to its balances
+func (store *publishingStore) publishBalances(ctx context.Context, owner string, accountIDs ...int64) {
+	if !store.hub.HasSubscribers(Topic(BALANCES, owner)) {
+		return
+	}
+
+	published := map[int64]bool{}
+	for _, id := range accountIDs {
+		if published[id] {
+			continue
+		}
+		published[id] = true
+
+		account, err := store.Store.GetAccount(ctx, id)
+		if err != nil {
+			log.Error().Err(err).Int64("account_id", id).Msg("cannot publish balance")
+			continue
+		}
+		store.publishAccount(account)
+	}
+}
+
+// publishAccounts reads accounts of any owner and publishes the balances of those whose owner subscribed to them
+func (store *publishingStore) publishAccounts(ctx context.Context, accountIDs ...int64) {
+	for _, id := range accountIDs {
+		account, err := store.Store.GetAccount(ctx, id)
+		if err != nil {
+			log.Error().Err(err).Int64("account_id", id).Msg("cannot publish balance")
+			continue
+		}
+		store.publishAccount(account)
+	}
+}
+
+// publishAccount publishes the balance of an account on the balances channel of its owner
+func (store *publishingStore) publishAccount(account db.Account) {
+	store.publish(BALANCES, account.Owner, account)
+}
+
+// publish publishes an update on the channel of an owner
+func (store *publishingStore) publish(channel string, owner string, data interface{}) {
+	store.hub.Publish(Event{
+		Channel: channel,
+		Key:     owner,
+		Type:    UPDATE,
+		Data:    data,
+	})
+}
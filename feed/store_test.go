@@ -0,0 +1,196 @@
+package feed
+
+import (
+	"context"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func randomAccount(owner string, currency string) db.Account {
+	return db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    owner,
+		Balance:  util.RandomMoney(),
+		Currency: currency,
+	}
+}
+
+func TestPublishFill(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buyer, seller := util.RandomOwner(), util.RandomOwner()
+	buyerUSDT, buyerBTC := randomAccount(buyer, util.USDT), randomAccount(buyer, util.BTC)
+	buyerBTC.ID = buyerUSDT.ID + 1
+
+	bid := db.Bid{
+		ID:              util.RandomInt(1, 1000),
+		Pair:            util.BTC_USDT,
+		FromAccountID:   buyerUSDT.ID,
+		ToAccountID:     buyerBTC.ID,
+		Price:           decimal.NewFromInt(100),
+		Amount:          decimal.NewFromInt(10),
+		Status:          util.PARTIALLY_FILLED,
+		FilledAmount:    decimal.NewFromInt(4),
+		RemainingAmount: decimal.NewFromInt(6),
+		Owner:           buyer,
+	}
+	ask := db.Ask{
+		ID:              util.RandomInt(1, 1000),
+		Pair:            util.BTC_USDT,
+		FromAccountID:   util.RandomInt(1, 1000),
+		ToAccountID:     util.RandomInt(1, 1000),
+		Price:           decimal.NewFromInt(100),
+		Amount:          decimal.NewFromInt(4),
+		Status:          util.COMPLETED,
+		FilledAmount:    decimal.NewFromInt(4),
+		RemainingAmount: decimal.Zero,
+		Owner:           seller,
+	}
+	arg := db.FillTxParams{
+		BidID:     bid.ID,
+		AskID:     ask.ID,
+		Price:     decimal.NewFromInt(100),
+		Amount:    decimal.NewFromInt(4),
+		TakerSide: util.BID,
+	}
+	result := db.FillTxResult{
+		Fill: db.Fill{TradeID: 7, BidID: bid.ID, AskID: ask.ID, Price: arg.Price, Amount: arg.Amount, CreatedAt: time.Now()},
+		Trade: db.Trade{
+			ID:        7,
+			FirstFee:  decimal.NewFromInt(1),
+			SecondFee: decimal.New(4, -3),
+		},
+		Bid: bid,
+		Ask: ask,
+	}
+
+	inner := mockdb.NewMockStore(ctrl)
+	inner.EXPECT().FillTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+	// only the buyer subscribed to its balances, so the accounts of the seller aren't read
+	inner.EXPECT().GetAccount(gomock.Any(), gomock.Eq(buyerUSDT.ID)).Times(1).Return(buyerUSDT, nil)
+	inner.EXPECT().GetAccount(gomock.Any(), gomock.Eq(buyerBTC.ID)).Times(1).Return(buyerBTC, nil)
+
+	hub := NewHub()
+	store := NewStore(inner, hub)
+
+	buyerOrders := hub.Subscribe(Topic(ORDERS, buyer), 10)
+	buyerFills := hub.Subscribe(Topic(FILLS, buyer), 10)
+	buyerBalances := hub.Subscribe(Topic(BALANCES, buyer), 10)
+	sellerFills := hub.Subscribe(Topic(FILLS, seller), 10)
+
+	_, err := store.FillTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	event := <-buyerOrders.Events()
+	require.Equal(t, NewOrderFromBid(bid), event.Data)
+	require.Empty(t, buyerOrders.Events())
+
+	event = <-buyerFills.Events()
+	require.Equal(t, Fill{
+		TradeID:   7,
+		OrderID:   bid.ID,
+		Pair:      util.BTC_USDT,
+		Side:      util.BID,
+		Price:     arg.Price,
+		Amount:    arg.Amount,
+		Fee:       decimal.New(4, -3),
+		Taker:     true,
+		CreatedAt: result.Fill.CreatedAt,
+	}, event.Data)
+
+	event = <-sellerFills.Events()
+	fill := event.Data.(Fill)
+	require.Equal(t, ask.ID, fill.OrderID)
+	require.Equal(t, decimal.NewFromInt(1), fill.Fee)
+	require.False(t, fill.Taker)
+
+	require.Equal(t, buyerUSDT, (<-buyerBalances.Events()).Data)
+	require.Equal(t, buyerBTC, (<-buyerBalances.Events()).Data)
+	require.Equal(t, uint64(1), hub.Sequence(Topic(ORDERS, seller)))
+}
+
+func TestPublishCanceledOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := util.RandomOwner()
+	account := randomAccount(owner, util.USDT)
+	bids := []db.Bid{
+		{ID: 1, Pair: util.BTC_USDT, FromAccountID: account.ID, Status: util.CANCELED, Owner: owner},
+		{ID: 2, Pair: util.ETH_USDT, FromAccountID: account.ID, Status: util.CANCELED, Owner: owner},
+	}
+
+	inner := mockdb.NewMockStore(ctrl)
+	inner.EXPECT().CancelOrdersTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CancelOrdersTxResult{Bids: bids, Asks: []db.Ask{}}, nil)
+	inner.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+	hub := NewHub()
+	store := NewStore(inner, hub)
+
+	orders := hub.Subscribe(Topic(ORDERS, owner), 10)
+	balances := hub.Subscribe(Topic(BALANCES, owner), 10)
+
+	_, err := store.CancelOrdersTx(context.Background(), db.CancelOrdersTxParams{Owner: owner})
+	require.NoError(t, err)
+
+	for _, bid := range bids {
+		event := <-orders.Events()
+		require.Equal(t, NewOrderFromBid(bid), event.Data)
+	}
+
+	// both bids held funds of the same account, whose balance is published once
+	require.Len(t, balances.Events(), 1)
+	require.Equal(t, account, (<-balances.Events()).Data)
+}
+
+func TestPublishTriggeredOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := util.RandomOwner()
+	bid := db.Bid{ID: 1, Pair: util.BTC_USDT, Type: util.STOP_LIMIT, Status: util.ACTIVE, Owner: owner}
+	ask := db.Ask{ID: 2, Pair: util.BTC_USDT, Type: util.STOP_LIMIT, Status: util.ACTIVE, Owner: owner}
+
+	inner := mockdb.NewMockStore(ctrl)
+	inner.EXPECT().TriggerBid(gomock.Any(), gomock.Eq(bid.ID)).Times(1).Return(bid, nil)
+	inner.EXPECT().TriggerAsk(gomock.Any(), gomock.Eq(ask.ID)).Times(1).Return(ask, nil)
+	// triggering holds no new funds and nobody subscribed to the balances
+	inner.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+
+	hub := NewHub()
+	store := NewStore(inner, hub)
+
+	orders := hub.Subscribe(Topic(ORDERS, owner), 10)
+
+	_, err := store.TriggerBid(context.Background(), bid.ID)
+	require.NoError(t, err)
+	_, err = store.TriggerAsk(context.Background(), ask.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, NewOrderFromBid(bid), (<-orders.Events()).Data)
+	require.Equal(t, NewOrderFromAsk(ask), (<-orders.Events()).Data)
+}
+
+func TestPublishNothingOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inner := mockdb.NewMockStore(ctrl)
+	inner.EXPECT().CreateBidTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateBidTxResult{}, db.ErrInsufficientFunds)
+
+	hub := NewHub()
+	store := NewStore(inner, hub)
+
+	_, err := store.CreateBidTx(context.Background(), db.CreateBidParams{})
+	require.ErrorIs(t, err, db.ErrInsufficientFunds)
+	require.Zero(t, hub.Sequence(Topic(ORDERS, "")))
+	require.Zero(t, hub.Sequence(Topic(BALANCES, "")))
+}
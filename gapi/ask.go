@@ -0,0 +1,342 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/pb"
+	"go-exchange/util"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateAsk(ctx context.Context, req *pb.CreateAskRequest) (*pb.CreateAskResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateNewOrderRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.parseNewOrder(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c1, c2 := util.CurrenciesFromPair(req.GetPair())
+
+	fromAccount, err := server.validAccount(ctx, "from_account_id", req.GetFromAccountId(), c1)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := server.validAccount(ctx, "to_account_id", req.GetToAccountId(), c2)
+	if err != nil {
+		return nil, err
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	price, amount := order.price, order.amount
+	if order.orderType == util.MARKET {
+		available := fromAccount.Balance.Sub(fromAccount.Held)
+		quote, err := server.engine.QuoteMarketAsk(req.GetPair(), order.amount, available, req.GetMaxSlippage())
+		if err != nil {
+			return nil, failedPreconditionError("LIQUIDITY", "pair", err)
+		}
+		price, amount = quote.Price, quote.Amount
+
+		// a quote for less than the amount must still meet the trading rules of the pair
+		if err := server.validOrderSize(req.GetPair(), newOrderSize(price, decimal.Zero, amount, decimal.Zero)); err != nil {
+			return nil, err
+		}
+	}
+	if order.orderType == util.STOP_MARKET {
+		pair, _ := server.registry.Pair(req.GetPair())
+		price = engine.StopMarketPrice(util.ASK, order.stopPrice, req.GetMaxSlippage(), pair.TickSize)
+	}
+
+	arg := db.CreateAskParams{
+		Pair:                req.GetPair(),
+		FromAccountID:       req.GetFromAccountId(),
+		ToAccountID:         req.GetToAccountId(),
+		Price:               price,
+		Amount:              amount,
+		Status:              util.OrderStatus(order.orderType),
+		Type:                order.orderType,
+		TimeInForce:         order.timeInForce,
+		ExpiresAt:           order.expiresAt,
+		StopPrice:           order.stopPrice,
+		PostOnly:            req.GetPostOnly(),
+		DisplayAmount:       order.displayAmount,
+		Hidden:              req.GetHidden(),
+		SelfTradePrevention: req.GetSelfTradePrevention(),
+	}
+
+	result, err := server.store.CreateAskTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, failedPreconditionError("FUNDS", "from_account_id", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create ask: %s", err)
+	}
+
+	ask, err := server.placeAsk(ctx, result.Ask)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreateAskResponse{
+		Ask: convertAsk(ask),
+	}
+	return rsp, nil
+}
+
+// placeAsk sends a new ask to the matching engine and reloads it if matching changed it
+func (server *Server) placeAsk(ctx context.Context, ask db.Ask) (db.Ask, error) {
+	match, err := server.engine.PlaceAsk(ctx, ask)
+	if err != nil {
+		return ask, status.Errorf(codes.Internal, "failed to place ask: %s", err)
+	}
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		ask, err = server.store.GetAsk(ctx, ask.ID)
+		if err != nil {
+			return ask, status.Errorf(codes.Internal, "failed to get ask: %s", err)
+		}
+	}
+
+	return ask, nil
+}
+
+func (server *Server) GetAsk(ctx context.Context, req *pb.GetAskRequest) (*pb.GetAskResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	ask, err := server.getAsk(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, ask.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetAskResponse{
+		Ask: convertAsk(ask),
+	}
+	return rsp, nil
+}
+
+// getAsk loads an ask and turns a missing ask into NotFound
+func (server *Server) getAsk(ctx context.Context, id int64) (db.Ask, error) {
+	ask, err := server.store.GetAsk(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ask, status.Errorf(codes.NotFound, "ask %d not found", id)
+		}
+		return ask, status.Errorf(codes.Internal, "failed to get ask: %s", err)
+	}
+
+	return ask, nil
+}
+
+func (server *Server) ListAsks(ctx context.Context, req *pb.ListAsksRequest) (*pb.ListAsksResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListOrdersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.verifyAccountOwner(ctx, req.GetFromAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, req.GetToAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListAsksParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Limit:         req.GetPageSize(),
+		Offset:        (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	asks, err := server.store.ListAsks(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list asks: %s", err)
+	}
+
+	rsp := &pb.ListAsksResponse{
+		Asks: convertAsks(asks),
+	}
+	return rsp, nil
+}
+
+func (server *Server) ListAskEvents(ctx context.Context, req *pb.ListAskEventsRequest) (*pb.ListAskEventsResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListOrderEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	ask, err := server.getAsk(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, ask.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.listOrderEvents(ctx, util.ASK, ask.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListAskEventsResponse{
+		Events: events,
+	}
+	return rsp, nil
+}
+
+// UpdateAsk cancels an open ask, or amends its price and amount if no status is given
+func (server *Server) UpdateAsk(ctx context.Context, req *pb.UpdateAskRequest) (*pb.UpdateAskResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	a, err := server.getAsk(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, a.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !util.IsOpenStatus(a.Status) {
+		err := fmt.Errorf("ask %d is %s", a.ID, a.Status)
+		return nil, failedPreconditionError("STATUS", "id", err)
+	}
+
+	if req.GetStatus() == "" {
+		ask, err := server.amendAsk(ctx, a, parseDecimal(req.GetPrice()), parseDecimal(req.GetAmount()))
+		if err != nil {
+			return nil, err
+		}
+
+		rsp := &pb.UpdateAskResponse{
+			Ask: convertAsk(ask),
+		}
+		return rsp, nil
+	}
+
+	result, err := server.engine.CancelOpenAsk(ctx, a)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "ask %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel ask: %s", err)
+	}
+
+	rsp := &pb.UpdateAskResponse{
+		Ask: convertAsk(result.Ask),
+	}
+	return rsp, nil
+}
+
+// amendAsk changes the price and amount of an open ask, a zero price or amount keeps the current one
+func (server *Server) amendAsk(ctx context.Context, a db.Ask, price decimal.Decimal, amount decimal.Decimal) (db.Ask, error) {
+	if a.GroupID.Valid {
+		err := fmt.Errorf("ask %d belongs to order group %d", a.ID, a.GroupID.Int64)
+		return a, failedPreconditionError("GROUP", "id", err)
+	}
+
+	if !server.registry.IsActivePair(a.Pair) {
+		err := fmt.Errorf("pair %s is not open for trading", a.Pair)
+		return a, failedPreconditionError("STATUS", "pair", err)
+	}
+
+	if err := val.ValidateOrderAmendment(a.Type, price, amount, a.FilledAmount, a.DisplayAmount); err != nil {
+		return a, status.Errorf(codes.InvalidArgument, "invalid amendment: %s", err)
+	}
+
+	size := orderSize{price: price, amount: amount}
+	if price.IsZero() {
+		price = a.Price
+	}
+	if amount.IsZero() {
+		amount = a.Amount
+	}
+	size.notionalAmount, size.notionalPrice = amount, price
+
+	if err := server.validOrderSize(a.Pair, size); err != nil {
+		return a, err
+	}
+
+	result, match, err := server.engine.AmendAsk(ctx, a, price, amount)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return a, failedPreconditionError("FUNDS", "from_account_id", err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("ask %d can no longer be amended", a.ID)
+			return a, failedPreconditionError("STATUS", "id", err)
+		}
+		return a, status.Errorf(codes.Internal, "failed to amend ask: %s", err)
+	}
+	ask := result.Ask
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		ask, err = server.store.GetAsk(ctx, ask.ID)
+		if err != nil {
+			return ask, status.Errorf(codes.Internal, "failed to get ask: %s", err)
+		}
+	}
+
+	return ask, nil
+}
@@ -0,0 +1,342 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/pb"
+	"go-exchange/util"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateBid(ctx context.Context, req *pb.CreateBidRequest) (*pb.CreateBidResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateNewOrderRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.parseNewOrder(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c1, c2 := util.CurrenciesFromPair(req.GetPair())
+
+	fromAccount, err := server.validAccount(ctx, "from_account_id", req.GetFromAccountId(), c2)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := server.validAccount(ctx, "to_account_id", req.GetToAccountId(), c1)
+	if err != nil {
+		return nil, err
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	price, amount := order.price, order.amount
+	if order.orderType == util.MARKET {
+		available := fromAccount.Balance.Sub(fromAccount.Held)
+		quote, err := server.engine.QuoteMarketBid(req.GetPair(), order.amount, available, req.GetMaxSlippage())
+		if err != nil {
+			return nil, failedPreconditionError("LIQUIDITY", "pair", err)
+		}
+		price, amount = quote.Price, quote.Amount
+
+		// a quote for less than the amount must still meet the trading rules of the pair
+		if err := server.validOrderSize(req.GetPair(), newOrderSize(price, decimal.Zero, amount, decimal.Zero)); err != nil {
+			return nil, err
+		}
+	}
+	if order.orderType == util.STOP_MARKET {
+		pair, _ := server.registry.Pair(req.GetPair())
+		price = engine.StopMarketPrice(util.BID, order.stopPrice, req.GetMaxSlippage(), pair.TickSize)
+	}
+
+	arg := db.CreateBidParams{
+		Pair:                req.GetPair(),
+		FromAccountID:       req.GetFromAccountId(),
+		ToAccountID:         req.GetToAccountId(),
+		Price:               price,
+		Amount:              amount,
+		Status:              util.OrderStatus(order.orderType),
+		Type:                order.orderType,
+		TimeInForce:         order.timeInForce,
+		ExpiresAt:           order.expiresAt,
+		StopPrice:           order.stopPrice,
+		PostOnly:            req.GetPostOnly(),
+		DisplayAmount:       order.displayAmount,
+		Hidden:              req.GetHidden(),
+		SelfTradePrevention: req.GetSelfTradePrevention(),
+	}
+
+	result, err := server.store.CreateBidTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, failedPreconditionError("FUNDS", "from_account_id", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create bid: %s", err)
+	}
+
+	bid, err := server.placeBid(ctx, result.Bid)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreateBidResponse{
+		Bid: convertBid(bid),
+	}
+	return rsp, nil
+}
+
+// placeBid sends a new bid to the matching engine and reloads it if matching changed it
+func (server *Server) placeBid(ctx context.Context, bid db.Bid) (db.Bid, error) {
+	match, err := server.engine.PlaceBid(ctx, bid)
+	if err != nil {
+		return bid, status.Errorf(codes.Internal, "failed to place bid: %s", err)
+	}
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		bid, err = server.store.GetBid(ctx, bid.ID)
+		if err != nil {
+			return bid, status.Errorf(codes.Internal, "failed to get bid: %s", err)
+		}
+	}
+
+	return bid, nil
+}
+
+func (server *Server) GetBid(ctx context.Context, req *pb.GetBidRequest) (*pb.GetBidResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	bid, err := server.getBid(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, bid.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetBidResponse{
+		Bid: convertBid(bid),
+	}
+	return rsp, nil
+}
+
+// getBid loads a bid and turns a missing bid into NotFound
+func (server *Server) getBid(ctx context.Context, id int64) (db.Bid, error) {
+	bid, err := server.store.GetBid(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return bid, status.Errorf(codes.NotFound, "bid %d not found", id)
+		}
+		return bid, status.Errorf(codes.Internal, "failed to get bid: %s", err)
+	}
+
+	return bid, nil
+}
+
+func (server *Server) ListBids(ctx context.Context, req *pb.ListBidsRequest) (*pb.ListBidsResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListOrdersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.verifyAccountOwner(ctx, req.GetFromAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, req.GetToAccountId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListBidsParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Limit:         req.GetPageSize(),
+		Offset:        (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	bids, err := server.store.ListBids(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bids: %s", err)
+	}
+
+	rsp := &pb.ListBidsResponse{
+		Bids: convertBids(bids),
+	}
+	return rsp, nil
+}
+
+func (server *Server) ListBidEvents(ctx context.Context, req *pb.ListBidEventsRequest) (*pb.ListBidEventsResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListOrderEventsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	bid, err := server.getBid(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, bid.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := server.listOrderEvents(ctx, util.BID, bid.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListBidEventsResponse{
+		Events: events,
+	}
+	return rsp, nil
+}
+
+// UpdateBid cancels an open bid, or amends its price and amount if no status is given
+func (server *Server) UpdateBid(ctx context.Context, req *pb.UpdateBidRequest) (*pb.UpdateBidResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	b, err := server.getBid(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.verifyAccountOwner(ctx, b.FromAccountID, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !util.IsOpenStatus(b.Status) {
+		err := fmt.Errorf("bid %d is %s", b.ID, b.Status)
+		return nil, failedPreconditionError("STATUS", "id", err)
+	}
+
+	if req.GetStatus() == "" {
+		bid, err := server.amendBid(ctx, b, parseDecimal(req.GetPrice()), parseDecimal(req.GetAmount()))
+		if err != nil {
+			return nil, err
+		}
+
+		rsp := &pb.UpdateBidResponse{
+			Bid: convertBid(bid),
+		}
+		return rsp, nil
+	}
+
+	result, err := server.engine.CancelOpenBid(ctx, b)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "bid %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel bid: %s", err)
+	}
+
+	rsp := &pb.UpdateBidResponse{
+		Bid: convertBid(result.Bid),
+	}
+	return rsp, nil
+}
+
+// amendBid changes the price and amount of an open bid, a zero price or amount keeps the current one
+func (server *Server) amendBid(ctx context.Context, b db.Bid, price decimal.Decimal, amount decimal.Decimal) (db.Bid, error) {
+	if b.GroupID.Valid {
+		err := fmt.Errorf("bid %d belongs to order group %d", b.ID, b.GroupID.Int64)
+		return b, failedPreconditionError("GROUP", "id", err)
+	}
+
+	if !server.registry.IsActivePair(b.Pair) {
+		err := fmt.Errorf("pair %s is not open for trading", b.Pair)
+		return b, failedPreconditionError("STATUS", "pair", err)
+	}
+
+	if err := val.ValidateOrderAmendment(b.Type, price, amount, b.FilledAmount, b.DisplayAmount); err != nil {
+		return b, status.Errorf(codes.InvalidArgument, "invalid amendment: %s", err)
+	}
+
+	size := orderSize{price: price, amount: amount}
+	if price.IsZero() {
+		price = b.Price
+	}
+	if amount.IsZero() {
+		amount = b.Amount
+	}
+	size.notionalAmount, size.notionalPrice = amount, price
+
+	if err := server.validOrderSize(b.Pair, size); err != nil {
+		return b, err
+	}
+
+	result, match, err := server.engine.AmendBid(ctx, b, price, amount)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return b, failedPreconditionError("FUNDS", "from_account_id", err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			err := fmt.Errorf("bid %d can no longer be amended", b.ID)
+			return b, failedPreconditionError("STATUS", "id", err)
+		}
+		return b, status.Errorf(codes.Internal, "failed to amend bid: %s", err)
+	}
+	bid := result.Bid
+
+	if len(match.Fills) > 0 || match.SelfTrades > 0 || !match.Resting {
+		bid, err = server.store.GetBid(ctx, bid.ID)
+		if err != nil {
+			return bid, status.Errorf(codes.Internal, "failed to get bid: %s", err)
+		}
+	}
+
+	return bid, nil
+}
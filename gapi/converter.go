@@ -1,7 +1,11 @@
 package gapi
 
 import (
+	"database/sql"
 	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/feed"
 	"go-exchange/pb"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -9,10 +13,319 @@ import (
 
 func convertUser(user db.User) *pb.User {
 	return &pb.User{
-		Username:          user.Username,
-		FullName:          user.FullName,
-		Email:             user.Email,
-		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
-		CreatedAt:         timestamppb.New(user.CreatedAt),
+		Username:            user.Username,
+		FullName:            user.FullName,
+		Email:               user.Email,
+		PasswordChangedAt:   timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		SelfTradePrevention: user.SelfTradePrevention,
+		Role:                user.Role,
 	}
 }
+
+func convertPriceLevels(levels []engine.PriceLevel) []*pb.PriceLevel {
+	result := make([]*pb.PriceLevel, 0, len(levels))
+	for _, level := range levels {
+		result = append(result, &pb.PriceLevel{
+			Price:      level.Price.String(),
+			Amount:     level.Amount.String(),
+			OrderCount: level.OrderCount,
+		})
+	}
+	return result
+}
+
+func convertMarketTrade(trade engine.MarketTrade) *pb.MarketTrade {
+	return &pb.MarketTrade{
+		Id:          trade.ID,
+		Pair:        trade.Pair,
+		Price:       trade.Price.String(),
+		Amount:      trade.Amount.String(),
+		QuoteAmount: trade.QuoteAmount.String(),
+		TakerSide:   trade.TakerSide,
+		CreatedAt:   timestamppb.New(trade.CreatedAt),
+	}
+}
+
+func convertOrder(order feed.Order) *pb.Order {
+	return &pb.Order{
+		Id:              order.ID,
+		Pair:            order.Pair,
+		Side:            order.Side,
+		Type:            order.Type,
+		TimeInForce:     order.TimeInForce,
+		Status:          order.Status,
+		Price:           order.Price.String(),
+		StopPrice:       order.StopPrice.String(),
+		Amount:          order.Amount.String(),
+		FilledAmount:    order.FilledAmount.String(),
+		RemainingAmount: order.RemainingAmount.String(),
+		AveragePrice:    order.AveragePrice.String(),
+		CreatedAt:       timestamppb.New(order.CreatedAt),
+	}
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:        account.ID,
+		Owner:     account.Owner,
+		Balance:   account.Balance.String(),
+		Currency:  account.Currency,
+		CreatedAt: timestamppb.New(account.CreatedAt),
+		Held:      account.Held.String(),
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount.String(),
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount.String(),
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func convertTrade(trade db.Trade) *pb.Trade {
+	return &pb.Trade{
+		Id:                  trade.ID,
+		FirstFromAccountId:  trade.FirstFromAccountID,
+		FirstToAccountId:    trade.FirstToAccountID,
+		FirstAmount:         trade.FirstAmount.String(),
+		SecondFromAccountId: trade.SecondFromAccountID,
+		SecondToAccountId:   trade.SecondToAccountID,
+		SecondAmount:        trade.SecondAmount.String(),
+		CreatedAt:           timestamppb.New(trade.CreatedAt),
+		FirstFee:            trade.FirstFee.String(),
+		SecondFee:           trade.SecondFee.String(),
+		Pair:                convertNullString(trade.Pair),
+		Price:               convertNullDecimal(trade.Price),
+		TakerSide:           convertNullString(trade.TakerSide),
+		MakerOrderId:        convertNullInt64(trade.MakerOrderID),
+		TakerOrderId:        convertNullInt64(trade.TakerOrderID),
+	}
+}
+
+func convertBid(bid db.Bid) *pb.Bid {
+	return &pb.Bid{
+		Id:                  bid.ID,
+		Pair:                bid.Pair,
+		FromAccountId:       bid.FromAccountID,
+		ToAccountId:         bid.ToAccountID,
+		Price:               bid.Price.String(),
+		Amount:              bid.Amount.String(),
+		Status:              bid.Status,
+		CreatedAt:           timestamppb.New(bid.CreatedAt),
+		FilledAmount:        bid.FilledAmount.String(),
+		RemainingAmount:     bid.RemainingAmount.String(),
+		AveragePrice:        bid.AveragePrice.String(),
+		Type:                bid.Type,
+		TimeInForce:         bid.TimeInForce,
+		ExpiresAt:           convertNullTime(bid.ExpiresAt),
+		StopPrice:           bid.StopPrice.String(),
+		PostOnly:            bid.PostOnly,
+		DisplayAmount:       bid.DisplayAmount.String(),
+		Hidden:              bid.Hidden,
+		GroupId:             convertNullInt64(bid.GroupID),
+		GroupLeg:            bid.GroupLeg,
+		PriorityAt:          timestamppb.New(bid.PriorityAt),
+		Owner:               bid.Owner,
+		SelfTradePrevention: bid.SelfTradePrevention,
+	}
+}
+
+func convertBids(bids []db.Bid) []*pb.Bid {
+	result := make([]*pb.Bid, 0, len(bids))
+	for _, bid := range bids {
+		result = append(result, convertBid(bid))
+	}
+	return result
+}
+
+func convertAsk(ask db.Ask) *pb.Ask {
+	return &pb.Ask{
+		Id:                  ask.ID,
+		Pair:                ask.Pair,
+		FromAccountId:       ask.FromAccountID,
+		ToAccountId:         ask.ToAccountID,
+		Price:               ask.Price.String(),
+		Amount:              ask.Amount.String(),
+		Status:              ask.Status,
+		CreatedAt:           timestamppb.New(ask.CreatedAt),
+		FilledAmount:        ask.FilledAmount.String(),
+		RemainingAmount:     ask.RemainingAmount.String(),
+		AveragePrice:        ask.AveragePrice.String(),
+		Type:                ask.Type,
+		TimeInForce:         ask.TimeInForce,
+		ExpiresAt:           convertNullTime(ask.ExpiresAt),
+		StopPrice:           ask.StopPrice.String(),
+		PostOnly:            ask.PostOnly,
+		DisplayAmount:       ask.DisplayAmount.String(),
+		Hidden:              ask.Hidden,
+		GroupId:             convertNullInt64(ask.GroupID),
+		GroupLeg:            ask.GroupLeg,
+		PriorityAt:          timestamppb.New(ask.PriorityAt),
+		Owner:               ask.Owner,
+		SelfTradePrevention: ask.SelfTradePrevention,
+	}
+}
+
+func convertAsks(asks []db.Ask) []*pb.Ask {
+	result := make([]*pb.Ask, 0, len(asks))
+	for _, ask := range asks {
+		result = append(result, convertAsk(ask))
+	}
+	return result
+}
+
+func convertOrderHistoryEvent(event db.OrderEvent) *pb.OrderHistoryEvent {
+	return &pb.OrderHistoryEvent{
+		Id:                  event.ID,
+		Side:                event.Side,
+		OrderId:             event.OrderID,
+		Type:                event.Type,
+		SelfTradePrevention: event.SelfTradePrevention,
+		CounterOrderId:      convertNullInt64(event.CounterOrderID),
+		Amount:              event.Amount.String(),
+		CreatedAt:           timestamppb.New(event.CreatedAt),
+	}
+}
+
+func convertOrderGroup(group db.OrderGroup, legs db.OrderGroupLegs) *pb.OrderGroup {
+	return &pb.OrderGroup{
+		Id:        group.ID,
+		Type:      group.Type,
+		CreatedAt: timestamppb.New(group.CreatedAt),
+		Bids:      convertBids(legs.Bids),
+		Asks:      convertAsks(legs.Asks),
+	}
+}
+
+func convertDeadManSwitch(deadManSwitch db.DeadManSwitch) *pb.DeadManSwitch {
+	return &pb.DeadManSwitch{
+		Username:       deadManSwitch.Username,
+		TimeoutSeconds: deadManSwitch.TimeoutSeconds,
+		ExpiresAt:      timestamppb.New(deadManSwitch.ExpiresAt),
+		UpdatedAt:      timestamppb.New(deadManSwitch.UpdatedAt),
+		CreatedAt:      timestamppb.New(deadManSwitch.CreatedAt),
+	}
+}
+
+func convertDeadManSwitchEvent(event db.DeadManSwitchEvent) *pb.DeadManSwitchEvent {
+	return &pb.DeadManSwitchEvent{
+		Id:             event.ID,
+		Username:       event.Username,
+		Action:         event.Action,
+		TimeoutSeconds: event.TimeoutSeconds,
+		ExpiresAt:      timestamppb.New(event.ExpiresAt),
+		CanceledOrders: event.CanceledOrders,
+		CreatedAt:      timestamppb.New(event.CreatedAt),
+	}
+}
+
+func convertCurrency(currency db.Currency) *pb.Currency {
+	return &pb.Currency{
+		Code:      currency.Code,
+		Decimals:  currency.Decimals,
+		Status:    currency.Status,
+		UpdatedAt: timestamppb.New(currency.UpdatedAt),
+		CreatedAt: timestamppb.New(currency.CreatedAt),
+	}
+}
+
+func convertPair(pair db.Pair) *pb.Pair {
+	return &pb.Pair{
+		Symbol:      pair.Symbol,
+		Base:        pair.Base,
+		Quote:       pair.Quote,
+		TickSize:    pair.TickSize.String(),
+		LotSize:     pair.LotSize.String(),
+		MinNotional: pair.MinNotional.String(),
+		Status:      pair.Status,
+		UpdatedAt:   timestamppb.New(pair.UpdatedAt),
+		CreatedAt:   timestamppb.New(pair.CreatedAt),
+	}
+}
+
+func convertFeeTier(feeTier db.FeeTier) *pb.FeeTier {
+	return &pb.FeeTier{
+		Id:        feeTier.ID,
+		Pair:      feeTier.Pair,
+		MinVolume: feeTier.MinVolume.String(),
+		MakerRate: feeTier.MakerRate,
+		TakerRate: feeTier.TakerRate,
+		CreatedAt: timestamppb.New(feeTier.CreatedAt),
+	}
+}
+
+func convertCandle(candle db.Candle) *pb.Candle {
+	return &pb.Candle{
+		Pair:        candle.Pair,
+		Interval:    candle.Interval,
+		OpenTime:    timestamppb.New(candle.OpenTime),
+		Open:        candle.Open.String(),
+		High:        candle.High.String(),
+		Low:         candle.Low.String(),
+		Close:       candle.Close.String(),
+		Volume:      candle.Volume.String(),
+		QuoteVolume: candle.QuoteVolume.String(),
+		TradeCount:  candle.TradeCount,
+		UpdatedAt:   timestamppb.New(candle.UpdatedAt),
+	}
+}
+
+func convertTicker(ticker engine.Ticker) *pb.Ticker {
+	return &pb.Ticker{
+		Pair:          ticker.Pair,
+		LastPrice:     ticker.LastPrice.String(),
+		BestBid:       ticker.BestBid.String(),
+		BestAsk:       ticker.BestAsk.String(),
+		Open:          ticker.Open.String(),
+		High:          ticker.High.String(),
+		Low:           ticker.Low.String(),
+		Volume:        ticker.Volume.String(),
+		QuoteVolume:   ticker.QuoteVolume.String(),
+		PriceChange:   ticker.PriceChange.String(),
+		ChangePercent: ticker.ChangePercent.String(),
+		TradeCount:    ticker.TradeCount,
+	}
+}
+
+// convertNullTime leaves out a time that isn't set
+func convertNullTime(value sql.NullTime) *timestamppb.Timestamp {
+	if !value.Valid {
+		return nil
+	}
+	return timestamppb.New(value.Time)
+}
+
+func convertNullString(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
+
+func convertNullInt64(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int64
+}
+
+func convertNullDecimal(value decimal.NullDecimal) *string {
+	if !value.Valid {
+		return nil
+	}
+	price := value.Decimal.String()
+	return &price
+}
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"go-exchange/pb"
+	"go-exchange/val"
+	"time"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ArmDeadManSwitch arms the dead man's switch of the authenticated user, or re-arms it with a new timeout.
+// Every open order of the user is canceled if no heartbeat arrives before the timeout
+func (server *Server) ArmDeadManSwitch(ctx context.Context, req *pb.ArmDeadManSwitchRequest) (*pb.ArmDeadManSwitchResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateArmDeadManSwitchRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ArmDeadManSwitchTxParams{
+		Username:       authPayload.Username,
+		TimeoutSeconds: req.GetTimeoutSeconds(),
+		Now:            time.Now(),
+	}
+
+	result, err := server.store.ArmDeadManSwitchTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to arm dead man's switch: %s", err)
+	}
+
+	rsp := &pb.ArmDeadManSwitchResponse{
+		DeadManSwitch: convertDeadManSwitch(result.DeadManSwitch),
+	}
+	return rsp, nil
+}
+
+func validateArmDeadManSwitchRequest(req *pb.ArmDeadManSwitchRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTimeout(req.GetTimeoutSeconds()); err != nil {
+		violations = append(violations, fieldViolation("timeout_seconds", err))
+	}
+
+	return violations
+}
+
+// RefreshDeadManSwitch is the heartbeat that pushes back the expiry of an armed dead man's switch
+func (server *Server) RefreshDeadManSwitch(ctx context.Context, req *pb.RefreshDeadManSwitchRequest) (*pb.RefreshDeadManSwitchResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.RefreshDeadManSwitchTxParams{
+		Username: authPayload.Username,
+		Now:      time.Now(),
+	}
+
+	result, err := server.store.RefreshDeadManSwitchTx(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "dead man's switch is not armed")
+		}
+		if errors.Is(err, db.ErrDeadManSwitchExpired) {
+			return nil, failedPreconditionError("STATUS", "dead_man_switch", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refresh dead man's switch: %s", err)
+	}
+
+	rsp := &pb.RefreshDeadManSwitchResponse{
+		DeadManSwitch: convertDeadManSwitch(result.DeadManSwitch),
+	}
+	return rsp, nil
+}
+
+func (server *Server) GetDeadManSwitch(ctx context.Context, req *pb.GetDeadManSwitchRequest) (*pb.GetDeadManSwitchResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deadManSwitch, err := server.store.GetDeadManSwitch(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "dead man's switch is not armed")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get dead man's switch: %s", err)
+	}
+
+	rsp := &pb.GetDeadManSwitchResponse{
+		DeadManSwitch: convertDeadManSwitch(deadManSwitch),
+	}
+	return rsp, nil
+}
+
+func (server *Server) DisarmDeadManSwitch(ctx context.Context, req *pb.DisarmDeadManSwitchRequest) (*pb.DisarmDeadManSwitchResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.DisarmDeadManSwitchTx(ctx, authPayload.Username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "dead man's switch is not armed")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to disarm dead man's switch: %s", err)
+	}
+
+	rsp := &pb.DisarmDeadManSwitchResponse{
+		DeadManSwitch: convertDeadManSwitch(result.DeadManSwitch),
+	}
+	return rsp, nil
+}
+
+// ListDeadManSwitchEvents lists the audit trail of the dead man's switch of the authenticated user, newest first
+func (server *Server) ListDeadManSwitchEvents(ctx context.Context, req *pb.ListDeadManSwitchEventsRequest) (*pb.ListDeadManSwitchEventsResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validatePage(req.GetPageId(), req.GetPageSize())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListDeadManSwitchEventsParams{
+		Username: authPayload.Username,
+		Limit:    req.GetPageSize(),
+		Offset:   (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	events, err := server.store.ListDeadManSwitchEvents(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list dead man's switch events: %s", err)
+	}
+
+	rsp := &pb.ListDeadManSwitchEventsResponse{
+		Events: make([]*pb.DeadManSwitchEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		rsp.Events = append(rsp.Events, convertDeadManSwitchEvent(event))
+	}
+	return rsp, nil
+}
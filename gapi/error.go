@@ -28,3 +28,23 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 
 	return statusDetails.Err()
 }
+
+func failedPreconditionError(violationType string, subject string, err error) error {
+	failure := &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{
+				Type:        violationType,
+				Subject:     subject,
+				Description: err.Error(),
+			},
+		},
+	}
+	statusFailed := status.New(codes.FailedPrecondition, err.Error())
+
+	statusDetails, err := statusFailed.WithDetails(failure)
+	if err != nil {
+		return statusFailed.Err()
+	}
+
+	return statusDetails.Err()
+}
@@ -0,0 +1,131 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListFeeTiers(ctx context.Context, req *pb.ListFeeTiersRequest) (*pb.ListFeeTiersResponse, error) {
+	violations := validateListFeeTiersRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	feeTiers, err := server.store.ListFeeTiers(ctx, req.GetPair())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list fee tiers: %s", err)
+	}
+
+	rsp := &pb.ListFeeTiersResponse{
+		FeeTiers: make([]*pb.FeeTier, 0, len(feeTiers)),
+	}
+	for _, feeTier := range feeTiers {
+		rsp.FeeTiers = append(rsp.FeeTiers, convertFeeTier(feeTier))
+	}
+	return rsp, nil
+}
+
+func validateListFeeTiersRequest(req *pb.ListFeeTiersRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	return violations
+}
+
+// CreateFeeTier adds a tier to the fee schedule of a pair, for the owners whose trailing 30-day volume reaches its min volume
+func (server *Server) CreateFeeTier(ctx context.Context, req *pb.CreateFeeTierRequest) (*pb.CreateFeeTierResponse, error) {
+	violations := validateCreateFeeTierRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	minVolume := parseDecimal(req.GetMinVolume())
+	pair, _ := server.registry.Pair(req.GetPair())
+	quote, _ := server.registry.Currency(pair.Quote)
+	if err := val.ValidateDecimals(minVolume, quote); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("min_volume", err)})
+	}
+
+	feeTier, err := server.store.CreateFeeTier(ctx, db.CreateFeeTierParams{
+		Pair:      req.GetPair(),
+		MinVolume: minVolume,
+		MakerRate: req.GetMakerRate(),
+		TakerRate: req.GetTakerRate(),
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "fee tier already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create fee tier: %s", err)
+	}
+
+	rsp := &pb.CreateFeeTierResponse{
+		FeeTier: convertFeeTier(feeTier),
+	}
+	return rsp, nil
+}
+
+func validateCreateFeeTierRequest(req *pb.CreateFeeTierRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if req.MinVolume != nil {
+		if err := val.ValidateNonNegativeDecimal(req.GetMinVolume()); err != nil {
+			violations = append(violations, fieldViolation("min_volume", err))
+		}
+	}
+
+	if err := val.ValidateFeeRate(req.GetMakerRate()); err != nil {
+		violations = append(violations, fieldViolation("maker_rate", err))
+	}
+
+	if err := val.ValidateFeeRate(req.GetTakerRate()); err != nil {
+		violations = append(violations, fieldViolation("taker_rate", err))
+	}
+
+	return violations
+}
+
+// DeleteFeeTier removes a tier from the fee schedule of its pair, a pair left without tiers trades for free
+func (server *Server) DeleteFeeTier(ctx context.Context, req *pb.DeleteFeeTierRequest) (*pb.DeleteFeeTierResponse, error) {
+	violations := validateDeleteFeeTierRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	feeTier, err := server.store.DeleteFeeTier(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "fee tier %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete fee tier: %s", err)
+	}
+
+	rsp := &pb.DeleteFeeTierResponse{
+		FeeTier: convertFeeTier(feeTier),
+	}
+	return rsp, nil
+}
+
+func validateDeleteFeeTierRequest(req *pb.DeleteFeeTierRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,166 @@
+package gapi
+
+import (
+	"context"
+	"go-exchange/token"
+	"go-exchange/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accessPolicy is who may call a method, the zero value denies everyone
+type accessPolicy int
+
+const (
+	// publicAccess lets anyone call the method without an access token
+	publicAccess accessPolicy = iota + 1
+	// authenticatedAccess needs a valid access token
+	authenticatedAccess
+	// adminAccess needs a valid access token of an admin
+	adminAccess
+)
+
+// methodPolicies is the access policy of every method served, methods left out are denied
+var methodPolicies = map[string]accessPolicy{
+	"/pb.Exchange/CreateUser":       publicAccess,
+	"/pb.Exchange/LoginUser":        publicAccess,
+	"/pb.Exchange/RenewAccessToken": publicAccess,
+	"/pb.Exchange/UpdateUser":       authenticatedAccess,
+	"/pb.Exchange/DeleteUser":       authenticatedAccess,
+
+	"/pb.Exchange/CreateAccount": authenticatedAccess,
+	"/pb.Exchange/GetAccount":    authenticatedAccess,
+	"/pb.Exchange/ListAccounts":  authenticatedAccess,
+	"/pb.Exchange/UpdateAccount": authenticatedAccess,
+	"/pb.Exchange/DeleteAccount": authenticatedAccess,
+
+	"/pb.Exchange/CreateTransfer": authenticatedAccess,
+	"/pb.Exchange/GetTransfer":    authenticatedAccess,
+	"/pb.Exchange/ListTransfers":  authenticatedAccess,
+
+	"/pb.Exchange/CreateTrade": adminAccess,
+	"/pb.Exchange/GetTrade":    publicAccess,
+	"/pb.Exchange/ListTrades":  publicAccess,
+
+	"/pb.Exchange/CreateBid":     authenticatedAccess,
+	"/pb.Exchange/GetBid":        authenticatedAccess,
+	"/pb.Exchange/ListBids":      authenticatedAccess,
+	"/pb.Exchange/UpdateBid":     authenticatedAccess,
+	"/pb.Exchange/ListBidEvents": authenticatedAccess,
+
+	"/pb.Exchange/CreateAsk":     authenticatedAccess,
+	"/pb.Exchange/GetAsk":        authenticatedAccess,
+	"/pb.Exchange/ListAsks":      authenticatedAccess,
+	"/pb.Exchange/UpdateAsk":     authenticatedAccess,
+	"/pb.Exchange/ListAskEvents": authenticatedAccess,
+
+	"/pb.Exchange/CancelOrders":     authenticatedAccess,
+	"/pb.Exchange/CreateOrderGroup": authenticatedAccess,
+	"/pb.Exchange/GetOrderGroup":    authenticatedAccess,
+	"/pb.Exchange/UpdateOrderGroup": authenticatedAccess,
+
+	"/pb.Exchange/ArmDeadManSwitch":        authenticatedAccess,
+	"/pb.Exchange/RefreshDeadManSwitch":    authenticatedAccess,
+	"/pb.Exchange/GetDeadManSwitch":        authenticatedAccess,
+	"/pb.Exchange/DisarmDeadManSwitch":     authenticatedAccess,
+	"/pb.Exchange/ListDeadManSwitchEvents": authenticatedAccess,
+
+	"/pb.Exchange/ListCurrencies": publicAccess,
+	"/pb.Exchange/ListPairs":      publicAccess,
+	"/pb.Exchange/ListFeeTiers":   publicAccess,
+
+	"/pb.Exchange/GetOrderBook":     publicAccess,
+	"/pb.Exchange/ListMarketTrades": publicAccess,
+	"/pb.Exchange/ListCandles":      publicAccess,
+	"/pb.Exchange/GetTicker":        publicAccess,
+	"/pb.Exchange/ListTickers":      publicAccess,
+
+	"/pb.Exchange/CreateCurrency":  adminAccess,
+	"/pb.Exchange/UpdateCurrency":  adminAccess,
+	"/pb.Exchange/CreatePair":      adminAccess,
+	"/pb.Exchange/UpdatePair":      adminAccess,
+	"/pb.Exchange/CreateFeeTier":   adminAccess,
+	"/pb.Exchange/DeleteFeeTier":   adminAccess,
+	"/pb.Exchange/BackfillCandles": adminAccess,
+
+	"/pb.Exchange/StreamOrderBook": publicAccess,
+	"/pb.Exchange/StreamTrades":    publicAccess,
+	"/pb.Exchange/StreamMyOrders":  authenticatedAccess,
+
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": publicAccess,
+}
+
+// payloadKey is the context key of the payload of the verified access token
+type payloadKey struct{}
+
+// authorize enforces the access policy of a method.
+// The payload of the verified access token is put in the returned context for the methods that need one
+func (server *Server) authorize(ctx context.Context, method string) (context.Context, error) {
+	policy := methodPolicies[method]
+	if policy == publicAccess {
+		return ctx, nil
+	}
+	if policy != authenticatedAccess && policy != adminAccess {
+		return nil, status.Errorf(codes.PermissionDenied, "access to %s is denied", method)
+	}
+
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if policy == adminAccess && payload.Role != util.ADMIN {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can access this resource")
+	}
+
+	return context.WithValue(ctx, payloadKey{}, payload), nil
+}
+
+// UnaryAuthInterceptor enforces the access policy of a method before its handler runs
+func (server *Server) UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := server.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authorizedStream is a server stream whose context carries the payload of the verified access token
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authorizedStream) Context() context.Context {
+	return stream.ctx
+}
+
+// StreamAuthInterceptor enforces the access policy of a stream before it starts
+func (server *Server) StreamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := server.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+}
+
+// payloadFromContext returns the payload put in the context by the auth interceptors
+func payloadFromContext(ctx context.Context) (*token.Payload, bool) {
+	payload, ok := ctx.Value(payloadKey{}).(*token.Payload)
+	return payload, ok
+}
+
+// authorizedPayload returns the payload of the access token of a method that needs one.
+// It is only missing if the method has no authenticated policy in methodPolicies
+func authorizedPayload(ctx context.Context) (*token.Payload, error) {
+	payload, ok := payloadFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "unauthorized: missing access token")
+	}
+
+	return payload, nil
+}
@@ -1,7 +1,10 @@
 package gapi
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"net"
 	"net/http"
 	"time"
 
@@ -52,6 +55,27 @@ func (rec *ResponseRecorder) Write(body []byte) (int, error) {
 	return rec.ResponseWriter.Write(body)
 }
 
+// Flush sends every message of a gateway stream as soon as it is written
+func (rec *ResponseRecorder) Flush() {
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack hands the connection over to the websocket feed
+func (rec *ResponseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rec.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer doesn't support hijacking")
+	}
+
+	conn, rw, err := hijacker.Hijack()
+	if err == nil {
+		rec.StatusCode = http.StatusSwitchingProtocols
+	}
+	return conn, rw, err
+}
+
 func HttpLogger(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		startTime := time.Now()
@@ -63,7 +87,7 @@ func HttpLogger(handler http.Handler) http.Handler {
 		duration := time.Since(startTime)
 
 		logger := log.Info()
-		if rec.StatusCode != http.StatusOK {
+		if rec.StatusCode != http.StatusOK && rec.StatusCode != http.StatusSwitchingProtocols {
 			logger = log.Error().Bytes("body", rec.Body)
 		}
 
@@ -0,0 +1,251 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"go-exchange/engine"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"go-exchange/val"
+	"time"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultBookDepth is the number of price levels of each side returned when no depth is given
+const defaultBookDepth = 20
+
+// maxCandles is the most candles returned at once
+const maxCandles = 1000
+
+// defaultTradeTape is the number of recent trades returned when no limit is given
+const defaultTradeTape = 50
+
+func (server *Server) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.GetOrderBookResponse, error) {
+	violations := validateGetOrderBookRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	limit := defaultBookDepth
+	if req.Depth != nil {
+		limit = int(req.GetDepth())
+	}
+
+	depth, err := server.engine.Depth(req.GetPair(), limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get order book: %s", err)
+	}
+
+	rsp := &pb.GetOrderBookResponse{
+		Pair:     depth.Pair,
+		Bids:     convertPriceLevels(depth.Bids),
+		Asks:     convertPriceLevels(depth.Asks),
+		Sequence: depth.Sequence,
+	}
+	return rsp, nil
+}
+
+func validateGetOrderBookRequest(req *pb.GetOrderBookRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if req.Depth != nil {
+		if err := val.ValidateDepth(req.GetDepth()); err != nil {
+			violations = append(violations, fieldViolation("depth", err))
+		}
+	}
+
+	return violations
+}
+
+func (server *Server) ListTickers(ctx context.Context, req *pb.ListTickersRequest) (*pb.ListTickersResponse, error) {
+	tickers := server.engine.Tickers()
+
+	rsp := &pb.ListTickersResponse{
+		Tickers: make([]*pb.Ticker, 0, len(tickers)),
+	}
+	for _, ticker := range tickers {
+		rsp.Tickers = append(rsp.Tickers, convertTicker(ticker))
+	}
+	return rsp, nil
+}
+
+func (server *Server) GetTicker(ctx context.Context, req *pb.GetTickerRequest) (*pb.GetTickerResponse, error) {
+	violations := validateGetTickerRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	ticker, err := server.engine.Ticker(req.GetPair())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get ticker: %s", err)
+	}
+
+	rsp := &pb.GetTickerResponse{
+		Ticker: convertTicker(ticker),
+	}
+	return rsp, nil
+}
+
+func validateGetTickerRequest(req *pb.GetTickerRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	return violations
+}
+
+// ListMarketTrades returns the most recent trades of the order book of a pair, newest first
+func (server *Server) ListMarketTrades(ctx context.Context, req *pb.ListMarketTradesRequest) (*pb.ListMarketTradesResponse, error) {
+	violations := validateListMarketTradesRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	limit := int32(defaultTradeTape)
+	if req.Limit != nil {
+		limit = req.GetLimit()
+	}
+
+	trades, err := server.store.ListPairTrades(ctx, db.ListPairTradesParams{
+		Pair:  req.GetPair(),
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trades: %s", err)
+	}
+
+	rsp := &pb.ListMarketTradesResponse{
+		Trades: make([]*pb.MarketTrade, 0, len(trades)),
+	}
+	for _, trade := range trades {
+		rsp.Trades = append(rsp.Trades, convertMarketTrade(engine.NewMarketTrade(trade)))
+	}
+	return rsp, nil
+}
+
+func validateListMarketTradesRequest(req *pb.ListMarketTradesRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if req.Limit != nil {
+		if err := val.ValidateTradeLimit(req.GetLimit()); err != nil {
+			violations = append(violations, fieldViolation("limit", err))
+		}
+	}
+
+	return violations
+}
+
+// ListCandles returns the candles of a pair opened between from and to, oldest first.
+// Without to it ends now, and without from it starts maxCandles intervals before to.
+// Intervals without trades have no candle
+func (server *Server) ListCandles(ctx context.Context, req *pb.ListCandlesRequest) (*pb.ListCandlesResponse, error) {
+	violations := validateListCandlesRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	duration := util.CandleIntervalDuration(req.GetInterval())
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.Add(-maxCandles * duration)
+	if req.From != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	if !from.Before(to) {
+		violation := fieldViolation("from", errors.New("must be before to"))
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{violation})
+	}
+
+	arg := db.ListCandlesParams{
+		Pair:     req.GetPair(),
+		Interval: req.GetInterval(),
+		FromTime: from.Truncate(duration),
+		ToTime:   to,
+		Limit:    maxCandles,
+	}
+
+	candles, err := server.store.ListCandles(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list candles: %s", err)
+	}
+
+	rsp := &pb.ListCandlesResponse{
+		Candles: make([]*pb.Candle, 0, len(candles)),
+	}
+	for _, candle := range candles {
+		rsp.Candles = append(rsp.Candles, convertCandle(candle))
+	}
+	return rsp, nil
+}
+
+func validateListCandlesRequest(req *pb.ListCandlesRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if err := val.ValidateCandleInterval(req.GetInterval()); err != nil {
+		violations = append(violations, fieldViolation("interval", err))
+	}
+
+	return violations
+}
+
+// BackfillCandles rebuilds the candles of every interval of a pair from the trades executed between two times,
+// up to now when no end is given
+func (server *Server) BackfillCandles(ctx context.Context, req *pb.BackfillCandlesRequest) (*pb.BackfillCandlesResponse, error) {
+	violations := validateBackfillCandlesRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromTime := req.GetFromTime().AsTime()
+	toTime := time.Now()
+	if req.ToTime != nil {
+		toTime = req.GetToTime().AsTime()
+	}
+
+	if !fromTime.Before(toTime) {
+		violation := fieldViolation("from_time", errors.New("must be before to_time"))
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{violation})
+	}
+
+	result, err := server.store.BackfillCandlesTx(ctx, db.BackfillCandlesTxParams{
+		Pair:     req.GetPair(),
+		FromTime: fromTime,
+		ToTime:   toTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to backfill candles: %s", err)
+	}
+
+	rsp := &pb.BackfillCandlesResponse{
+		Candles: result.Candles,
+	}
+	return rsp, nil
+}
+
+func validateBackfillCandlesRequest(req *pb.BackfillCandlesRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if req.FromTime == nil {
+		violations = append(violations, fieldViolation("from_time", errors.New("is required")))
+	}
+
+	return violations
+}
@@ -0,0 +1,373 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"go-exchange/val"
+	"time"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) CancelOrders(ctx context.Context, req *pb.CancelOrdersRequest) (*pb.CancelOrdersResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCancelOrdersRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if req.AccountId != nil {
+		_, err := server.verifyAccountOwner(ctx, req.GetAccountId(), authPayload.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	arg := db.CancelOrdersTxParams{
+		Owner:     authPayload.Username,
+		Pair:      req.GetPair(),
+		Side:      req.GetSide(),
+		AccountID: req.GetAccountId(),
+	}
+
+	result, err := server.engine.CancelOrders(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel orders: %s", err)
+	}
+
+	rsp := &pb.CancelOrdersResponse{
+		BidIds: make([]int64, 0, len(result.Bids)),
+		AskIds: make([]int64, 0, len(result.Asks)),
+	}
+	for _, bid := range result.Bids {
+		rsp.BidIds = append(rsp.BidIds, bid.ID)
+	}
+	for _, ask := range result.Asks {
+		rsp.AskIds = append(rsp.AskIds, ask.ID)
+	}
+	return rsp, nil
+}
+
+func validateCancelOrdersRequest(req *pb.CancelOrdersRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.Pair != nil {
+		if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+			violations = append(violations, fieldViolation("pair", err))
+		}
+	}
+
+	if req.Side != nil {
+		if err := val.ValidateSide(req.GetSide()); err != nil {
+			violations = append(violations, fieldViolation("side", err))
+		}
+	}
+
+	if req.AccountId != nil {
+		if err := val.ValidateID(req.GetAccountId()); err != nil {
+			violations = append(violations, fieldViolation("account_id", err))
+		}
+	}
+
+	return violations
+}
+
+// newOrderRequest holds the fields shared by the requests that create a bid or an ask
+type newOrderRequest interface {
+	GetPair() string
+	GetFromAccountId() int64
+	GetToAccountId() int64
+	GetPrice() string
+	GetAmount() string
+	GetType() string
+	GetMaxSlippage() int64
+	GetTimeInForce() string
+	GetExpiresAt() *timestamppb.Timestamp
+	GetStopPrice() string
+	GetPostOnly() bool
+	GetDisplayAmount() string
+	GetHidden() bool
+	GetSelfTradePrevention() string
+}
+
+func validateNewOrderRequest(req newOrderRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateActivePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	violations = append(violations, validateOptionalDecimal("price", req.GetPrice())...)
+	violations = append(violations, validateOptionalDecimal("stop_price", req.GetStopPrice())...)
+	violations = append(violations, validateOptionalDecimal("display_amount", req.GetDisplayAmount())...)
+
+	if req.GetType() != "" {
+		if err := val.ValidateOrderType(req.GetType()); err != nil {
+			violations = append(violations, fieldViolation("type", err))
+		}
+	}
+
+	if err := val.ValidateMaxSlippage(req.GetMaxSlippage()); err != nil {
+		violations = append(violations, fieldViolation("max_slippage", err))
+	}
+
+	if req.GetTimeInForce() != "" {
+		if err := val.ValidateTimeInForce(req.GetTimeInForce()); err != nil {
+			violations = append(violations, fieldViolation("time_in_force", err))
+		}
+	}
+
+	if req.GetSelfTradePrevention() != "" {
+		if err := val.ValidateSelfTradePrevention(req.GetSelfTradePrevention()); err != nil {
+			violations = append(violations, fieldViolation("self_trade_prevention", err))
+		}
+	}
+
+	return violations
+}
+
+// newOrder holds a validated request to create a bid or an ask
+type newOrder struct {
+	orderType     string
+	price         decimal.Decimal
+	amount        decimal.Decimal
+	stopPrice     decimal.Decimal
+	displayAmount decimal.Decimal
+	timeInForce   string
+	expiresAt     sql.NullTime
+}
+
+// parseNewOrder checks the rules of a new order that span several fields of the request.
+// Limit orders are good till canceled and market orders are immediate or cancel by default
+func (server *Server) parseNewOrder(req newOrderRequest) (newOrder, error) {
+	order := newOrder{
+		orderType:     req.GetType(),
+		price:         parseDecimal(req.GetPrice()),
+		amount:        parseDecimal(req.GetAmount()),
+		stopPrice:     parseDecimal(req.GetStopPrice()),
+		displayAmount: parseDecimal(req.GetDisplayAmount()),
+	}
+	if order.orderType == "" {
+		order.orderType = util.LIMIT
+	}
+
+	if err := val.ValidateOrderPrices(order.orderType, order.price, order.stopPrice, req.GetMaxSlippage()); err != nil {
+		return order, status.Errorf(codes.InvalidArgument, "invalid order: %s", err)
+	}
+
+	var expiresAt time.Time
+	if req.GetExpiresAt() != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	var err error
+	order.timeInForce, order.expiresAt, err = val.ValidateOrderTimeInForce(order.orderType, req.GetTimeInForce(), expiresAt)
+	if err != nil {
+		return order, status.Errorf(codes.InvalidArgument, "invalid order: %s", err)
+	}
+
+	if err := val.ValidateOrderFlags(order.orderType, order.timeInForce, order.amount, req.GetPostOnly(), order.displayAmount, req.GetHidden()); err != nil {
+		return order, status.Errorf(codes.InvalidArgument, "invalid order: %s", err)
+	}
+
+	size := newOrderSize(order.price, order.stopPrice, order.amount, order.displayAmount)
+	if err := server.validOrderSize(req.GetPair(), size); err != nil {
+		return order, err
+	}
+
+	return order, nil
+}
+
+// getOrderRequest holds the id of a bid or an ask to look up
+type getOrderRequest interface {
+	GetId() int64
+}
+
+func validateGetOrderRequest(req getOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
+
+// listOrdersRequest holds the fields shared by the requests that list bids or asks between two accounts
+type listOrdersRequest interface {
+	GetFromAccountId() int64
+	GetToAccountId() int64
+	GetPageId() int32
+	GetPageSize() int32
+}
+
+func validateListOrdersRequest(req listOrdersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	return append(violations, validatePage(req.GetPageId(), req.GetPageSize())...)
+}
+
+// updateOrderRequest holds the fields shared by the requests that update a bid or an ask
+type updateOrderRequest interface {
+	GetId() int64
+	GetStatus() string
+	GetPrice() string
+	GetAmount() string
+}
+
+func validateUpdateOrderRequest(req updateOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.GetStatus() != "" && req.GetStatus() != util.CANCELED {
+		violations = append(violations, fieldViolation("status", fmt.Errorf("must be %s", util.CANCELED)))
+	}
+
+	violations = append(violations, validateOptionalDecimal("price", req.GetPrice())...)
+	violations = append(violations, validateOptionalDecimal("amount", req.GetAmount())...)
+
+	if violations == nil {
+		if err := val.ValidateOrderUpdate(req.GetStatus(), parseDecimal(req.GetPrice()), parseDecimal(req.GetAmount())); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
+
+// listOrderEventsRequest holds the fields shared by the requests that list the events of a bid or an ask
+type listOrderEventsRequest interface {
+	GetId() int64
+	GetPageId() int32
+	GetPageSize() int32
+}
+
+func validateListOrderEventsRequest(req listOrderEventsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return append(violations, validatePage(req.GetPageId(), req.GetPageSize())...)
+}
+
+// listOrderEvents returns a page of the event history of an order, oldest first
+func (server *Server) listOrderEvents(ctx context.Context, side string, id int64, req listOrderEventsRequest) ([]*pb.OrderHistoryEvent, error) {
+	arg := db.ListOrderEventsParams{
+		Side:    side,
+		OrderID: id,
+		Limit:   req.GetPageSize(),
+		Offset:  (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	events, err := server.store.ListOrderEvents(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list order events: %s", err)
+	}
+
+	result := make([]*pb.OrderHistoryEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, convertOrderHistoryEvent(event))
+	}
+	return result, nil
+}
+
+// orderSize holds the prices and amounts of an order to check against the trading rules of its pair.
+// Zero prices and amounts aren't checked, notionalAmount at notionalPrice is what the order is worth
+type orderSize struct {
+	price          decimal.Decimal
+	stopPrice      decimal.Decimal
+	amount         decimal.Decimal
+	displayAmount  decimal.Decimal
+	notionalAmount decimal.Decimal
+	notionalPrice  decimal.Decimal
+}
+
+// newOrderSize returns the size of a new order, which is worth its amount at its limit price or else at its stop price
+func newOrderSize(price decimal.Decimal, stopPrice decimal.Decimal, amount decimal.Decimal, displayAmount decimal.Decimal) orderSize {
+	notionalPrice := price
+	if notionalPrice.IsZero() {
+		notionalPrice = stopPrice
+	}
+
+	return orderSize{
+		price:          price,
+		stopPrice:      stopPrice,
+		amount:         amount,
+		displayAmount:  displayAmount,
+		notionalAmount: amount,
+		notionalPrice:  notionalPrice,
+	}
+}
+
+// orderSizeViolations checks prices are multiples of the tick size of the pair, amounts multiples of its lot size
+// and the order is worth at least its minimum notional
+func orderSizeViolations(pair db.Pair, size orderSize) (violations []*errdetails.BadRequest_FieldViolation) {
+	if !size.price.IsZero() {
+		if err := val.ValidateTickSize(size.price, pair); err != nil {
+			violations = append(violations, fieldViolation("price", err))
+		}
+	}
+
+	if !size.stopPrice.IsZero() {
+		if err := val.ValidateTickSize(size.stopPrice, pair); err != nil {
+			violations = append(violations, fieldViolation("stop_price", err))
+		}
+	}
+
+	if !size.amount.IsZero() {
+		if err := val.ValidateLotSize(size.amount, pair); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	if !size.displayAmount.IsZero() {
+		if err := val.ValidateLotSize(size.displayAmount, pair); err != nil {
+			violations = append(violations, fieldViolation("display_amount", err))
+		}
+	}
+
+	if !size.notionalAmount.IsZero() && !size.notionalPrice.IsZero() {
+		if err := val.ValidateMinNotional(size.notionalAmount, size.notionalPrice, pair); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
+
+// validOrderSize returns the field violations of an order that doesn't meet the trading rules of its pair
+func (server *Server) validOrderSize(symbol string, size orderSize) error {
+	pair, _ := server.registry.Pair(symbol)
+
+	if violations := orderSizeViolations(pair, size); violations != nil {
+		return invalidArgumentError(violations)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateOrderGroup creates all the legs of an order group at once.
+// The legs of an OCO are on the side of the request, a bracket enters on the side of the request
+// and its take profit and stop loss exit on the opposite side with the accounts swapped
+func (server *Server) CreateOrderGroup(ctx context.Context, req *pb.CreateOrderGroupRequest) (*pb.CreateOrderGroupResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateOrderGroupRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	group := engine.OrderGroup{
+		Type:                req.GetType(),
+		Pair:                req.GetPair(),
+		Side:                req.GetSide(),
+		FromAccountID:       req.GetFromAccountId(),
+		ToAccountID:         req.GetToAccountId(),
+		Amount:              parseDecimal(req.GetAmount()),
+		Price:               parseDecimal(req.GetPrice()),
+		TakeProfitPrice:     parseDecimal(req.GetTakeProfitPrice()),
+		StopPrice:           parseDecimal(req.GetStopPrice()),
+		StopLimitPrice:      parseDecimal(req.GetStopLimitPrice()),
+		MaxSlippage:         req.GetMaxSlippage(),
+		SelfTradePrevention: req.GetSelfTradePrevention(),
+	}
+
+	err = val.ValidateOrderGroupPrices(group.Type, group.Side, group.Price, group.TakeProfitPrice, group.StopPrice, group.StopLimitPrice, group.MaxSlippage)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order group: %s", err)
+	}
+
+	pair, _ := server.registry.Pair(group.Pair)
+	if violations := orderGroupSizeViolations(pair, group); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	c1, c2 := util.CurrenciesFromPair(group.Pair)
+	fromCurrency, toCurrency := c2, c1
+	if group.Side == util.ASK {
+		fromCurrency, toCurrency = c1, c2
+	}
+
+	fromAccount, err := server.validAccount(ctx, "from_account_id", group.FromAccountID, fromCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := server.validAccount(ctx, "to_account_id", group.ToAccountID, toCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	result, err := server.store.CreateOrderGroupTx(ctx, group.Params(pair))
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, failedPreconditionError("FUNDS", "from_account_id", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create order group: %s", err)
+	}
+
+	if err := server.engine.PlaceOrderGroup(ctx, group.Side, result.Legs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to place order group: %s", err)
+	}
+
+	legs, err := server.listOrderGroupLegs(ctx, result.OrderGroup.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.CreateOrderGroupResponse{
+		OrderGroup: convertOrderGroup(result.OrderGroup, legs),
+	}
+	return rsp, nil
+}
+
+func validateCreateOrderGroupRequest(req *pb.CreateOrderGroupRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateOrderGroupType(req.GetType()); err != nil {
+		violations = append(violations, fieldViolation("type", err))
+	}
+
+	if err := val.ValidateActivePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if err := val.ValidateSide(req.GetSide()); err != nil {
+		violations = append(violations, fieldViolation("side", err))
+	}
+
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetTakeProfitPrice()); err != nil {
+		violations = append(violations, fieldViolation("take_profit_price", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetStopPrice()); err != nil {
+		violations = append(violations, fieldViolation("stop_price", err))
+	}
+
+	violations = append(violations, validateOptionalDecimal("price", req.GetPrice())...)
+	violations = append(violations, validateOptionalDecimal("stop_limit_price", req.GetStopLimitPrice())...)
+
+	if err := val.ValidateMaxSlippage(req.GetMaxSlippage()); err != nil {
+		violations = append(violations, fieldViolation("max_slippage", err))
+	}
+
+	if req.GetSelfTradePrevention() != "" {
+		if err := val.ValidateSelfTradePrevention(req.GetSelfTradePrevention()); err != nil {
+			violations = append(violations, fieldViolation("self_trade_prevention", err))
+		}
+	}
+
+	return violations
+}
+
+// orderGroupSizeViolations checks the legs of an order group against the trading rules of its pair.
+// Every leg trades amount, so the leg with the lowest price must still be worth the minimum notional
+func orderGroupSizeViolations(pair db.Pair, group engine.OrderGroup) []*errdetails.BadRequest_FieldViolation {
+	stopLossPrice := group.StopLimitPrice
+	if stopLossPrice.IsZero() {
+		stopLossPrice = group.StopPrice
+	}
+	lowestPrice := decimal.Min(group.TakeProfitPrice, stopLossPrice)
+	if !group.Price.IsZero() {
+		lowestPrice = decimal.Min(lowestPrice, group.Price)
+	}
+
+	violations := orderSizeViolations(pair, orderSize{
+		price:          group.Price,
+		stopPrice:      group.StopPrice,
+		amount:         group.Amount,
+		notionalAmount: group.Amount,
+		notionalPrice:  lowestPrice,
+	})
+
+	if err := val.ValidateTickSize(group.TakeProfitPrice, pair); err != nil {
+		violations = append(violations, fieldViolation("take_profit_price", err))
+	}
+	if !group.StopLimitPrice.IsZero() {
+		if err := val.ValidateTickSize(group.StopLimitPrice, pair); err != nil {
+			violations = append(violations, fieldViolation("stop_limit_price", err))
+		}
+	}
+
+	return violations
+}
+
+func (server *Server) listOrderGroupLegs(ctx context.Context, id int64) (db.OrderGroupLegs, error) {
+	var legs db.OrderGroupLegs
+	var err error
+	groupID := sql.NullInt64{Int64: id, Valid: true}
+
+	legs.Bids, err = server.store.ListBidsByGroup(ctx, groupID)
+	if err != nil {
+		return legs, status.Errorf(codes.Internal, "failed to list order group bids: %s", err)
+	}
+
+	legs.Asks, err = server.store.ListAsksByGroup(ctx, groupID)
+	if err != nil {
+		return legs, status.Errorf(codes.Internal, "failed to list order group asks: %s", err)
+	}
+
+	return legs, nil
+}
+
+// verifyOrderGroupOwner loads an order group with its legs and checks the user owns them
+func (server *Server) verifyOrderGroupOwner(ctx context.Context, id int64, username string) (db.OrderGroup, db.OrderGroupLegs, error) {
+	var legs db.OrderGroupLegs
+
+	group, err := server.store.GetOrderGroup(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return group, legs, status.Errorf(codes.NotFound, "order group %d not found", id)
+		}
+		return group, legs, status.Errorf(codes.Internal, "failed to get order group: %s", err)
+	}
+
+	legs, err = server.listOrderGroupLegs(ctx, group.ID)
+	if err != nil {
+		return group, legs, err
+	}
+
+	// every leg is paid from an account of the same user
+	var accountID int64
+	if len(legs.Bids) > 0 {
+		accountID = legs.Bids[0].FromAccountID
+	} else if len(legs.Asks) > 0 {
+		accountID = legs.Asks[0].FromAccountID
+	}
+
+	_, err = server.verifyAccountOwner(ctx, accountID, username)
+	if err != nil {
+		return group, legs, err
+	}
+
+	return group, legs, nil
+}
+
+func (server *Server) GetOrderGroup(ctx context.Context, req *pb.GetOrderGroupRequest) (*pb.GetOrderGroupResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetOrderGroupRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	group, legs, err := server.verifyOrderGroupOwner(ctx, req.GetId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetOrderGroupResponse{
+		OrderGroup: convertOrderGroup(group, legs),
+	}
+	return rsp, nil
+}
+
+func validateGetOrderGroupRequest(req *pb.GetOrderGroupRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
+
+// UpdateOrderGroup cancels every open leg of an order group
+func (server *Server) UpdateOrderGroup(ctx context.Context, req *pb.UpdateOrderGroupRequest) (*pb.UpdateOrderGroupResponse, error) {
+	authPayload, err := authorizedPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateOrderGroupRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	group, legs, err := server.verifyOrderGroupOwner(ctx, req.GetId(), authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	open := db.OrderGroupLegs{}
+	for _, bid := range legs.Bids {
+		if util.IsOpenStatus(bid.Status) {
+			open.Bids = append(open.Bids, bid)
+		}
+	}
+	for _, ask := range legs.Asks {
+		if util.IsOpenStatus(ask.Status) {
+			open.Asks = append(open.Asks, ask)
+		}
+	}
+
+	if len(open.Bids)+len(open.Asks) == 0 {
+		err := fmt.Errorf("order group %d has no open orders", group.ID)
+		return nil, failedPreconditionError("STATUS", "id", err)
+	}
+
+	server.engine.CancelLegs(open)
+
+	result, err := server.store.CancelOrderGroupTx(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "order group %d not found", req.GetId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel order group: %s", err)
+	}
+
+	legs, err = server.listOrderGroupLegs(ctx, result.OrderGroup.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UpdateOrderGroupResponse{
+		OrderGroup: convertOrderGroup(result.OrderGroup, legs),
+	}
+	return rsp, nil
+}
+
+func validateUpdateOrderGroupRequest(req *pb.UpdateOrderGroupRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.GetStatus() != util.CANCELED {
+		violations = append(violations, fieldViolation("status", fmt.Errorf("must be %s", util.CANCELED)))
+	}
+
+	return violations
+}
@@ -0,0 +1,321 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	currencies := server.registry.Currencies()
+
+	rsp := &pb.ListCurrenciesResponse{
+		Currencies: make([]*pb.Currency, 0, len(currencies)),
+	}
+	for _, currency := range currencies {
+		rsp.Currencies = append(rsp.Currencies, convertCurrency(currency))
+	}
+	return rsp, nil
+}
+
+func (server *Server) ListPairs(ctx context.Context, req *pb.ListPairsRequest) (*pb.ListPairsResponse, error) {
+	pairs := server.registry.Pairs()
+
+	rsp := &pb.ListPairsResponse{
+		Pairs: make([]*pb.Pair, 0, len(pairs)),
+	}
+	for _, pair := range pairs {
+		rsp.Pairs = append(rsp.Pairs, convertPair(pair))
+	}
+	return rsp, nil
+}
+
+// CreateCurrency lists a new currency along with the exchange account its fees are collected into
+func (server *Server) CreateCurrency(ctx context.Context, req *pb.CreateCurrencyRequest) (*pb.CreateCurrencyResponse, error) {
+	violations := validateCreateCurrencyRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.CreateCurrencyTx(ctx, db.CreateCurrencyParams{
+		Code:     req.GetCode(),
+		Decimals: req.GetDecimals(),
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "currency already exists: %s", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create currency: %s", err)
+	}
+	server.registry.SetCurrency(result.Currency)
+
+	rsp := &pb.CreateCurrencyResponse{
+		Currency: convertCurrency(result.Currency),
+	}
+	return rsp, nil
+}
+
+func validateCreateCurrencyRequest(req *pb.CreateCurrencyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrencyCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if err := val.ValidateCurrencyDecimals(req.GetDecimals()); err != nil {
+		violations = append(violations, fieldViolation("decimals", err))
+	}
+
+	return violations
+}
+
+// UpdateCurrency changes the decimals or the trading status of a currency.
+// Pairs can't take new orders while one of their currencies isn't active
+func (server *Server) UpdateCurrency(ctx context.Context, req *pb.UpdateCurrencyRequest) (*pb.UpdateCurrencyResponse, error) {
+	violations := validateUpdateCurrencyRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.UpdateCurrencyParams{
+		Code:     req.GetCode(),
+		Decimals: sql.NullInt64{Int64: req.GetDecimals(), Valid: req.Decimals != nil},
+		Status:   sql.NullString{String: req.GetStatus(), Valid: req.Status != nil},
+	}
+
+	currency, err := server.store.UpdateCurrency(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "currency %s not found", req.GetCode())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update currency: %s", err)
+	}
+	server.registry.SetCurrency(currency)
+
+	rsp := &pb.UpdateCurrencyResponse{
+		Currency: convertCurrency(currency),
+	}
+	return rsp, nil
+}
+
+func validateUpdateCurrencyRequest(req *pb.UpdateCurrencyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateString(req.GetCode(), 1, 10); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if req.Decimals != nil {
+		if err := val.ValidateCurrencyDecimals(req.GetDecimals()); err != nil {
+			violations = append(violations, fieldViolation("decimals", err))
+		}
+	}
+
+	if req.Status != nil {
+		if err := val.ValidateMarketStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
+
+// CreatePair lists a new market between two active currencies, open for trading right away
+func (server *Server) CreatePair(ctx context.Context, req *pb.CreatePairRequest) (*pb.CreatePairResponse, error) {
+	violations := validateCreatePairRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tickSize, lotSize := parseDecimal(req.GetTickSize()), parseDecimal(req.GetLotSize())
+	minNotional := parseDecimal(req.GetMinNotional())
+
+	violations = server.pairPrecisionViolations(req.GetBase(), req.GetQuote(), tickSize, lotSize, minNotional)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pair, err := server.store.CreatePair(ctx, db.CreatePairParams{
+		Symbol:      fmt.Sprintf("%s/%s", req.GetBase(), req.GetQuote()),
+		Base:        req.GetBase(),
+		Quote:       req.GetQuote(),
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+		MinNotional: minNotional,
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code.Name() {
+			case "unique_violation":
+				return nil, status.Errorf(codes.AlreadyExists, "pair already exists: %s", err)
+			case "foreign_key_violation":
+				return nil, failedPreconditionError("CURRENCY", "base", err)
+			}
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create pair: %s", err)
+	}
+	server.registry.SetPair(pair)
+
+	rsp := &pb.CreatePairResponse{
+		Pair: convertPair(pair),
+	}
+	return rsp, nil
+}
+
+func validateCreatePairRequest(req *pb.CreatePairRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetBase(), registry); err != nil {
+		violations = append(violations, fieldViolation("base", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetQuote(), registry); err != nil {
+		violations = append(violations, fieldViolation("quote", err))
+	} else if req.GetQuote() == req.GetBase() {
+		violations = append(violations, fieldViolation("quote", errors.New("must differ from base")))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetTickSize()); err != nil {
+		violations = append(violations, fieldViolation("tick_size", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetLotSize()); err != nil {
+		violations = append(violations, fieldViolation("lot_size", err))
+	}
+
+	if req.MinNotional != nil {
+		if err := val.ValidateNonNegativeDecimal(req.GetMinNotional()); err != nil {
+			violations = append(violations, fieldViolation("min_notional", err))
+		}
+	}
+
+	return violations
+}
+
+// UpdatePair changes the trading rules or the trading status of a pair.
+// Paused pairs keep their open orders but take no new ones, delisting a pair also cancels its open orders
+func (server *Server) UpdatePair(ctx context.Context, req *pb.UpdatePairRequest) (*pb.UpdatePairResponse, error) {
+	violations := validateUpdatePairRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the rules left out keep their current value, the new ones must still fit the currencies of the pair
+	listed, _ := server.registry.Pair(req.GetPair())
+	tickSize, lotSize, minNotional := listed.TickSize, listed.LotSize, listed.MinNotional
+	if req.TickSize != nil {
+		tickSize = parseDecimal(req.GetTickSize())
+	}
+	if req.LotSize != nil {
+		lotSize = parseDecimal(req.GetLotSize())
+	}
+	if req.MinNotional != nil {
+		minNotional = parseDecimal(req.GetMinNotional())
+	}
+
+	violations = server.pairPrecisionViolations(listed.Base, listed.Quote, tickSize, lotSize, minNotional)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.UpdatePairParams{
+		Symbol:      req.GetPair(),
+		TickSize:    decimal.NullDecimal{Decimal: tickSize, Valid: req.TickSize != nil},
+		LotSize:     decimal.NullDecimal{Decimal: lotSize, Valid: req.LotSize != nil},
+		MinNotional: decimal.NullDecimal{Decimal: minNotional, Valid: req.MinNotional != nil},
+		Status:      sql.NullString{String: req.GetStatus(), Valid: req.Status != nil},
+	}
+
+	pair, err := server.store.UpdatePair(ctx, arg)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "pair %s not found", req.GetPair())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update pair: %s", err)
+	}
+	server.registry.SetPair(pair)
+
+	rsp := &pb.UpdatePairResponse{
+		Pair:           convertPair(pair),
+		CanceledBidIds: []int64{},
+		CanceledAskIds: []int64{},
+	}
+
+	if pair.Status == util.DELISTED {
+		result, err := server.engine.CancelOrders(ctx, db.CancelOrdersTxParams{Pair: pair.Symbol})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to cancel orders: %s", err)
+		}
+
+		for _, bid := range result.Bids {
+			rsp.CanceledBidIds = append(rsp.CanceledBidIds, bid.ID)
+		}
+		for _, ask := range result.Asks {
+			rsp.CanceledAskIds = append(rsp.CanceledAskIds, ask.ID)
+		}
+	}
+
+	return rsp, nil
+}
+
+func validateUpdatePairRequest(req *pb.UpdatePairRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if req.TickSize != nil {
+		if err := val.ValidatePositiveDecimal(req.GetTickSize()); err != nil {
+			violations = append(violations, fieldViolation("tick_size", err))
+		}
+	}
+
+	if req.LotSize != nil {
+		if err := val.ValidatePositiveDecimal(req.GetLotSize()); err != nil {
+			violations = append(violations, fieldViolation("lot_size", err))
+		}
+	}
+
+	if req.MinNotional != nil {
+		if err := val.ValidateNonNegativeDecimal(req.GetMinNotional()); err != nil {
+			violations = append(violations, fieldViolation("min_notional", err))
+		}
+	}
+
+	if req.Status != nil {
+		if err := val.ValidateMarketStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
+
+// pairPrecisionViolations checks the trading rules of a pair can be settled in its currencies.
+// Amounts on the lot size must fit the decimals of the base currency, and any trade at a price on the tick size
+// must be worth an amount that fits the decimals of the quote currency
+func (server *Server) pairPrecisionViolations(base string, quote string, tickSize decimal.Decimal, lotSize decimal.Decimal, minNotional decimal.Decimal) (violations []*errdetails.BadRequest_FieldViolation) {
+	baseCurrency, _ := server.registry.Currency(base)
+	quoteCurrency, _ := server.registry.Currency(quote)
+
+	if err := val.ValidateTickValue(tickSize, lotSize, quoteCurrency); err != nil {
+		violations = append(violations, fieldViolation("tick_size", err))
+	}
+	if err := val.ValidateDecimals(lotSize, baseCurrency); err != nil {
+		violations = append(violations, fieldViolation("lot_size", err))
+	}
+	if err := val.ValidateDecimals(minNotional, quoteCurrency); err != nil {
+		violations = append(violations, fieldViolation("min_notional", err))
+	}
+
+	return violations
+}
@@ -3,7 +3,9 @@ package gapi
 import (
 	"fmt"
 	db "go-exchange/db/sqlc"
+	"go-exchange/engine"
 	"go-exchange/pb"
+	"go-exchange/registry"
 	"go-exchange/token"
 	"go-exchange/util"
 )
@@ -13,11 +15,13 @@ type Server struct {
 	pb.UnimplementedExchangeServer
 	config          util.Config
 	store           db.Store
+	engine          *engine.Engine
+	registry        *registry.Registry
 	tokenMaker      token.Maker
 }
 
 // NewServer creates a new gRPC server.
-func NewServer(config util.Config, store db.Store) (*Server, error) {
+func NewServer(config util.Config, store db.Store, engine *engine.Engine, registry *registry.Registry) (*Server, error) {
 	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
@@ -26,6 +30,8 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 	server := &Server{
 		config:          config,
 		store:           store,
+		engine:          engine,
+		registry:        registry,
 		tokenMaker:      tokenMaker,
 	}
 
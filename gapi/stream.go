@@ -0,0 +1,163 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"go-exchange/engine"
+	"go-exchange/feed"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamBuffer is the number of events buffered for a stream.
+// A stream whose client can't keep up drops behind that many events and is ended instead of blocking the matching engine
+const streamBuffer = 256
+
+func (server *Server) StreamOrderBook(req *pb.StreamOrderBookRequest, stream pb.Exchange_StreamOrderBookServer) error {
+	violations := validatePairRequest(req.GetPair(), server.registry)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	// the subscription starts before the snapshot is taken, so no update published in between is missed
+	sub := server.engine.Feed().Subscribe(feed.Topic(feed.BOOK, req.GetPair()), streamBuffer)
+	defer sub.Close()
+
+	depth, err := server.engine.Depth(req.GetPair(), 0)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get order book: %s", err)
+	}
+
+	err = stream.Send(&pb.OrderBookEvent{
+		Pair:     depth.Pair,
+		Type:     feed.SNAPSHOT,
+		Sequence: depth.Sequence,
+		Bids:     convertPriceLevels(depth.Bids),
+		Asks:     convertPriceLevels(depth.Asks),
+	})
+	if err != nil {
+		return err
+	}
+
+	return forward(stream.Context(), sub, depth.Sequence, func(event feed.Event) error {
+		update := event.Data.(engine.BookUpdate)
+		return stream.Send(&pb.OrderBookEvent{
+			Pair:     update.Pair,
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Bids:     convertPriceLevels(update.Bids),
+			Asks:     convertPriceLevels(update.Asks),
+		})
+	})
+}
+
+func (server *Server) StreamTrades(req *pb.StreamTradesRequest, stream pb.Exchange_StreamTradesServer) error {
+	violations := validatePairRequest(req.GetPair(), server.registry)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	topic := feed.Topic(feed.TRADES, req.GetPair())
+	sub := server.engine.Feed().Subscribe(topic, streamBuffer)
+	defer sub.Close()
+
+	// trades have no snapshot, the stream starts with the first trade after it was opened
+	sequence := server.engine.Feed().Sequence(topic)
+
+	return forward(stream.Context(), sub, sequence, func(event feed.Event) error {
+		return stream.Send(&pb.TradeEvent{
+			Pair:     event.Key,
+			Sequence: event.Sequence,
+			Trade:    convertMarketTrade(event.Data.(engine.MarketTrade)),
+		})
+	})
+}
+
+func (server *Server) StreamMyOrders(req *pb.StreamMyOrdersRequest, stream pb.Exchange_StreamMyOrdersServer) error {
+	authPayload, err := authorizedPayload(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	topic := feed.Topic(feed.ORDERS, authPayload.Username)
+	sub := server.engine.Feed().Subscribe(topic, streamBuffer)
+	defer sub.Close()
+
+	// order updates hold the whole order, so an update already part of the snapshot can safely follow it
+	sequence := server.engine.Feed().Sequence(topic)
+
+	owner := sql.NullString{String: authPayload.Username, Valid: true}
+	bids, err := server.store.ListOpenBidsByOwner(stream.Context(), db.ListOpenBidsByOwnerParams{Owner: owner})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list bids: %s", err)
+	}
+	asks, err := server.store.ListOpenAsksByOwner(stream.Context(), db.ListOpenAsksByOwnerParams{Owner: owner})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list asks: %s", err)
+	}
+
+	orders := make([]*pb.Order, 0, len(bids)+len(asks))
+	for _, bid := range bids {
+		orders = append(orders, convertOrder(feed.NewOrderFromBid(bid)))
+	}
+	for _, ask := range asks {
+		orders = append(orders, convertOrder(feed.NewOrderFromAsk(ask)))
+	}
+
+	err = stream.Send(&pb.OrderEvent{
+		Type:     feed.SNAPSHOT,
+		Sequence: sequence,
+		Orders:   orders,
+	})
+	if err != nil {
+		return err
+	}
+
+	return forward(stream.Context(), sub, sequence, func(event feed.Event) error {
+		return stream.Send(&pb.OrderEvent{
+			Type:     event.Type,
+			Sequence: event.Sequence,
+			Orders:   []*pb.Order{convertOrder(event.Data.(feed.Order))},
+		})
+	})
+}
+
+// forward sends the events of a subscription that follow the sequence of a snapshot until the client goes away.
+// A subscription dropped for falling behind ends the stream with ResourceExhausted, the client must stream again to resync
+func forward(ctx context.Context, sub *feed.Subscription, sequence uint64, send func(event feed.Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-sub.Events():
+			if !ok {
+				if sub.Dropped() {
+					return status.Errorf(codes.ResourceExhausted, "stream fell behind, stream again to resync")
+				}
+				return status.Errorf(codes.Unavailable, "stream closed")
+			}
+
+			if event.Sequence <= sequence {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func validatePairRequest(pair string, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePair(pair, registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,192 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"go-exchange/decimal"
+	"go-exchange/pb"
+	"go-exchange/registry"
+	"go-exchange/util"
+	"go-exchange/val"
+
+	db "go-exchange/db/sqlc"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTrade(ctx context.Context, req *pb.CreateTradeRequest) (*pb.CreateTradeResponse, error) {
+	violations := validateCreateTradeRequest(req, server.registry)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	c1, c2 := util.CurrenciesFromPair(req.GetPair())
+	firstAmount := decimal.RequireFromString(req.GetFirstAmount())
+	secondAmount := decimal.RequireFromString(req.GetSecondAmount())
+
+	first, _ := server.registry.Currency(c1)
+	if err := val.ValidateDecimals(firstAmount, first); err != nil {
+		violations = append(violations, fieldViolation("first_amount", err))
+	}
+	second, _ := server.registry.Currency(c2)
+	if err := val.ValidateDecimals(secondAmount, second); err != nil {
+		violations = append(violations, fieldViolation("second_amount", err))
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accounts := []struct {
+		field    string
+		id       int64
+		currency string
+	}{
+		{"first_from_account_id", req.GetFirstFromAccountId(), c1},
+		{"first_to_account_id", req.GetFirstToAccountId(), c1},
+		{"second_from_account_id", req.GetSecondFromAccountId(), c2},
+		{"second_to_account_id", req.GetSecondToAccountId(), c2},
+	}
+	for _, account := range accounts {
+		if _, err := server.validAccount(ctx, account.field, account.id, account.currency); err != nil {
+			return nil, err
+		}
+	}
+
+	// only admins may call it, so the accounts can belong to any user
+	arg := db.TradeTxParams{
+		FirstFromAccountID: req.GetFirstFromAccountId(),
+		FirstToAccountID:   req.GetFirstToAccountId(),
+		FirstAmount:        firstAmount,
+
+		SecondFromAccountID: req.GetSecondFromAccountId(),
+		SecondToAccountID:   req.GetSecondToAccountId(),
+		SecondAmount:        secondAmount,
+
+		Pair: req.GetPair(),
+	}
+
+	result, err := server.store.TradeTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to trade: %s", err)
+	}
+
+	rsp := &pb.CreateTradeResponse{
+		Trade:             convertTrade(result.Trade),
+		FirstTransfer:     convertTransfer(result.FirstTransfer),
+		SecondTransfer:    convertTransfer(result.SecondTransfer),
+		FirstFeeTransfer:  convertTransfer(result.FirstFeeTransfer),
+		SecondFeeTransfer: convertTransfer(result.SecondFeeTransfer),
+	}
+	return rsp, nil
+}
+
+func validateCreateTradeRequest(req *pb.CreateTradeRequest, registry *registry.Registry) (violations []*errdetails.BadRequest_FieldViolation) {
+	ids := []struct {
+		field string
+		id    int64
+	}{
+		{"first_from_account_id", req.GetFirstFromAccountId()},
+		{"first_to_account_id", req.GetFirstToAccountId()},
+		{"second_from_account_id", req.GetSecondFromAccountId()},
+		{"second_to_account_id", req.GetSecondToAccountId()},
+	}
+	for _, id := range ids {
+		if err := val.ValidateID(id.id); err != nil {
+			violations = append(violations, fieldViolation(id.field, err))
+		}
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetFirstAmount()); err != nil {
+		violations = append(violations, fieldViolation("first_amount", err))
+	}
+
+	if err := val.ValidatePositiveDecimal(req.GetSecondAmount()); err != nil {
+		violations = append(violations, fieldViolation("second_amount", err))
+	}
+
+	if err := val.ValidateActivePair(req.GetPair(), registry); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	return violations
+}
+
+func (server *Server) GetTrade(ctx context.Context, req *pb.GetTradeRequest) (*pb.GetTradeResponse, error) {
+	violations := validateGetTradeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trade, err := server.store.GetTrade(ctx, req.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "trade not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find trade: %s", err)
+	}
+
+	rsp := &pb.GetTradeResponse{
+		Trade: convertTrade(trade),
+	}
+	return rsp, nil
+}
+
+func validateGetTradeRequest(req *pb.GetTradeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
+
+func (server *Server) ListTrades(ctx context.Context, req *pb.ListTradesRequest) (*pb.ListTradesResponse, error) {
+	violations := validateListTradesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListTradesParams{
+		FirstFromAccountID: req.GetFirstFromAccountId(),
+		FirstToAccountID:   req.GetFirstToAccountId(),
+
+		SecondFromAccountID: req.GetSecondFromAccountId(),
+		SecondToAccountID:   req.GetSecondToAccountId(),
+
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	trades, err := server.store.ListTrades(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trades: %s", err)
+	}
+
+	rsp := &pb.ListTradesResponse{
+		Trades: make([]*pb.Trade, 0, len(trades)),
+	}
+	for _, trade := range trades {
+		rsp.Trades = append(rsp.Trades, convertTrade(trade))
+	}
+	return rsp, nil
+}
+
+func validateListTradesRequest(req *pb.ListTradesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	ids := []struct {
+		field string
+		id    int64
+	}{
+		{"first_from_account_id", req.GetFirstFromAccountId()},
+		{"first_to_account_id", req.GetFirstToAccountId()},
+		{"second_from_account_id", req.GetSecondFromAccountId()},
+		{"second_to_account_id", req.GetSecondToAccountId()},
+	}
+	for _, id := range ids {
+		if err := val.ValidateID(id.id); err != nil {
+			violations = append(violations, fieldViolation(id.field, err))
+		}
+	}
+
+	return append(violations, validatePage(req.GetPageId(), req.GetPageSize())...)
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"go-exchange/decimal"
+	"go-exchange/val"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// validatePage checks the page of a list request
+func validatePage(pageID int32, pageSize int32) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateID(int64(pageID)); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(pageSize); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+// validateOptionalDecimal checks an optional decimal field is positive when it is set
+func validateOptionalDecimal(field string, value string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if value != "" {
+		if err := val.ValidatePositiveDecimal(value); err != nil {
+			violations = append(violations, fieldViolation(field, err))
+		}
+	}
+
+	return violations
+}
+
+// parseDecimal returns the decimal of a validated field, or zero if it isn't set
+func parseDecimal(value string) decimal.Decimal {
+	if value == "" {
+		return decimal.Zero
+	}
+	return decimal.RequireFromString(value)
+}
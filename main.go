@@ -3,11 +3,15 @@ package main
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"go-exchange/api"
 	db "go-exchange/db/sqlc"
 	_ "go-exchange/doc/statik"
+	"go-exchange/engine"
+	"go-exchange/feed"
 	"go-exchange/gapi"
 	"go-exchange/pb"
+	"go-exchange/registry"
 	"go-exchange/util"
 	"net"
 	"net/http"
@@ -23,6 +27,7 @@ import (
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -42,11 +47,18 @@ func main() {
 
 	runDBMigration(config.MigrationURL, config.DBSource)
 
-	store := db.NewStore(conn)
+	hub := feed.NewHub()
+	store := feed.NewStore(db.NewStore(conn), hub)
 
-	// go runGinServer(config, store)
-	go runGatewayServer(config, store)
-	runGrpcServer(config, store)
+	marketRegistry := loadRegistry(store)
+
+	matchingEngine := runMatchingEngine(store, marketRegistry, hub)
+	go matchingEngine.RunExpirySweeper(context.Background(), config.ExpirySweepInterval)
+	go matchingEngine.RunDeadManSwitchSweeper(context.Background(), config.DeadManSwitchSweepInterval)
+
+	// go runGinServer(config, store, matchingEngine, marketRegistry)
+	go runGatewayServer(config, store, matchingEngine, marketRegistry)
+	runGrpcServer(config, store, matchingEngine, marketRegistry)
 }
 
 // runDBMigration applies all up migrations
@@ -100,9 +112,40 @@ func connectToDB(config util.Config) *sql.DB {
 	}
 }
 
+// loadRegistry caches the currencies and pairs listed in the database
+func loadRegistry(store db.Store) *registry.Registry {
+	marketRegistry := registry.NewRegistry()
+
+	err := marketRegistry.Load(context.Background(), store)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load registry")
+	}
+
+	log.Info().Msg("registry loaded successfully")
+	return marketRegistry
+}
+
+// runMatchingEngine creates the matching engine publishing on the hub and rebuilds its tickers and order books
+func runMatchingEngine(store db.Store, marketRegistry *registry.Registry, hub *feed.Hub) *engine.Engine {
+	matchingEngine := engine.NewEngine(store, marketRegistry, hub)
+
+	err := matchingEngine.LoadTickers(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load tickers")
+	}
+
+	err = matchingEngine.Load(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load order books")
+	}
+
+	log.Info().Msg("order books loaded successfully")
+	return matchingEngine
+}
+
 // runGinServer creates and runs a HTTP server with Gin routes
-func runGinServer(config util.Config, store db.Store) {
-	server, err := api.NewServer(config, store)
+func runGinServer(config util.Config, store db.Store, matchingEngine *engine.Engine, marketRegistry *registry.Registry) {
+	server, err := api.NewServer(config, store, matchingEngine, marketRegistry)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
@@ -114,17 +157,12 @@ func runGinServer(config util.Config, store db.Store) {
 }
 
 // runGrpcServer creates and runs a gRPC server
-func runGrpcServer(config util.Config, store db.Store) {
-	server, err := gapi.NewServer(config, store)
+func runGrpcServer(config util.Config, store db.Store, matchingEngine *engine.Engine, marketRegistry *registry.Registry) {
+	grpcServer, err := newGrpcServer(config, store, matchingEngine, marketRegistry)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot ")
 	}
 
-	gprcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(gprcLogger)
-	pb.RegisterExchangeServer(grpcServer, server)
-	reflection.Register(grpcServer)
-
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create listener")
@@ -137,13 +175,50 @@ func runGrpcServer(config util.Config, store db.Store) {
 	}
 }
 
-// runGatewayServer creates and runs a HTTP server with gRPC
-func runGatewayServer(config util.Config, store db.Store) {
-	server, err := gapi.NewServer(config, store)
+// newGrpcServer creates a gRPC server with the exchange service and its interceptors
+func newGrpcServer(config util.Config, store db.Store, matchingEngine *engine.Engine, marketRegistry *registry.Registry) (*grpc.Server, error) {
+	server, err := gapi.NewServer(config, store, matchingEngine, marketRegistry)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
+		return nil, err
 	}
 
+	// every call is logged, including the ones the auth interceptors deny
+	unaryInterceptors := grpc.ChainUnaryInterceptor(gapi.GrpcLogger, server.UnaryAuthInterceptor)
+	streamInterceptors := grpc.ChainStreamInterceptor(server.StreamAuthInterceptor)
+	grpcServer := grpc.NewServer(unaryInterceptors, streamInterceptors)
+	pb.RegisterExchangeServer(grpcServer, server)
+	reflection.Register(grpcServer)
+
+	return grpcServer, nil
+}
+
+// runGatewayServer creates and runs a HTTP server with gRPC.
+// It forwards every request to the gRPC server, so the same interceptors authorize both
+func runGatewayServer(config util.Config, store db.Store, matchingEngine *engine.Engine, marketRegistry *registry.Registry) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := newGatewayHandler(ctx, config, store, matchingEngine, marketRegistry)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create HTTP gateway handler")
+	}
+
+	listener, err := net.Listen("tcp", config.HTTPServerAddress)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create listener")
+	}
+
+	log.Info().Msgf("start HTTP gateway server at %s", listener.Addr().String())
+
+	err = http.Serve(listener, handler)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot start HTTP gateway server")
+	}
+}
+
+// newGatewayHandler routes the HTTP requests of the gateway server to the gRPC gateway, the swagger docs
+// and the websocket feed, which has no gRPC counterpart and is served by the Gin server's handler
+func newGatewayHandler(ctx context.Context, config util.Config, store db.Store, matchingEngine *engine.Engine, marketRegistry *registry.Registry) (http.Handler, error) {
 	// for snake_case instead of camelCase
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
@@ -156,12 +231,10 @@ func runGatewayServer(config util.Config, store db.Store) {
 
 	grpcMux := runtime.NewServeMux(jsonOption)
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	err = pb.RegisterExchangeHandlerServer(ctx, grpcMux, server)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	err := pb.RegisterExchangeHandlerFromEndpoint(ctx, grpcMux, config.GRPCServerAddress, opts)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot register handler server")
+		return nil, fmt.Errorf("cannot register handler from endpoint: %w", err)
 	}
 
 	mux := http.NewServeMux()
@@ -169,22 +242,17 @@ func runGatewayServer(config util.Config, store db.Store) {
 
 	statikFS, err := fs.New()
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create statik fs")
+		return nil, fmt.Errorf("cannot create statik fs: %w", err)
 	}
 
 	swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(statikFS))
 	mux.Handle("/swagger/", swaggerHandler)
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
+	server, err := api.NewServer(config, store, matchingEngine, marketRegistry)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create listener")
+		return nil, fmt.Errorf("cannot create server: %w", err)
 	}
+	mux.Handle("/ws", server.WebsocketHandler())
 
-	log.Info().Msgf("start HTTP gateway server at %s", listener.Addr().String())
-
-	handler := gapi.HttpLogger(mux)
-	err = http.Serve(listener, handler)
-	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start HTTP gateway server")
-	}
+	return gapi.HttpLogger(mux), nil
 }
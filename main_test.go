@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/decimal"
+	"go-exchange/engine"
+	"go-exchange/feed"
+	"go-exchange/registry"
+	"go-exchange/token"
+	"go-exchange/util"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRegistry lists the BTC/USDT pair
+func newTestRegistry() *registry.Registry {
+	marketRegistry := registry.NewRegistry()
+	marketRegistry.SetCurrency(db.Currency{Code: util.BTC, Decimals: 8, Status: util.ACTIVE})
+	marketRegistry.SetCurrency(db.Currency{Code: util.USDT, Decimals: 6, Status: util.ACTIVE})
+	marketRegistry.SetPair(db.Pair{Symbol: util.BTC_USDT, Base: util.BTC, Quote: util.USDT, TickSize: decimal.NewFromInt(1), LotSize: decimal.NewFromInt(1), Status: util.ACTIVE})
+	return marketRegistry
+}
+
+// serveGateway runs the gRPC server and the HTTP gateway server the way main wires them, and returns the URL of the gateway with its config
+func serveGateway(t *testing.T, store db.Store) (string, util.Config) {
+	marketRegistry := newTestRegistry()
+	matchingEngine := engine.NewEngine(store, marketRegistry, feed.NewHub())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+		GRPCServerAddress:   listener.Addr().String(),
+	}
+
+	grpcServer, err := newGrpcServer(config, store, matchingEngine, marketRegistry)
+	require.NoError(t, err)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	handler, err := newGatewayHandler(ctx, config, store, matchingEngine, marketRegistry)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+
+	return httpServer.URL, config
+}
+
+func TestGatewayHandlerServesStreams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	url, _ := serveGateway(t, mockdb.NewMockStore(ctrl))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/v1/markets/BTC/USDT/book/stream", nil)
+	require.NoError(t, err)
+
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+
+	// every message of the stream is a line of JSON, the first one is the snapshot of the book
+	line, err := bufio.NewReader(rsp.Body).ReadBytes('\n')
+	require.NoError(t, err)
+
+	var message struct {
+		Result struct {
+			Pair string `json:"pair"`
+			Type string `json:"type"`
+		} `json:"result"`
+	}
+	err = json.Unmarshal(line, &message)
+	require.NoError(t, err)
+	require.Equal(t, util.BTC_USDT, message.Result.Pair)
+	require.Equal(t, feed.SNAPSHOT, message.Result.Type)
+}
+
+func TestGatewayHandlerServesWebsocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpURL, _ := serveGateway(t, mockdb.NewMockStore(ctrl))
+
+	url := "ws" + strings.TrimPrefix(httpURL, "http") + "/ws"
+	conn, rsp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, http.StatusSwitchingProtocols, rsp.StatusCode)
+
+	err = conn.WriteJSON(map[string]string{"op": "subscribe", "channel": feed.BOOK, "pair": util.BTC_USDT})
+	require.NoError(t, err)
+
+	err = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	require.NoError(t, err)
+
+	var message struct {
+		Type    string `json:"type"`
+		Channel string `json:"channel"`
+		Key     string `json:"key"`
+	}
+	err = conn.ReadJSON(&message)
+	require.NoError(t, err)
+	require.Equal(t, feed.SNAPSHOT, message.Type)
+	require.Equal(t, feed.BOOK, message.Channel)
+	require.Equal(t, util.BTC_USDT, message.Key)
+}
+
+func TestGatewayHandlerCreateTrade(t *testing.T) {
+	// the four accounts belong to other users than the admin
+	accounts := []db.Account{
+		{ID: 1, Owner: util.RandomOwner(), Currency: util.BTC},
+		{ID: 2, Owner: util.RandomOwner(), Currency: util.BTC},
+		{ID: 3, Owner: util.RandomOwner(), Currency: util.USDT},
+		{ID: 4, Owner: util.RandomOwner(), Currency: util.USDT},
+	}
+
+	body := map[string]interface{}{
+		"first_from_account_id":  accounts[0].ID,
+		"first_to_account_id":    accounts[1].ID,
+		"first_amount":           "1",
+		"second_from_account_id": accounts[2].ID,
+		"second_to_account_id":   accounts[3].ID,
+		"second_amount":          "100",
+		"pair":                   util.BTC_USDT,
+	}
+
+	testCases := []struct {
+		name          string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, rsp *http.Response)
+	}{
+		{
+			name: "Admin",
+			role: util.ADMIN,
+			buildStubs: func(store *mockdb.MockStore) {
+				for _, account := range accounts {
+					store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				}
+				store.EXPECT().TradeTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TradeTxResult{}, nil)
+			},
+			checkResponse: func(t *testing.T, rsp *http.Response) {
+				require.Equal(t, http.StatusOK, rsp.StatusCode)
+			},
+		},
+		{
+			name: "Trader",
+			role: util.TRADER,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TradeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *http.Response) {
+				require.Equal(t, http.StatusForbidden, rsp.StatusCode)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			url, config := serveGateway(t, store)
+
+			tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+			require.NoError(t, err)
+			accessToken, _, err := tokenMaker.CreateToken(util.RandomOwner(), tc.role, time.Minute)
+			require.NoError(t, err)
+
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/v1/create_trade", bytes.NewReader(data))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+
+			rsp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer rsp.Body.Close()
+			tc.checkResponse(t, rsp)
+		})
+	}
+}
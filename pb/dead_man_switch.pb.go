@@ -0,0 +1,952 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.22.0
+// source: dead_man_switch.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeadManSwitch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username       string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeadManSwitch) Reset() {
+	*x = DeadManSwitch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadManSwitch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadManSwitch) ProtoMessage() {}
+
+func (x *DeadManSwitch) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadManSwitch.ProtoReflect.Descriptor instead.
+func (*DeadManSwitch) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeadManSwitch) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeadManSwitch) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DeadManSwitch) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DeadManSwitch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DeadManSwitch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DeadManSwitchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Action         string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TimeoutSeconds int64                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CanceledOrders int64                  `protobuf:"varint,6,opt,name=canceled_orders,json=canceledOrders,proto3" json:"canceled_orders,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DeadManSwitchEvent) Reset() {
+	*x = DeadManSwitchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadManSwitchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadManSwitchEvent) ProtoMessage() {}
+
+func (x *DeadManSwitchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadManSwitchEvent.ProtoReflect.Descriptor instead.
+func (*DeadManSwitchEvent) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadManSwitchEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadManSwitchEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeadManSwitchEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DeadManSwitchEvent) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DeadManSwitchEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DeadManSwitchEvent) GetCanceledOrders() int64 {
+	if x != nil {
+		return x.CanceledOrders
+	}
+	return 0
+}
+
+func (x *DeadManSwitchEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ArmDeadManSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeoutSeconds int64 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *ArmDeadManSwitchRequest) Reset() {
+	*x = ArmDeadManSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmDeadManSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmDeadManSwitchRequest) ProtoMessage() {}
+
+func (x *ArmDeadManSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmDeadManSwitchRequest.ProtoReflect.Descriptor instead.
+func (*ArmDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArmDeadManSwitchRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type ArmDeadManSwitchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadManSwitch *DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch,omitempty"`
+}
+
+func (x *ArmDeadManSwitchResponse) Reset() {
+	*x = ArmDeadManSwitchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArmDeadManSwitchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmDeadManSwitchResponse) ProtoMessage() {}
+
+func (x *ArmDeadManSwitchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmDeadManSwitchResponse.ProtoReflect.Descriptor instead.
+func (*ArmDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArmDeadManSwitchResponse) GetDeadManSwitch() *DeadManSwitch {
+	if x != nil {
+		return x.DeadManSwitch
+	}
+	return nil
+}
+
+type RefreshDeadManSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshDeadManSwitchRequest) Reset() {
+	*x = RefreshDeadManSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshDeadManSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDeadManSwitchRequest) ProtoMessage() {}
+
+func (x *RefreshDeadManSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDeadManSwitchRequest.ProtoReflect.Descriptor instead.
+func (*RefreshDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{4}
+}
+
+type RefreshDeadManSwitchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadManSwitch *DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch,omitempty"`
+}
+
+func (x *RefreshDeadManSwitchResponse) Reset() {
+	*x = RefreshDeadManSwitchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshDeadManSwitchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDeadManSwitchResponse) ProtoMessage() {}
+
+func (x *RefreshDeadManSwitchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDeadManSwitchResponse.ProtoReflect.Descriptor instead.
+func (*RefreshDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshDeadManSwitchResponse) GetDeadManSwitch() *DeadManSwitch {
+	if x != nil {
+		return x.DeadManSwitch
+	}
+	return nil
+}
+
+type GetDeadManSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeadManSwitchRequest) Reset() {
+	*x = GetDeadManSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadManSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadManSwitchRequest) ProtoMessage() {}
+
+func (x *GetDeadManSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadManSwitchRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{6}
+}
+
+type GetDeadManSwitchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadManSwitch *DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch,omitempty"`
+}
+
+func (x *GetDeadManSwitchResponse) Reset() {
+	*x = GetDeadManSwitchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeadManSwitchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadManSwitchResponse) ProtoMessage() {}
+
+func (x *GetDeadManSwitchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadManSwitchResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDeadManSwitchResponse) GetDeadManSwitch() *DeadManSwitch {
+	if x != nil {
+		return x.DeadManSwitch
+	}
+	return nil
+}
+
+type DisarmDeadManSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisarmDeadManSwitchRequest) Reset() {
+	*x = DisarmDeadManSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisarmDeadManSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisarmDeadManSwitchRequest) ProtoMessage() {}
+
+func (x *DisarmDeadManSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisarmDeadManSwitchRequest.ProtoReflect.Descriptor instead.
+func (*DisarmDeadManSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{8}
+}
+
+type DisarmDeadManSwitchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadManSwitch *DeadManSwitch `protobuf:"bytes,1,opt,name=dead_man_switch,json=deadManSwitch,proto3" json:"dead_man_switch,omitempty"`
+}
+
+func (x *DisarmDeadManSwitchResponse) Reset() {
+	*x = DisarmDeadManSwitchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisarmDeadManSwitchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisarmDeadManSwitchResponse) ProtoMessage() {}
+
+func (x *DisarmDeadManSwitchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisarmDeadManSwitchResponse.ProtoReflect.Descriptor instead.
+func (*DisarmDeadManSwitchResponse) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisarmDeadManSwitchResponse) GetDeadManSwitch() *DeadManSwitch {
+	if x != nil {
+		return x.DeadManSwitch
+	}
+	return nil
+}
+
+type ListDeadManSwitchEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId   int32 `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListDeadManSwitchEventsRequest) Reset() {
+	*x = ListDeadManSwitchEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadManSwitchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadManSwitchEventsRequest) ProtoMessage() {}
+
+func (x *ListDeadManSwitchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadManSwitchEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadManSwitchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDeadManSwitchEventsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListDeadManSwitchEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeadManSwitchEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DeadManSwitchEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListDeadManSwitchEventsResponse) Reset() {
+	*x = ListDeadManSwitchEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dead_man_switch_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadManSwitchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadManSwitchEventsResponse) ProtoMessage() {}
+
+func (x *ListDeadManSwitchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dead_man_switch_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadManSwitchEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadManSwitchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_dead_man_switch_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDeadManSwitchEventsResponse) GetEvents() []*DeadManSwitchEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_dead_man_switch_proto protoreflect.FileDescriptor
+
+var file_dead_man_switch_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x6e, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x02, 0x0a,
+	0x0d, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x17, 0x41, 0x72, 0x6d, 0x44, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x18, 0x41,
+	0x72, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x6d, 0x61, 0x6e, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x65, 0x61,
+	0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x59, 0x0a, 0x1c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x6e, 0x5f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x64,
+	0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x19, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x6e, 0x5f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x0d, 0x64, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x1c,
+	0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1b,
+	0x44, 0x69, 0x73, 0x61, 0x72, 0x6d, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0f, 0x64,
+	0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x6e, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61,
+	0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x56, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x51,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x10, 0x5a, 0x0e, 0x67, 0x6f, 0x2d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dead_man_switch_proto_rawDescOnce sync.Once
+	file_dead_man_switch_proto_rawDescData = file_dead_man_switch_proto_rawDesc
+)
+
+func file_dead_man_switch_proto_rawDescGZIP() []byte {
+	file_dead_man_switch_proto_rawDescOnce.Do(func() {
+		file_dead_man_switch_proto_rawDescData = protoimpl.X.CompressGZIP(file_dead_man_switch_proto_rawDescData)
+	})
+	return file_dead_man_switch_proto_rawDescData
+}
+
+var file_dead_man_switch_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_dead_man_switch_proto_goTypes = []interface{}{
+	(*DeadManSwitch)(nil),                   // 0: pb.DeadManSwitch
+	(*DeadManSwitchEvent)(nil),              // 1: pb.DeadManSwitchEvent
+	(*ArmDeadManSwitchRequest)(nil),         // 2: pb.ArmDeadManSwitchRequest
+	(*ArmDeadManSwitchResponse)(nil),        // 3: pb.ArmDeadManSwitchResponse
+	(*RefreshDeadManSwitchRequest)(nil),     // 4: pb.RefreshDeadManSwitchRequest
+	(*RefreshDeadManSwitchResponse)(nil),    // 5: pb.RefreshDeadManSwitchResponse
+	(*GetDeadManSwitchRequest)(nil),         // 6: pb.GetDeadManSwitchRequest
+	(*GetDeadManSwitchResponse)(nil),        // 7: pb.GetDeadManSwitchResponse
+	(*DisarmDeadManSwitchRequest)(nil),      // 8: pb.DisarmDeadManSwitchRequest
+	(*DisarmDeadManSwitchResponse)(nil),     // 9: pb.DisarmDeadManSwitchResponse
+	(*ListDeadManSwitchEventsRequest)(nil),  // 10: pb.ListDeadManSwitchEventsRequest
+	(*ListDeadManSwitchEventsResponse)(nil), // 11: pb.ListDeadManSwitchEventsResponse
+	(*timestamppb.Timestamp)(nil),           // 12: google.protobuf.Timestamp
+}
+var file_dead_man_switch_proto_depIdxs = []int32{
+	12, // 0: pb.DeadManSwitch.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 1: pb.DeadManSwitch.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 2: pb.DeadManSwitch.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: pb.DeadManSwitchEvent.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 4: pb.DeadManSwitchEvent.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: pb.ArmDeadManSwitchResponse.dead_man_switch:type_name -> pb.DeadManSwitch
+	0,  // 6: pb.RefreshDeadManSwitchResponse.dead_man_switch:type_name -> pb.DeadManSwitch
+	0,  // 7: pb.GetDeadManSwitchResponse.dead_man_switch:type_name -> pb.DeadManSwitch
+	0,  // 8: pb.DisarmDeadManSwitchResponse.dead_man_switch:type_name -> pb.DeadManSwitch
+	1,  // 9: pb.ListDeadManSwitchEventsResponse.events:type_name -> pb.DeadManSwitchEvent
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_dead_man_switch_proto_init() }
+func file_dead_man_switch_proto_init() {
+	if File_dead_man_switch_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dead_man_switch_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadManSwitch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadManSwitchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmDeadManSwitchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArmDeadManSwitchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshDeadManSwitchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshDeadManSwitchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadManSwitchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeadManSwitchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisarmDeadManSwitchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisarmDeadManSwitchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadManSwitchEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dead_man_switch_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadManSwitchEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dead_man_switch_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dead_man_switch_proto_goTypes,
+		DependencyIndexes: file_dead_man_switch_proto_depIdxs,
+		MessageInfos:      file_dead_man_switch_proto_msgTypes,
+	}.Build()
+	File_dead_man_switch_proto = out.File
+	file_dead_man_switch_proto_rawDesc = nil
+	file_dead_man_switch_proto_goTypes = nil
+	file_dead_man_switch_proto_depIdxs = nil
+}
@@ -20,7 +20,57 @@ const _ = grpc.SupportPackageIsVersion7
 type ExchangeClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*CreateTradeResponse, error)
+	GetTrade(ctx context.Context, in *GetTradeRequest, opts ...grpc.CallOption) (*GetTradeResponse, error)
+	ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesResponse, error)
+	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*CreateBidResponse, error)
+	GetBid(ctx context.Context, in *GetBidRequest, opts ...grpc.CallOption) (*GetBidResponse, error)
+	ListBids(ctx context.Context, in *ListBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	UpdateBid(ctx context.Context, in *UpdateBidRequest, opts ...grpc.CallOption) (*UpdateBidResponse, error)
+	ListBidEvents(ctx context.Context, in *ListBidEventsRequest, opts ...grpc.CallOption) (*ListBidEventsResponse, error)
+	CreateAsk(ctx context.Context, in *CreateAskRequest, opts ...grpc.CallOption) (*CreateAskResponse, error)
+	GetAsk(ctx context.Context, in *GetAskRequest, opts ...grpc.CallOption) (*GetAskResponse, error)
+	ListAsks(ctx context.Context, in *ListAsksRequest, opts ...grpc.CallOption) (*ListAsksResponse, error)
+	UpdateAsk(ctx context.Context, in *UpdateAskRequest, opts ...grpc.CallOption) (*UpdateAskResponse, error)
+	ListAskEvents(ctx context.Context, in *ListAskEventsRequest, opts ...grpc.CallOption) (*ListAskEventsResponse, error)
+	CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error)
+	CreateOrderGroup(ctx context.Context, in *CreateOrderGroupRequest, opts ...grpc.CallOption) (*CreateOrderGroupResponse, error)
+	GetOrderGroup(ctx context.Context, in *GetOrderGroupRequest, opts ...grpc.CallOption) (*GetOrderGroupResponse, error)
+	UpdateOrderGroup(ctx context.Context, in *UpdateOrderGroupRequest, opts ...grpc.CallOption) (*UpdateOrderGroupResponse, error)
+	ArmDeadManSwitch(ctx context.Context, in *ArmDeadManSwitchRequest, opts ...grpc.CallOption) (*ArmDeadManSwitchResponse, error)
+	RefreshDeadManSwitch(ctx context.Context, in *RefreshDeadManSwitchRequest, opts ...grpc.CallOption) (*RefreshDeadManSwitchResponse, error)
+	GetDeadManSwitch(ctx context.Context, in *GetDeadManSwitchRequest, opts ...grpc.CallOption) (*GetDeadManSwitchResponse, error)
+	DisarmDeadManSwitch(ctx context.Context, in *DisarmDeadManSwitchRequest, opts ...grpc.CallOption) (*DisarmDeadManSwitchResponse, error)
+	ListDeadManSwitchEvents(ctx context.Context, in *ListDeadManSwitchEventsRequest, opts ...grpc.CallOption) (*ListDeadManSwitchEventsResponse, error)
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	ListPairs(ctx context.Context, in *ListPairsRequest, opts ...grpc.CallOption) (*ListPairsResponse, error)
+	ListFeeTiers(ctx context.Context, in *ListFeeTiersRequest, opts ...grpc.CallOption) (*ListFeeTiersResponse, error)
+	GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error)
+	ListMarketTrades(ctx context.Context, in *ListMarketTradesRequest, opts ...grpc.CallOption) (*ListMarketTradesResponse, error)
+	ListCandles(ctx context.Context, in *ListCandlesRequest, opts ...grpc.CallOption) (*ListCandlesResponse, error)
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*GetTickerResponse, error)
+	ListTickers(ctx context.Context, in *ListTickersRequest, opts ...grpc.CallOption) (*ListTickersResponse, error)
+	CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error)
+	UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error)
+	CreatePair(ctx context.Context, in *CreatePairRequest, opts ...grpc.CallOption) (*CreatePairResponse, error)
+	UpdatePair(ctx context.Context, in *UpdatePairRequest, opts ...grpc.CallOption) (*UpdatePairResponse, error)
+	CreateFeeTier(ctx context.Context, in *CreateFeeTierRequest, opts ...grpc.CallOption) (*CreateFeeTierResponse, error)
+	DeleteFeeTier(ctx context.Context, in *DeleteFeeTierRequest, opts ...grpc.CallOption) (*DeleteFeeTierResponse, error)
+	BackfillCandles(ctx context.Context, in *BackfillCandlesRequest, opts ...grpc.CallOption) (*BackfillCandlesResponse, error)
+	StreamOrderBook(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Exchange_StreamOrderBookClient, error)
+	StreamTrades(ctx context.Context, in *StreamTradesRequest, opts ...grpc.CallOption) (Exchange_StreamTradesClient, error)
+	StreamMyOrders(ctx context.Context, in *StreamMyOrdersRequest, opts ...grpc.CallOption) (Exchange_StreamMyOrdersClient, error)
 }
 
 type exchangeClient struct {
@@ -49,105 +99,1733 @@ func (c *exchangeClient) LoginUser(ctx context.Context, in *LoginUserRequest, op
 	return out, nil
 }
 
+func (c *exchangeClient) RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error) {
+	out := new(RenewAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/RenewAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exchangeClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
 	out := new(UpdateUserResponse)
 	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *exchangeClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error) {
+	out := new(GetTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*CreateTradeResponse, error) {
+	out := new(CreateTradeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetTrade(ctx context.Context, in *GetTradeRequest, opts ...grpc.CallOption) (*GetTradeResponse, error) {
+	out := new(GetTradeResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesResponse, error) {
+	out := new(ListTradesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*CreateBidResponse, error) {
+	out := new(CreateBidResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetBid(ctx context.Context, in *GetBidRequest, opts ...grpc.CallOption) (*GetBidResponse, error) {
+	out := new(GetBidResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListBids(ctx context.Context, in *ListBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdateBid(ctx context.Context, in *UpdateBidRequest, opts ...grpc.CallOption) (*UpdateBidResponse, error) {
+	out := new(UpdateBidResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListBidEvents(ctx context.Context, in *ListBidEventsRequest, opts ...grpc.CallOption) (*ListBidEventsResponse, error) {
+	out := new(ListBidEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListBidEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateAsk(ctx context.Context, in *CreateAskRequest, opts ...grpc.CallOption) (*CreateAskResponse, error) {
+	out := new(CreateAskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetAsk(ctx context.Context, in *GetAskRequest, opts ...grpc.CallOption) (*GetAskResponse, error) {
+	out := new(GetAskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListAsks(ctx context.Context, in *ListAsksRequest, opts ...grpc.CallOption) (*ListAsksResponse, error) {
+	out := new(ListAsksResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListAsks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdateAsk(ctx context.Context, in *UpdateAskRequest, opts ...grpc.CallOption) (*UpdateAskResponse, error) {
+	out := new(UpdateAskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListAskEvents(ctx context.Context, in *ListAskEventsRequest, opts ...grpc.CallOption) (*ListAskEventsResponse, error) {
+	out := new(ListAskEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListAskEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CancelOrders(ctx context.Context, in *CancelOrdersRequest, opts ...grpc.CallOption) (*CancelOrdersResponse, error) {
+	out := new(CancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CancelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateOrderGroup(ctx context.Context, in *CreateOrderGroupRequest, opts ...grpc.CallOption) (*CreateOrderGroupResponse, error) {
+	out := new(CreateOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetOrderGroup(ctx context.Context, in *GetOrderGroupRequest, opts ...grpc.CallOption) (*GetOrderGroupResponse, error) {
+	out := new(GetOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdateOrderGroup(ctx context.Context, in *UpdateOrderGroupRequest, opts ...grpc.CallOption) (*UpdateOrderGroupResponse, error) {
+	out := new(UpdateOrderGroupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ArmDeadManSwitch(ctx context.Context, in *ArmDeadManSwitchRequest, opts ...grpc.CallOption) (*ArmDeadManSwitchResponse, error) {
+	out := new(ArmDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ArmDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) RefreshDeadManSwitch(ctx context.Context, in *RefreshDeadManSwitchRequest, opts ...grpc.CallOption) (*RefreshDeadManSwitchResponse, error) {
+	out := new(RefreshDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/RefreshDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetDeadManSwitch(ctx context.Context, in *GetDeadManSwitchRequest, opts ...grpc.CallOption) (*GetDeadManSwitchResponse, error) {
+	out := new(GetDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) DisarmDeadManSwitch(ctx context.Context, in *DisarmDeadManSwitchRequest, opts ...grpc.CallOption) (*DisarmDeadManSwitchResponse, error) {
+	out := new(DisarmDeadManSwitchResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/DisarmDeadManSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListDeadManSwitchEvents(ctx context.Context, in *ListDeadManSwitchEventsRequest, opts ...grpc.CallOption) (*ListDeadManSwitchEventsResponse, error) {
+	out := new(ListDeadManSwitchEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListDeadManSwitchEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListPairs(ctx context.Context, in *ListPairsRequest, opts ...grpc.CallOption) (*ListPairsResponse, error) {
+	out := new(ListPairsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListFeeTiers(ctx context.Context, in *ListFeeTiersRequest, opts ...grpc.CallOption) (*ListFeeTiersResponse, error) {
+	out := new(ListFeeTiersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListFeeTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error) {
+	out := new(GetOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetOrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListMarketTrades(ctx context.Context, in *ListMarketTradesRequest, opts ...grpc.CallOption) (*ListMarketTradesResponse, error) {
+	out := new(ListMarketTradesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListMarketTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListCandles(ctx context.Context, in *ListCandlesRequest, opts ...grpc.CallOption) (*ListCandlesResponse, error) {
+	out := new(ListCandlesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*GetTickerResponse, error) {
+	out := new(GetTickerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/GetTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) ListTickers(ctx context.Context, in *ListTickersRequest, opts ...grpc.CallOption) (*ListTickersResponse, error) {
+	out := new(ListTickersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/ListTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error) {
+	out := new(CreateCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error) {
+	out := new(UpdateCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdateCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreatePair(ctx context.Context, in *CreatePairRequest, opts ...grpc.CallOption) (*CreatePairResponse, error) {
+	out := new(CreatePairResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreatePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) UpdatePair(ctx context.Context, in *UpdatePairRequest, opts ...grpc.CallOption) (*UpdatePairResponse, error) {
+	out := new(UpdatePairResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/UpdatePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) CreateFeeTier(ctx context.Context, in *CreateFeeTierRequest, opts ...grpc.CallOption) (*CreateFeeTierResponse, error) {
+	out := new(CreateFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/CreateFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) DeleteFeeTier(ctx context.Context, in *DeleteFeeTierRequest, opts ...grpc.CallOption) (*DeleteFeeTierResponse, error) {
+	out := new(DeleteFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/DeleteFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) BackfillCandles(ctx context.Context, in *BackfillCandlesRequest, opts ...grpc.CallOption) (*BackfillCandlesResponse, error) {
+	out := new(BackfillCandlesResponse)
+	err := c.cc.Invoke(ctx, "/pb.Exchange/BackfillCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeClient) StreamOrderBook(ctx context.Context, in *StreamOrderBookRequest, opts ...grpc.CallOption) (Exchange_StreamOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Exchange_ServiceDesc.Streams[0], "/pb.Exchange/StreamOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exchangeStreamOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Exchange_StreamOrderBookClient interface {
+	Recv() (*OrderBookEvent, error)
+	grpc.ClientStream
+}
+
+type exchangeStreamOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *exchangeStreamOrderBookClient) Recv() (*OrderBookEvent, error) {
+	m := new(OrderBookEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exchangeClient) StreamTrades(ctx context.Context, in *StreamTradesRequest, opts ...grpc.CallOption) (Exchange_StreamTradesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Exchange_ServiceDesc.Streams[1], "/pb.Exchange/StreamTrades", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exchangeStreamTradesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Exchange_StreamTradesClient interface {
+	Recv() (*TradeEvent, error)
+	grpc.ClientStream
+}
+
+type exchangeStreamTradesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exchangeStreamTradesClient) Recv() (*TradeEvent, error) {
+	m := new(TradeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exchangeClient) StreamMyOrders(ctx context.Context, in *StreamMyOrdersRequest, opts ...grpc.CallOption) (Exchange_StreamMyOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Exchange_ServiceDesc.Streams[2], "/pb.Exchange/StreamMyOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exchangeStreamMyOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Exchange_StreamMyOrdersClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type exchangeStreamMyOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *exchangeStreamMyOrdersClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExchangeServer is the server API for Exchange service.
+// All implementations must embed UnimplementedExchangeServer
+// for forward compatibility
+type ExchangeServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	CreateTrade(context.Context, *CreateTradeRequest) (*CreateTradeResponse, error)
+	GetTrade(context.Context, *GetTradeRequest) (*GetTradeResponse, error)
+	ListTrades(context.Context, *ListTradesRequest) (*ListTradesResponse, error)
+	CreateBid(context.Context, *CreateBidRequest) (*CreateBidResponse, error)
+	GetBid(context.Context, *GetBidRequest) (*GetBidResponse, error)
+	ListBids(context.Context, *ListBidsRequest) (*ListBidsResponse, error)
+	UpdateBid(context.Context, *UpdateBidRequest) (*UpdateBidResponse, error)
+	ListBidEvents(context.Context, *ListBidEventsRequest) (*ListBidEventsResponse, error)
+	CreateAsk(context.Context, *CreateAskRequest) (*CreateAskResponse, error)
+	GetAsk(context.Context, *GetAskRequest) (*GetAskResponse, error)
+	ListAsks(context.Context, *ListAsksRequest) (*ListAsksResponse, error)
+	UpdateAsk(context.Context, *UpdateAskRequest) (*UpdateAskResponse, error)
+	ListAskEvents(context.Context, *ListAskEventsRequest) (*ListAskEventsResponse, error)
+	CancelOrders(context.Context, *CancelOrdersRequest) (*CancelOrdersResponse, error)
+	CreateOrderGroup(context.Context, *CreateOrderGroupRequest) (*CreateOrderGroupResponse, error)
+	GetOrderGroup(context.Context, *GetOrderGroupRequest) (*GetOrderGroupResponse, error)
+	UpdateOrderGroup(context.Context, *UpdateOrderGroupRequest) (*UpdateOrderGroupResponse, error)
+	ArmDeadManSwitch(context.Context, *ArmDeadManSwitchRequest) (*ArmDeadManSwitchResponse, error)
+	RefreshDeadManSwitch(context.Context, *RefreshDeadManSwitchRequest) (*RefreshDeadManSwitchResponse, error)
+	GetDeadManSwitch(context.Context, *GetDeadManSwitchRequest) (*GetDeadManSwitchResponse, error)
+	DisarmDeadManSwitch(context.Context, *DisarmDeadManSwitchRequest) (*DisarmDeadManSwitchResponse, error)
+	ListDeadManSwitchEvents(context.Context, *ListDeadManSwitchEventsRequest) (*ListDeadManSwitchEventsResponse, error)
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	ListPairs(context.Context, *ListPairsRequest) (*ListPairsResponse, error)
+	ListFeeTiers(context.Context, *ListFeeTiersRequest) (*ListFeeTiersResponse, error)
+	GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error)
+	ListMarketTrades(context.Context, *ListMarketTradesRequest) (*ListMarketTradesResponse, error)
+	ListCandles(context.Context, *ListCandlesRequest) (*ListCandlesResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*GetTickerResponse, error)
+	ListTickers(context.Context, *ListTickersRequest) (*ListTickersResponse, error)
+	CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error)
+	UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error)
+	CreatePair(context.Context, *CreatePairRequest) (*CreatePairResponse, error)
+	UpdatePair(context.Context, *UpdatePairRequest) (*UpdatePairResponse, error)
+	CreateFeeTier(context.Context, *CreateFeeTierRequest) (*CreateFeeTierResponse, error)
+	DeleteFeeTier(context.Context, *DeleteFeeTierRequest) (*DeleteFeeTierResponse, error)
+	BackfillCandles(context.Context, *BackfillCandlesRequest) (*BackfillCandlesResponse, error)
+	StreamOrderBook(*StreamOrderBookRequest, Exchange_StreamOrderBookServer) error
+	StreamTrades(*StreamTradesRequest, Exchange_StreamTradesServer) error
+	StreamMyOrders(*StreamMyOrdersRequest, Exchange_StreamMyOrdersServer) error
+	mustEmbedUnimplementedExchangeServer()
+}
+
+// UnimplementedExchangeServer must be embedded to have forward compatible implementations.
+type UnimplementedExchangeServer struct {
+}
+
+func (UnimplementedExchangeServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedExchangeServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedExchangeServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+}
+func (UnimplementedExchangeServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedExchangeServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedExchangeServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedExchangeServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedExchangeServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedExchangeServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedExchangeServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedExchangeServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedExchangeServer) GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedExchangeServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedExchangeServer) CreateTrade(context.Context, *CreateTradeRequest) (*CreateTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+}
+func (UnimplementedExchangeServer) GetTrade(context.Context, *GetTradeRequest) (*GetTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrade not implemented")
+}
+func (UnimplementedExchangeServer) ListTrades(context.Context, *ListTradesRequest) (*ListTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrades not implemented")
+}
+func (UnimplementedExchangeServer) CreateBid(context.Context, *CreateBidRequest) (*CreateBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+}
+func (UnimplementedExchangeServer) GetBid(context.Context, *GetBidRequest) (*GetBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBid not implemented")
+}
+func (UnimplementedExchangeServer) ListBids(context.Context, *ListBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBids not implemented")
+}
+func (UnimplementedExchangeServer) UpdateBid(context.Context, *UpdateBidRequest) (*UpdateBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBid not implemented")
+}
+func (UnimplementedExchangeServer) ListBidEvents(context.Context, *ListBidEventsRequest) (*ListBidEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidEvents not implemented")
+}
+func (UnimplementedExchangeServer) CreateAsk(context.Context, *CreateAskRequest) (*CreateAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAsk not implemented")
+}
+func (UnimplementedExchangeServer) GetAsk(context.Context, *GetAskRequest) (*GetAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAsk not implemented")
+}
+func (UnimplementedExchangeServer) ListAsks(context.Context, *ListAsksRequest) (*ListAsksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAsks not implemented")
+}
+func (UnimplementedExchangeServer) UpdateAsk(context.Context, *UpdateAskRequest) (*UpdateAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsk not implemented")
+}
+func (UnimplementedExchangeServer) ListAskEvents(context.Context, *ListAskEventsRequest) (*ListAskEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAskEvents not implemented")
+}
+func (UnimplementedExchangeServer) CancelOrders(context.Context, *CancelOrdersRequest) (*CancelOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrders not implemented")
+}
+func (UnimplementedExchangeServer) CreateOrderGroup(context.Context, *CreateOrderGroupRequest) (*CreateOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderGroup not implemented")
+}
+func (UnimplementedExchangeServer) GetOrderGroup(context.Context, *GetOrderGroupRequest) (*GetOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderGroup not implemented")
+}
+func (UnimplementedExchangeServer) UpdateOrderGroup(context.Context, *UpdateOrderGroupRequest) (*UpdateOrderGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderGroup not implemented")
+}
+func (UnimplementedExchangeServer) ArmDeadManSwitch(context.Context, *ArmDeadManSwitchRequest) (*ArmDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArmDeadManSwitch not implemented")
+}
+func (UnimplementedExchangeServer) RefreshDeadManSwitch(context.Context, *RefreshDeadManSwitchRequest) (*RefreshDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDeadManSwitch not implemented")
+}
+func (UnimplementedExchangeServer) GetDeadManSwitch(context.Context, *GetDeadManSwitchRequest) (*GetDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadManSwitch not implemented")
+}
+func (UnimplementedExchangeServer) DisarmDeadManSwitch(context.Context, *DisarmDeadManSwitchRequest) (*DisarmDeadManSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisarmDeadManSwitch not implemented")
+}
+func (UnimplementedExchangeServer) ListDeadManSwitchEvents(context.Context, *ListDeadManSwitchEventsRequest) (*ListDeadManSwitchEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadManSwitchEvents not implemented")
+}
+func (UnimplementedExchangeServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedExchangeServer) ListPairs(context.Context, *ListPairsRequest) (*ListPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPairs not implemented")
+}
+func (UnimplementedExchangeServer) ListFeeTiers(context.Context, *ListFeeTiersRequest) (*ListFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeTiers not implemented")
+}
+func (UnimplementedExchangeServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedExchangeServer) ListMarketTrades(context.Context, *ListMarketTradesRequest) (*ListMarketTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketTrades not implemented")
+}
+func (UnimplementedExchangeServer) ListCandles(context.Context, *ListCandlesRequest) (*ListCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCandles not implemented")
+}
+func (UnimplementedExchangeServer) GetTicker(context.Context, *GetTickerRequest) (*GetTickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (UnimplementedExchangeServer) ListTickers(context.Context, *ListTickersRequest) (*ListTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickers not implemented")
+}
+func (UnimplementedExchangeServer) CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCurrency not implemented")
+}
+func (UnimplementedExchangeServer) UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCurrency not implemented")
+}
+func (UnimplementedExchangeServer) CreatePair(context.Context, *CreatePairRequest) (*CreatePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePair not implemented")
+}
+func (UnimplementedExchangeServer) UpdatePair(context.Context, *UpdatePairRequest) (*UpdatePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePair not implemented")
+}
+func (UnimplementedExchangeServer) CreateFeeTier(context.Context, *CreateFeeTierRequest) (*CreateFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeeTier not implemented")
+}
+func (UnimplementedExchangeServer) DeleteFeeTier(context.Context, *DeleteFeeTierRequest) (*DeleteFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeTier not implemented")
+}
+func (UnimplementedExchangeServer) BackfillCandles(context.Context, *BackfillCandlesRequest) (*BackfillCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCandles not implemented")
+}
+func (UnimplementedExchangeServer) StreamOrderBook(*StreamOrderBookRequest, Exchange_StreamOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderBook not implemented")
+}
+func (UnimplementedExchangeServer) StreamTrades(*StreamTradesRequest, Exchange_StreamTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTrades not implemented")
+}
+func (UnimplementedExchangeServer) StreamMyOrders(*StreamMyOrdersRequest, Exchange_StreamMyOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMyOrders not implemented")
+}
+func (UnimplementedExchangeServer) mustEmbedUnimplementedExchangeServer() {}
+
+// UnsafeExchangeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeServer will
+// result in compilation errors.
+type UnsafeExchangeServer interface {
+	mustEmbedUnimplementedExchangeServer()
+}
+
+func RegisterExchangeServer(s grpc.ServiceRegistrar, srv ExchangeServer) {
+	s.RegisterService(&Exchange_ServiceDesc, srv)
+}
+
+func _Exchange_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/LoginUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).RenewAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/RenewAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateTrade(ctx, req.(*CreateTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetTrade(ctx, req.(*GetTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListTrades(ctx, req.(*ListTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateBid(ctx, req.(*CreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetBid(ctx, req.(*GetBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListBids(ctx, req.(*ListBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_UpdateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateBid(ctx, req.(*UpdateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListBidEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListBidEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListBidEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListBidEvents(ctx, req.(*ListBidEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateAsk(ctx, req.(*CreateAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetAsk(ctx, req.(*GetAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAsksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListAsks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListAsks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListAsks(ctx, req.(*ListAsksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_UpdateAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateAsk(ctx, req.(*UpdateAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListAskEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAskEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListAskEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListAskEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListAskEvents(ctx, req.(*ListAskEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CancelOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CancelOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CancelOrders(ctx, req.(*CancelOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_CreateOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateOrderGroup(ctx, req.(*CreateOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetOrderGroup(ctx, req.(*GetOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_UpdateOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateOrderGroup(ctx, req.(*UpdateOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ArmDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArmDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ArmDeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ArmDeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ArmDeadManSwitch(ctx, req.(*ArmDeadManSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_RefreshDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).RefreshDeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/RefreshDeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).RefreshDeadManSwitch(ctx, req.(*RefreshDeadManSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetDeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetDeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetDeadManSwitch(ctx, req.(*GetDeadManSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_DisarmDeadManSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisarmDeadManSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).DisarmDeadManSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/DisarmDeadManSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).DisarmDeadManSwitch(ctx, req.(*DisarmDeadManSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListDeadManSwitchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadManSwitchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListDeadManSwitchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListDeadManSwitchEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListDeadManSwitchEvents(ctx, req.(*ListDeadManSwitchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListPairs(ctx, req.(*ListPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListFeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListFeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListFeeTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListFeeTiers(ctx, req.(*ListFeeTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchange_ListMarketTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListMarketTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListMarketTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListMarketTrades(ctx, req.(*ListMarketTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ExchangeServer is the server API for Exchange service.
-// All implementations must embed UnimplementedExchangeServer
-// for forward compatibility
-type ExchangeServer interface {
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	mustEmbedUnimplementedExchangeServer()
+func _Exchange_ListCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListCandles(ctx, req.(*ListCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedExchangeServer must be embedded to have forward compatible implementations.
-type UnimplementedExchangeServer struct {
+func _Exchange_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedExchangeServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _Exchange_ListTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).ListTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/ListTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).ListTickers(ctx, req.(*ListTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExchangeServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _Exchange_CreateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreateCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreateCurrency(ctx, req.(*CreateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExchangeServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func _Exchange_UpdateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdateCurrency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdateCurrency(ctx, req.(*UpdateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedExchangeServer) mustEmbedUnimplementedExchangeServer() {}
 
-// UnsafeExchangeServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ExchangeServer will
-// result in compilation errors.
-type UnsafeExchangeServer interface {
-	mustEmbedUnimplementedExchangeServer()
+func _Exchange_CreatePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).CreatePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/CreatePair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).CreatePair(ctx, req.(*CreatePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterExchangeServer(s grpc.ServiceRegistrar, srv ExchangeServer) {
-	s.RegisterService(&Exchange_ServiceDesc, srv)
+func _Exchange_UpdatePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServer).UpdatePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Exchange/UpdatePair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServer).UpdatePair(ctx, req.(*UpdatePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Exchange_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Exchange_CreateFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeeTierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExchangeServer).CreateUser(ctx, in)
+		return srv.(ExchangeServer).CreateFeeTier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Exchange/CreateUser",
+		FullMethod: "/pb.Exchange/CreateFeeTier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExchangeServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(ExchangeServer).CreateFeeTier(ctx, req.(*CreateFeeTierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Exchange_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _Exchange_DeleteFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeeTierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExchangeServer).LoginUser(ctx, in)
+		return srv.(ExchangeServer).DeleteFeeTier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Exchange/LoginUser",
+		FullMethod: "/pb.Exchange/DeleteFeeTier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExchangeServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(ExchangeServer).DeleteFeeTier(ctx, req.(*DeleteFeeTierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Exchange_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Exchange_BackfillCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExchangeServer).UpdateUser(ctx, in)
+		return srv.(ExchangeServer).BackfillCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Exchange/UpdateUser",
+		FullMethod: "/pb.Exchange/BackfillCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExchangeServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(ExchangeServer).BackfillCandles(ctx, req.(*BackfillCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Exchange_StreamOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeServer).StreamOrderBook(m, &exchangeStreamOrderBookServer{stream})
+}
+
+type Exchange_StreamOrderBookServer interface {
+	Send(*OrderBookEvent) error
+	grpc.ServerStream
+}
+
+type exchangeStreamOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *exchangeStreamOrderBookServer) Send(m *OrderBookEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Exchange_StreamTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeServer).StreamTrades(m, &exchangeStreamTradesServer{stream})
+}
+
+type Exchange_StreamTradesServer interface {
+	Send(*TradeEvent) error
+	grpc.ServerStream
+}
+
+type exchangeStreamTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exchangeStreamTradesServer) Send(m *TradeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Exchange_StreamMyOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMyOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExchangeServer).StreamMyOrders(m, &exchangeStreamMyOrdersServer{stream})
+}
+
+type Exchange_StreamMyOrdersServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type exchangeStreamMyOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *exchangeStreamMyOrdersServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Exchange_ServiceDesc is the grpc.ServiceDesc for Exchange service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,11 +1841,215 @@ var Exchange_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginUser",
 			Handler:    _Exchange_LoginUser_Handler,
 		},
+		{
+			MethodName: "RenewAccessToken",
+			Handler:    _Exchange_RenewAccessToken_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _Exchange_UpdateUser_Handler,
 		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Exchange_DeleteUser_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _Exchange_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _Exchange_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Exchange_ListAccounts_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _Exchange_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Exchange_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _Exchange_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _Exchange_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _Exchange_ListTransfers_Handler,
+		},
+		{
+			MethodName: "CreateTrade",
+			Handler:    _Exchange_CreateTrade_Handler,
+		},
+		{
+			MethodName: "GetTrade",
+			Handler:    _Exchange_GetTrade_Handler,
+		},
+		{
+			MethodName: "ListTrades",
+			Handler:    _Exchange_ListTrades_Handler,
+		},
+		{
+			MethodName: "CreateBid",
+			Handler:    _Exchange_CreateBid_Handler,
+		},
+		{
+			MethodName: "GetBid",
+			Handler:    _Exchange_GetBid_Handler,
+		},
+		{
+			MethodName: "ListBids",
+			Handler:    _Exchange_ListBids_Handler,
+		},
+		{
+			MethodName: "UpdateBid",
+			Handler:    _Exchange_UpdateBid_Handler,
+		},
+		{
+			MethodName: "ListBidEvents",
+			Handler:    _Exchange_ListBidEvents_Handler,
+		},
+		{
+			MethodName: "CreateAsk",
+			Handler:    _Exchange_CreateAsk_Handler,
+		},
+		{
+			MethodName: "GetAsk",
+			Handler:    _Exchange_GetAsk_Handler,
+		},
+		{
+			MethodName: "ListAsks",
+			Handler:    _Exchange_ListAsks_Handler,
+		},
+		{
+			MethodName: "UpdateAsk",
+			Handler:    _Exchange_UpdateAsk_Handler,
+		},
+		{
+			MethodName: "ListAskEvents",
+			Handler:    _Exchange_ListAskEvents_Handler,
+		},
+		{
+			MethodName: "CancelOrders",
+			Handler:    _Exchange_CancelOrders_Handler,
+		},
+		{
+			MethodName: "CreateOrderGroup",
+			Handler:    _Exchange_CreateOrderGroup_Handler,
+		},
+		{
+			MethodName: "GetOrderGroup",
+			Handler:    _Exchange_GetOrderGroup_Handler,
+		},
+		{
+			MethodName: "UpdateOrderGroup",
+			Handler:    _Exchange_UpdateOrderGroup_Handler,
+		},
+		{
+			MethodName: "ArmDeadManSwitch",
+			Handler:    _Exchange_ArmDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "RefreshDeadManSwitch",
+			Handler:    _Exchange_RefreshDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "GetDeadManSwitch",
+			Handler:    _Exchange_GetDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "DisarmDeadManSwitch",
+			Handler:    _Exchange_DisarmDeadManSwitch_Handler,
+		},
+		{
+			MethodName: "ListDeadManSwitchEvents",
+			Handler:    _Exchange_ListDeadManSwitchEvents_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _Exchange_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "ListPairs",
+			Handler:    _Exchange_ListPairs_Handler,
+		},
+		{
+			MethodName: "ListFeeTiers",
+			Handler:    _Exchange_ListFeeTiers_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _Exchange_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "ListMarketTrades",
+			Handler:    _Exchange_ListMarketTrades_Handler,
+		},
+		{
+			MethodName: "ListCandles",
+			Handler:    _Exchange_ListCandles_Handler,
+		},
+		{
+			MethodName: "GetTicker",
+			Handler:    _Exchange_GetTicker_Handler,
+		},
+		{
+			MethodName: "ListTickers",
+			Handler:    _Exchange_ListTickers_Handler,
+		},
+		{
+			MethodName: "CreateCurrency",
+			Handler:    _Exchange_CreateCurrency_Handler,
+		},
+		{
+			MethodName: "UpdateCurrency",
+			Handler:    _Exchange_UpdateCurrency_Handler,
+		},
+		{
+			MethodName: "CreatePair",
+			Handler:    _Exchange_CreatePair_Handler,
+		},
+		{
+			MethodName: "UpdatePair",
+			Handler:    _Exchange_UpdatePair_Handler,
+		},
+		{
+			MethodName: "CreateFeeTier",
+			Handler:    _Exchange_CreateFeeTier_Handler,
+		},
+		{
+			MethodName: "DeleteFeeTier",
+			Handler:    _Exchange_DeleteFeeTier_Handler,
+		},
+		{
+			MethodName: "BackfillCandles",
+			Handler:    _Exchange_BackfillCandles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderBook",
+			Handler:       _Exchange_StreamOrderBook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTrades",
+			Handler:       _Exchange_StreamTrades_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamMyOrders",
+			Handler:       _Exchange_StreamMyOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_exchange.proto",
 }
@@ -0,0 +1,133 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"sort"
+	"sync"
+)
+
+// Registry caches the currencies and pairs listed in the database,
+// so validating a request doesn't need a query
+type Registry struct {
+	mu         sync.RWMutex
+	currencies map[string]db.Currency
+	pairs      map[string]db.Pair
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		currencies: make(map[string]db.Currency),
+		pairs:      make(map[string]db.Pair),
+	}
+}
+
+// Load replaces the cached currencies and pairs with the ones in the database
+func (registry *Registry) Load(ctx context.Context, store db.Store) error {
+	currencies, err := store.ListCurrencies(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot list currencies: %w", err)
+	}
+
+	pairs, err := store.ListPairs(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot list pairs: %w", err)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.currencies = make(map[string]db.Currency, len(currencies))
+	for _, currency := range currencies {
+		registry.currencies[currency.Code] = currency
+	}
+
+	registry.pairs = make(map[string]db.Pair, len(pairs))
+	for _, pair := range pairs {
+		registry.pairs[pair.Symbol] = pair
+	}
+	return nil
+}
+
+// SetCurrency caches a currency after it was created or updated in the database
+func (registry *Registry) SetCurrency(currency db.Currency) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.currencies[currency.Code] = currency
+}
+
+// SetPair caches a pair after it was created or updated in the database
+func (registry *Registry) SetPair(pair db.Pair) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.pairs[pair.Symbol] = pair
+}
+
+// Currency returns a listed currency
+func (registry *Registry) Currency(code string) (db.Currency, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	currency, ok := registry.currencies[code]
+	return currency, ok
+}
+
+// Pair returns a listed pair, whatever its trading status
+func (registry *Registry) Pair(symbol string) (db.Pair, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	pair, ok := registry.pairs[symbol]
+	return pair, ok
+}
+
+// Currencies returns every listed currency in code order
+func (registry *Registry) Currencies() []db.Currency {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	currencies := make([]db.Currency, 0, len(registry.currencies))
+	for _, currency := range registry.currencies {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool { return currencies[i].Code < currencies[j].Code })
+	return currencies
+}
+
+// Pairs returns every listed pair in symbol order
+func (registry *Registry) Pairs() []db.Pair {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	pairs := make([]db.Pair, 0, len(registry.pairs))
+	for _, pair := range registry.pairs {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Symbol < pairs[j].Symbol })
+	return pairs
+}
+
+// IsActiveCurrency returns true if the currency is listed and active
+func (registry *Registry) IsActiveCurrency(code string) bool {
+	currency, ok := registry.Currency(code)
+	return ok && currency.Status == util.ACTIVE
+}
+
+// IsActivePair returns true if the pair is listed, active and both of its currencies are active,
+// so new orders can be placed on it
+func (registry *Registry) IsActivePair(symbol string) bool {
+	pair, ok := registry.Pair(symbol)
+	return ok && pair.Status == util.ACTIVE && registry.IsActiveCurrency(pair.Base) && registry.IsActiveCurrency(pair.Quote)
+}
+
+// IsListedPair returns true if the pair is listed, whatever its trading status,
+// so the orders already on it can still be looked up and canceled
+func (registry *Registry) IsListedPair(symbol string) bool {
+	_, ok := registry.Pair(symbol)
+	return ok
+}
@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"context"
+	"database/sql"
+	mockdb "go-exchange/db/mock"
+	db "go-exchange/db/sqlc"
+	"go-exchange/util"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currencies := []db.Currency{
+		{Code: util.BTC, Decimals: 8, Status: util.ACTIVE},
+		{Code: util.ETH, Decimals: 18, Status: util.PAUSED},
+		{Code: util.USDT, Decimals: 6, Status: util.ACTIVE},
+	}
+	pairs := []db.Pair{
+		{Symbol: util.BTC_USDT, Base: util.BTC, Quote: util.USDT, Status: util.ACTIVE},
+		{Symbol: util.ETH_BTC, Base: util.ETH, Quote: util.BTC, Status: util.ACTIVE},
+		{Symbol: util.ETH_USDT, Base: util.ETH, Quote: util.USDT, Status: util.DELISTED},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListCurrencies(gomock.Any()).Times(1).Return(currencies, nil)
+	store.EXPECT().ListPairs(gomock.Any()).Times(1).Return(pairs, nil)
+
+	registry := NewRegistry()
+	err := registry.Load(context.Background(), store)
+	require.NoError(t, err)
+	require.Equal(t, currencies, registry.Currencies())
+	require.Equal(t, pairs, registry.Pairs())
+
+	require.True(t, registry.IsActiveCurrency(util.BTC))
+	require.False(t, registry.IsActiveCurrency(util.ETH))
+	require.False(t, registry.IsActiveCurrency(util.SOL))
+
+	// a pair with a paused currency takes no new orders
+	require.True(t, registry.IsActivePair(util.BTC_USDT))
+	require.False(t, registry.IsActivePair(util.ETH_BTC))
+	require.False(t, registry.IsActivePair(util.ETH_USDT))
+	require.False(t, registry.IsActivePair(util.SOL_USDT))
+
+	require.True(t, registry.IsListedPair(util.ETH_USDT))
+	require.False(t, registry.IsListedPair(util.SOL_USDT))
+
+	registry.SetPair(db.Pair{Symbol: util.SOL_USDT, Base: util.SOL, Quote: util.USDT, Status: util.ACTIVE})
+	require.True(t, registry.IsListedPair(util.SOL_USDT))
+	require.False(t, registry.IsActivePair(util.SOL_USDT))
+
+	registry.SetCurrency(db.Currency{Code: util.SOL, Decimals: 9, Status: util.ACTIVE})
+	require.True(t, registry.IsActivePair(util.SOL_USDT))
+}
+
+func TestLoadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListCurrencies(gomock.Any()).Times(1).Return([]db.Currency{}, nil)
+	store.EXPECT().ListPairs(gomock.Any()).Times(1).Return([]db.Pair{}, sql.ErrConnDone)
+
+	registry := NewRegistry()
+	registry.SetPair(db.Pair{Symbol: util.BTC_USDT, Status: util.ACTIVE})
+
+	err := registry.Load(context.Background(), store)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+	require.True(t, registry.IsListedPair(util.BTC_USDT))
+}
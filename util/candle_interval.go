@@ -0,0 +1,40 @@
+package util
+
+import "time"
+
+// Constants for all supported candle intervals
+const (
+	ONE_MINUTE      = "1m"
+	FIVE_MINUTES    = "5m"
+	FIFTEEN_MINUTES = "15m"
+	ONE_HOUR        = "1h"
+	FOUR_HOURS      = "4h"
+	ONE_DAY         = "1d"
+)
+
+// CandleIntervals lists the supported candle intervals, shortest first
+var CandleIntervals = []string{ONE_MINUTE, FIVE_MINUTES, FIFTEEN_MINUTES, ONE_HOUR, FOUR_HOURS, ONE_DAY}
+
+// IsSupportedCandleInterval returns true if the candle interval is supported
+func IsSupportedCandleInterval(interval string) bool {
+	return CandleIntervalDuration(interval) > 0
+}
+
+// CandleIntervalDuration returns the duration of a candle interval, or zero if it isn't supported
+func CandleIntervalDuration(interval string) time.Duration {
+	switch interval {
+	case ONE_MINUTE:
+		return time.Minute
+	case FIVE_MINUTES:
+		return 5 * time.Minute
+	case FIFTEEN_MINUTES:
+		return 15 * time.Minute
+	case ONE_HOUR:
+		return time.Hour
+	case FOUR_HOURS:
+		return 4 * time.Hour
+	case ONE_DAY:
+		return 24 * time.Hour
+	}
+	return 0
+}
@@ -9,16 +9,18 @@ import (
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	TokenType            string        `mapstructure:"TOKEN_TYPE"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	Environment                string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                   string        `mapstructure:"DB_DRIVER"`
+	DBSource                   string        `mapstructure:"DB_SOURCE"`
+	HTTPServerAddress          string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress          string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	MigrationURL               string        `mapstructure:"MIGRATION_URL"`
+	TokenType                  string        `mapstructure:"TOKEN_TYPE"`
+	TokenSymmetricKey          string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration        time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration       time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	ExpirySweepInterval        time.Duration `mapstructure:"EXPIRY_SWEEP_INTERVAL"`
+	DeadManSwitchSweepInterval time.Duration `mapstructure:"DEAD_MAN_SWITCH_SWEEP_INTERVAL"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -2,7 +2,7 @@ package util
 
 import "strings"
 
-// Constants for all supported currencies
+// Constants for the currencies seeded into the registry
 const (
 	BRL = "BRL"
 	CAD = "CAD"
@@ -17,7 +17,7 @@ const (
 	USDT  = "USDT"
 )
 
-// Constants for all supported pairs
+// Constants for the pairs seeded into the registry
 const (
 	USDT_BRL = "USDT/BRL"
 	USDT_CAD = "USDT/CAD"
@@ -38,24 +38,6 @@ const (
 	SOL_ETH = "SOL/ETH"
 )
 
-// IsSupportedCurrency returns true if the currency is supported
-func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case BRL, CAD, EUR, JPY, USD, BTC, ETH, MATIC, SOL, USDT:
-		return true
-	}
-	return false
-}
-
-// IsSupportedPair returns true if the pair is supported
-func IsSupportedPair(pair string) bool {
-	switch pair {
-	case USDT_BRL, USDT_CAD, USDT_EUR, USDT_JPY, USDT_USD, BTC_USDT, ETH_USDT, MATIC_USDT, SOL_USDT, ETH_BTC, MATIC_BTC, SOL_BTC, MATIC_ETH, SOL_ETH:
-		return true
-	}
-	return false
-}
-
 // CurrenciesFromPair returns both currencies from a given pair
 func CurrenciesFromPair(pair string) (string, string) {
 	currencies := strings.Split(pair, "/")
@@ -0,0 +1,9 @@
+package util
+
+// Constants for the actions audited on a dead man's switch
+const (
+	ARMED     = "armed"
+	REFRESHED = "refreshed"
+	DISARMED  = "disarmed"
+	FIRED     = "fired"
+)
@@ -0,0 +1,16 @@
+package util
+
+// Constants for the trading status of currencies and pairs, besides ACTIVE
+const (
+	PAUSED   = "paused"
+	DELISTED = "delisted"
+)
+
+// IsSupportedMarketStatus returns true if the trading status is supported
+func IsSupportedMarketStatus(status string) bool {
+	switch status {
+	case ACTIVE, PAUSED, DELISTED:
+		return true
+	}
+	return false
+}
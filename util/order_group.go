@@ -0,0 +1,32 @@
+package util
+
+// Constants for all supported order group types
+const (
+	OCO     = "oco"     // one cancels other
+	BRACKET = "bracket" // entry with take profit and stop loss
+)
+
+// Constants for the legs of an order group
+const (
+	ENTRY_LEG       = "entry"
+	TAKE_PROFIT_LEG = "take_profit"
+	STOP_LOSS_LEG   = "stop_loss"
+)
+
+// IsSupportedOrderGroupType returns true if the order group type is supported
+func IsSupportedOrderGroupType(groupType string) bool {
+	switch groupType {
+	case OCO, BRACKET:
+		return true
+	}
+	return false
+}
+
+// IsContingentLeg returns true if the leg is one of the pair that cancels the other
+func IsContingentLeg(leg string) bool {
+	switch leg {
+	case TAKE_PROFIT_LEG, STOP_LOSS_LEG:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package util
+
+// Constants for all supported order types
+const (
+	LIMIT       = "limit"
+	MARKET      = "market"
+	STOP_LIMIT  = "stop_limit"
+	STOP_MARKET = "stop_market"
+)
+
+// IsSupportedOrderType returns true if the order type is supported
+func IsSupportedOrderType(orderType string) bool {
+	switch orderType {
+	case LIMIT, MARKET, STOP_LIMIT, STOP_MARKET:
+		return true
+	}
+	return false
+}
+
+// IsStopOrderType returns true if orders of the type wait for a trigger price before they are matched
+func IsStopOrderType(orderType string) bool {
+	switch orderType {
+	case STOP_LIMIT, STOP_MARKET:
+		return true
+	}
+	return false
+}
+
+// OrderStatus returns the status a new order of the type is created with.
+// Stop orders are pending until they are triggered
+func OrderStatus(orderType string) string {
+	if IsStopOrderType(orderType) {
+		return PENDING
+	}
+	return ACTIVE
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"go-exchange/decimal"
 	"math/rand"
 	"strings"
 )
@@ -9,7 +10,7 @@ import (
 const alphabet = "abcdefghijklmnopqrstuvwxyz"
 var currencies = [...]string{BRL, CAD, EUR, JPY, USD}
 var pairs = [...]string{USDT_BRL, USDT_CAD, USDT_EUR, USDT_JPY, USDT_USD, BTC_USDT, ETH_USDT, MATIC_USDT, SOL_USDT, ETH_BTC, MATIC_BTC, SOL_BTC, MATIC_ETH, SOL_ETH}
-var status = [...]string{ACTIVE, COMPLETED, CANCELED}
+var status = [...]string{INACTIVE, PENDING, ACTIVE, PARTIALLY_FILLED, COMPLETED, CANCELED, EXPIRED}
 
 // RandomInt generates a random integer between min and max
 func RandomInt(min, max int64) int64 {
@@ -40,8 +41,8 @@ func RandomEmail() string {
 }
 
 // RandomMoney generates a random amount of money
-func RandomMoney() int64 {
-	return RandomInt(0, 1000)
+func RandomMoney() decimal.Decimal {
+	return decimal.NewFromInt(RandomInt(0, 1000))
 }
 
 // RandomCurrency generates a random currency code
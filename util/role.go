@@ -0,0 +1,7 @@
+package util
+
+// Constants for the roles of a user
+const (
+	TRADER = "trader"
+	ADMIN  = "admin"
+)
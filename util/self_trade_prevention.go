@@ -0,0 +1,22 @@
+package util
+
+// Constants for all supported self-trade prevention modes.
+// They decide what happens when an order would trade against a resting order of the same owner
+const (
+	CANCEL_NEWEST        = "cancel_newest"
+	CANCEL_OLDEST        = "cancel_oldest"
+	CANCEL_BOTH          = "cancel_both"
+	DECREMENT_AND_CANCEL = "decrement_and_cancel"
+)
+
+// SELF_TRADE_PREVENTED is the order event recorded for every match prevented between orders of the same owner
+const SELF_TRADE_PREVENTED = "self_trade_prevented"
+
+// IsSupportedSelfTradePrevention returns true if the self-trade prevention mode is supported
+func IsSupportedSelfTradePrevention(mode string) bool {
+	switch mode {
+	case CANCEL_NEWEST, CANCEL_OLDEST, CANCEL_BOTH, DECREMENT_AND_CANCEL:
+		return true
+	}
+	return false
+}
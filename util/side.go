@@ -0,0 +1,24 @@
+package util
+
+// Constants for both sides of an order book
+const (
+	BID = "bid"
+	ASK = "ask"
+)
+
+// IsSupportedSide returns true if the side is supported
+func IsSupportedSide(side string) bool {
+	switch side {
+	case BID, ASK:
+		return true
+	}
+	return false
+}
+
+// OppositeSide returns the side an order trades against
+func OppositeSide(side string) string {
+	if side == BID {
+		return ASK
+	}
+	return BID
+}
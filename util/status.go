@@ -1,16 +1,29 @@
 package util
 
 const(
+	INACTIVE="inactive"
+	PENDING="pending"
 	ACTIVE="active"
+	PARTIALLY_FILLED="partially_filled"
 	COMPLETED="completed"
 	CANCELED="canceled"
+	EXPIRED="expired"
 )
 
 // IsSupportedStatus returns true if the status is supported
 func IsSupportedStatus(status string) bool {
 	switch status {
-	case ACTIVE, COMPLETED, CANCELED:
+	case INACTIVE, PENDING, ACTIVE, PARTIALLY_FILLED, COMPLETED, CANCELED, EXPIRED:
 		return true
 	}
 	return false
-}
\ No newline at end of file
+}
+
+// IsOpenStatus returns true if an order with the status can still be executed
+func IsOpenStatus(status string) bool {
+	switch status {
+	case INACTIVE, PENDING, ACTIVE, PARTIALLY_FILLED:
+		return true
+	}
+	return false
+}
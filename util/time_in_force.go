@@ -0,0 +1,18 @@
+package util
+
+// Constants for all supported time in force policies
+const (
+	GTC = "GTC" // good till canceled
+	IOC = "IOC" // immediate or cancel
+	FOK = "FOK" // fill or kill
+	GTD = "GTD" // good till date
+)
+
+// IsSupportedTimeInForce returns true if the time in force is supported
+func IsSupportedTimeInForce(timeInForce string) bool {
+	switch timeInForce {
+	case GTC, IOC, FOK, GTD:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package val
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/util"
+	"time"
+)
+
+// ValidateOrderPrices checks the prices a new order of the type needs
+func ValidateOrderPrices(orderType string, price decimal.Decimal, stopPrice decimal.Decimal, maxSlippage int64) error {
+	if (orderType == util.LIMIT || orderType == util.STOP_LIMIT) && price.IsZero() {
+		return errors.New("price is required for limit orders")
+	}
+
+	if !util.IsStopOrderType(orderType) {
+		if !stopPrice.IsZero() {
+			return errors.New("stop_price is only allowed for stop orders")
+		}
+		return nil
+	}
+
+	if stopPrice.IsZero() {
+		return errors.New("stop_price is required for stop orders")
+	}
+	if orderType == util.STOP_MARKET && maxSlippage == 0 {
+		return errors.New("max_slippage is required for stop market orders")
+	}
+	return nil
+}
+
+// ValidateOrderTimeInForce returns the time in force of a new order and its expiry.
+// Limit orders are good till canceled and market orders are immediate or cancel by default
+func ValidateOrderTimeInForce(orderType string, timeInForce string, expiresAt time.Time) (string, sql.NullTime, error) {
+	if timeInForce == "" {
+		timeInForce = util.GTC
+		if orderType == util.MARKET || orderType == util.STOP_MARKET {
+			timeInForce = util.IOC
+		}
+	}
+
+	if (orderType == util.MARKET || orderType == util.STOP_MARKET) && timeInForce != util.IOC && timeInForce != util.FOK {
+		return "", sql.NullTime{}, errors.New("market orders must be immediate or cancel or fill or kill")
+	}
+
+	if timeInForce != util.GTD {
+		if !expiresAt.IsZero() {
+			return "", sql.NullTime{}, errors.New("expires_at is only allowed for good till date orders")
+		}
+		return timeInForce, sql.NullTime{}, nil
+	}
+
+	if !expiresAt.After(time.Now()) {
+		return "", sql.NullTime{}, errors.New("good till date orders require a future expires_at")
+	}
+	return timeInForce, sql.NullTime{Time: expiresAt, Valid: true}, nil
+}
+
+// ValidateOrderFlags checks the post only, iceberg and hidden flags of a new order.
+// They only apply to limit orders, which can rest on the book
+func ValidateOrderFlags(orderType string, timeInForce string, amount decimal.Decimal, postOnly bool, displayAmount decimal.Decimal, hidden bool) error {
+	limit := orderType == util.LIMIT || orderType == util.STOP_LIMIT
+
+	if postOnly && (!limit || timeInForce == util.IOC || timeInForce == util.FOK) {
+		return errors.New("post_only is only allowed for limit orders that can rest on the book")
+	}
+
+	if displayAmount.IsPositive() {
+		if !limit {
+			return errors.New("display_amount is only allowed for limit orders")
+		}
+		if displayAmount.GreaterThanOrEqual(amount) {
+			return errors.New("display_amount must be less than amount")
+		}
+		if hidden {
+			return errors.New("hidden orders can't have a display_amount")
+		}
+	}
+
+	if hidden && !limit {
+		return errors.New("hidden is only allowed for limit orders")
+	}
+	return nil
+}
+
+// ValidateOrderUpdate checks an update either cancels an order or amends its price or amount
+func ValidateOrderUpdate(status string, price decimal.Decimal, amount decimal.Decimal) error {
+	amend := !price.IsZero() || !amount.IsZero()
+
+	if status == "" && !amend {
+		return errors.New("status, price or amount is required")
+	}
+	if status != "" && amend {
+		return errors.New("an order can't be canceled and amended at once")
+	}
+	return nil
+}
+
+// ValidateOrderAmendment checks the new price and amount of an order, zero keeps the current value.
+// The price of market orders is derived from their slippage, so only their amount can change
+func ValidateOrderAmendment(orderType string, price decimal.Decimal, amount decimal.Decimal, filledAmount decimal.Decimal, displayAmount decimal.Decimal) error {
+	if !price.IsZero() && (orderType == util.MARKET || orderType == util.STOP_MARKET) {
+		return errors.New("the price of market orders can't be amended")
+	}
+
+	if amount.IsZero() {
+		return nil
+	}
+	if amount.LessThanOrEqual(filledAmount) {
+		return fmt.Errorf("amount must be greater than the filled amount %s", filledAmount)
+	}
+	if displayAmount.GreaterThanOrEqual(amount) {
+		return errors.New("amount must be greater than display_amount")
+	}
+	return nil
+}
+
+// ValidateOrderGroupPrices checks the prices of the legs against the side that exits the position.
+// A take profit sells above the stop loss or buys below it, and the entry of a bracket lies between them
+func ValidateOrderGroupPrices(groupType string, side string, price decimal.Decimal, takeProfitPrice decimal.Decimal, stopPrice decimal.Decimal, stopLimitPrice decimal.Decimal, maxSlippage int64) error {
+	exitSide := side
+	if groupType == util.BRACKET {
+		if price.IsZero() {
+			return errors.New("price is required for the entry of a bracket")
+		}
+		exitSide = util.OppositeSide(side)
+	} else if !price.IsZero() {
+		return errors.New("price is only allowed for the entry of a bracket")
+	}
+
+	if stopLimitPrice.IsZero() == (maxSlippage == 0) {
+		return errors.New("the stop loss requires either stop_limit_price or max_slippage")
+	}
+
+	if exitSide == util.ASK && takeProfitPrice.LessThanOrEqual(stopPrice) {
+		return errors.New("take_profit_price must be above stop_price when selling")
+	}
+	if exitSide == util.BID && takeProfitPrice.GreaterThanOrEqual(stopPrice) {
+		return errors.New("take_profit_price must be below stop_price when buying")
+	}
+
+	if groupType == util.BRACKET && price.Sub(takeProfitPrice).Mul(price.Sub(stopPrice)).Sign() >= 0 {
+		return errors.New("price must be between take_profit_price and stop_price")
+	}
+	return nil
+}
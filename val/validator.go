@@ -2,13 +2,20 @@ package val
 
 import (
 	"fmt"
+	"go-exchange/decimal"
+	"go-exchange/registry"
+	"go-exchange/util"
 	"net/mail"
 	"regexp"
+	"strings"
+
+	db "go-exchange/db/sqlc"
 )
 
 var (
 	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidCode     = regexp.MustCompile(`^[A-Z0-9]+$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -52,3 +59,190 @@ func ValidateEmail(value string) error {
 	}
 	return nil
 }
+
+func ValidateID(value int64) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePair(value string, registry *registry.Registry) error {
+	if !registry.IsListedPair(value) {
+		return fmt.Errorf("is not a listed pair")
+	}
+	return nil
+}
+
+func ValidateActivePair(value string, registry *registry.Registry) error {
+	if !registry.IsActivePair(value) {
+		return fmt.Errorf("is not open for trading")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string, registry *registry.Registry) error {
+	if !registry.IsActiveCurrency(value) {
+		return fmt.Errorf("is not an active currency")
+	}
+	return nil
+}
+
+func ValidateCurrencyCode(value string) error {
+	if err := ValidateString(value, 2, 10); err != nil {
+		return err
+	}
+	if !isValidCode(value) {
+		return fmt.Errorf("must contain only uppercase letters or digits")
+	}
+	return nil
+}
+
+func ValidateCurrencyDecimals(value int64) error {
+	if value < 0 || value > 18 {
+		return fmt.Errorf("must be from 0-18")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 1 || value > 10 {
+		return fmt.Errorf("must be from 1-10")
+	}
+	return nil
+}
+
+func ValidateTradeLimit(value int32) error {
+	if value < 1 || value > 500 {
+		return fmt.Errorf("must be from 1-500")
+	}
+	return nil
+}
+
+func ValidateMaxSlippage(value int64) error {
+	if value < 0 || value > 10000 {
+		return fmt.Errorf("must be from 0-10000 basis points")
+	}
+	return nil
+}
+
+func ValidateFeeRate(value int64) error {
+	if value < 0 || value > 10000 {
+		return fmt.Errorf("must be from 0-10000 basis points")
+	}
+	return nil
+}
+
+func ValidateTimeout(value int64) error {
+	if value < 1 || value > 86400 {
+		return fmt.Errorf("must be from 1-86400 seconds")
+	}
+	return nil
+}
+
+func ValidatePositiveDecimal(value string) error {
+	d, err := decimal.NewFromString(value)
+	if err != nil || !d.IsPositive() {
+		return fmt.Errorf("must be a positive decimal number")
+	}
+	return nil
+}
+
+func ValidateNonNegativeDecimal(value string) error {
+	d, err := decimal.NewFromString(value)
+	if err != nil || d.IsNegative() {
+		return fmt.Errorf("must be a non-negative decimal number")
+	}
+	return nil
+}
+
+func ValidateDepth(value int32) error {
+	if value < 1 || value > 100 {
+		return fmt.Errorf("must be from 1-100")
+	}
+	return nil
+}
+
+func ValidateDecimals(value decimal.Decimal, currency db.Currency) error {
+	if value.Places() > int32(currency.Decimals) {
+		return fmt.Errorf("must have at most %d decimal places of %s", currency.Decimals, currency.Code)
+	}
+	return nil
+}
+
+func ValidateTickValue(tickSize decimal.Decimal, lotSize decimal.Decimal, quote db.Currency) error {
+	if tickSize.Mul(lotSize).Places() > int32(quote.Decimals) {
+		return fmt.Errorf("times the lot size %s must have at most %d decimal places of %s", lotSize, quote.Decimals, quote.Code)
+	}
+	return nil
+}
+
+func ValidateTickSize(value decimal.Decimal, pair db.Pair) error {
+	if !value.IsMultipleOf(pair.TickSize) {
+		return fmt.Errorf("must be a multiple of the tick size %s of %s", pair.TickSize, pair.Symbol)
+	}
+	return nil
+}
+
+func ValidateLotSize(value decimal.Decimal, pair db.Pair) error {
+	if !value.IsMultipleOf(pair.LotSize) {
+		return fmt.Errorf("must be a multiple of the lot size %s of %s", pair.LotSize, pair.Symbol)
+	}
+	return nil
+}
+
+func ValidateMinNotional(amount decimal.Decimal, price decimal.Decimal, pair db.Pair) error {
+	if amount.Mul(price).LessThan(pair.MinNotional) {
+		return fmt.Errorf("must be worth at least the minimum notional %s of %s at price %s", pair.MinNotional, pair.Symbol, price)
+	}
+	return nil
+}
+
+func ValidateSide(value string) error {
+	if !util.IsSupportedSide(value) {
+		return fmt.Errorf("must be %s or %s", util.BID, util.ASK)
+	}
+	return nil
+}
+
+func ValidateSelfTradePrevention(value string) error {
+	if !util.IsSupportedSelfTradePrevention(value) {
+		return fmt.Errorf("must be %s, %s, %s or %s", util.CANCEL_NEWEST, util.CANCEL_OLDEST, util.CANCEL_BOTH, util.DECREMENT_AND_CANCEL)
+	}
+	return nil
+}
+
+func ValidateOrderType(value string) error {
+	if !util.IsSupportedOrderType(value) {
+		return fmt.Errorf("must be %s, %s, %s or %s", util.LIMIT, util.MARKET, util.STOP_LIMIT, util.STOP_MARKET)
+	}
+	return nil
+}
+
+func ValidateTimeInForce(value string) error {
+	if !util.IsSupportedTimeInForce(value) {
+		return fmt.Errorf("must be %s, %s, %s or %s", util.GTC, util.IOC, util.FOK, util.GTD)
+	}
+	return nil
+}
+
+func ValidateOrderGroupType(value string) error {
+	if !util.IsSupportedOrderGroupType(value) {
+		return fmt.Errorf("must be %s or %s", util.OCO, util.BRACKET)
+	}
+	return nil
+}
+
+func ValidateMarketStatus(value string) error {
+	if !util.IsSupportedMarketStatus(value) {
+		return fmt.Errorf("must be %s, %s or %s", util.ACTIVE, util.PAUSED, util.DELISTED)
+	}
+	return nil
+}
+
+func ValidateCandleInterval(value string) error {
+	if !util.IsSupportedCandleInterval(value) {
+		return fmt.Errorf("must be one of %s", strings.Join(util.CandleIntervals, ", "))
+	}
+	return nil
+}